@@ -404,6 +404,10 @@ func NewTokenApp(
 		BlockedAddresses(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// Transfers between accounts, made by users through the bank, vesting and distribution
+	// modules, IBC or contracts, are subject to the send restrictions of the token factory (e.g.
+	// paused denoms). The token factory keeper is set further down.
+	sendRestrictedBankKeeper := tokenfactorykeeper.NewSendRestrictedBankKeeper(app.BankKeeper, &app.TokenFactoryKeeper)
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -425,7 +429,7 @@ func NewTokenApp(
 		appCodec,
 		keys[distrtypes.StoreKey],
 		app.AccountKeeper,
-		app.BankKeeper,
+		newCommunityPoolRestrictedBankKeeper(app.BankKeeper, sendRestrictedBankKeeper),
 		app.StakingKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -540,12 +544,23 @@ func NewTokenApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
+		keys[tokenfactorytypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		keys[banktypes.StoreKey],
+		app.DistrKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.TokenFactoryKeeper = tokenFactoryKeeper
+
 	// IBC Fee Module keeper
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
 		appCodec, keys[ibcfeetypes.StoreKey],
 		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
 		app.IBCKeeper.ChannelKeeper,
-		&app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
+		&app.IBCKeeper.PortKeeper, app.AccountKeeper, sendRestrictedBankKeeper,
 	)
 
 	// Create Transfer Keepers
@@ -557,7 +572,7 @@ func NewTokenApp(
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
-		app.BankKeeper,
+		sendRestrictedBankKeeper,
 		scopedTransferKeeper,
 	)
 
@@ -583,17 +598,6 @@ func NewTokenApp(
 		app.MsgServiceRouter(),
 	)
 
-	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
-		keys[tokenfactorytypes.StoreKey],
-		app.GetSubspace(tokenfactorytypes.ModuleName),
-		app.AccountKeeper,
-		app.BankKeeper,
-		app.DistrKeeper,
-	)
-	app.TokenFactoryKeeper = tokenFactoryKeeper
-
-	tokenfactoryModule := tokenfactory.NewAppModule(app.appCodec, app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper)
-
 	wasmDir := filepath.Join(homePath, "wasm")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
@@ -609,7 +613,7 @@ func NewTokenApp(
 		appCodec,
 		keys[wasm.StoreKey],
 		app.AccountKeeper,
-		app.BankKeeper,
+		sendRestrictedBankKeeper,
 		app.StakingKeeper,
 		distrkeeper.NewQuerier(app.DistrKeeper),
 		app.IBCKeeper.ChannelKeeper,
@@ -625,6 +629,11 @@ func NewTokenApp(
 		wasmOpts...,
 	)
 
+	// The before send hooks of factory denoms are CosmWasm contracts called through sudo, and
+	// contracts may be allowed to create denoms based on their code id
+	app.TokenFactoryKeeper.SetContractKeeper(wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper), app.WasmKeeper)
+	tokenfactoryModule := tokenfactory.NewAppModule(app.appCodec, app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName))
+
 	// The gov proposal types can be individually enabled
 	if len(enabledProposals) != 0 {
 		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, enabledProposals))
@@ -682,8 +691,8 @@ func NewTokenApp(
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		vesting.NewAppModule(app.AccountKeeper, sendRestrictedBankKeeper),
+		newSendRestrictedBankModule(appCodec, app.BankKeeper, sendRestrictedBankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
@@ -1079,7 +1088,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
-	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
+	paramsKeeper.Subspace(tokenfactorytypes.ModuleName).WithKeyTable(tokenfactorytypes.ParamKeyTable()) //nolint:staticcheck
 
 	return paramsKeeper
 }
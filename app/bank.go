@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sendRestrictedBankModule is the bank module, with its Msg service backed by a bank keeper
+// enforcing send restrictions. Bank sends, whether from transactions, authz or contracts,
+// are then subject to the same restrictions as IBC transfers.
+type sendRestrictedBankModule struct {
+	bank.AppModule
+
+	baseKeeper           bankkeeper.BaseKeeper
+	sendRestrictedKeeper bankkeeper.Keeper
+	legacySubspace       exported.Subspace
+}
+
+func newSendRestrictedBankModule(cdc codec.Codec, baseKeeper bankkeeper.BaseKeeper, sendRestrictedKeeper bankkeeper.Keeper, accountKeeper banktypes.AccountKeeper, ss exported.Subspace) sendRestrictedBankModule {
+	return sendRestrictedBankModule{
+		AppModule:            bank.NewAppModule(cdc, baseKeeper, accountKeeper, ss),
+		baseKeeper:           baseKeeper,
+		sendRestrictedKeeper: sendRestrictedKeeper,
+		legacySubspace:       ss,
+	}
+}
+
+// RegisterServices registers the bank services, like bank.AppModule does, except for the Msg
+// service which uses the send restricted keeper.
+func (am sendRestrictedBankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.sendRestrictedKeeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.baseKeeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 3 to 4: %v", err))
+	}
+}
+
+// communityPoolRestrictedBankKeeper is the bank keeper of the distribution module. Funding the
+// community pool is subject to the send restrictions, like a bank send, while rewards and
+// commissions are paid out by the base keeper, so that a paused denom or a frozen address never
+// prevents delegators from withdrawing their rewards or changing their delegations.
+type communityPoolRestrictedBankKeeper struct {
+	bankkeeper.Keeper
+
+	sendRestrictedKeeper bankkeeper.Keeper
+}
+
+func newCommunityPoolRestrictedBankKeeper(baseKeeper bankkeeper.Keeper, sendRestrictedKeeper bankkeeper.Keeper) communityPoolRestrictedBankKeeper {
+	return communityPoolRestrictedBankKeeper{
+		Keeper:               baseKeeper,
+		sendRestrictedKeeper: sendRestrictedKeeper,
+	}
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module account with the send
+// restricted keeper. The distribution module only does so to fund the community pool.
+func (k communityPoolRestrictedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return k.sendRestrictedKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
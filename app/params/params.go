@@ -8,7 +8,7 @@ const (
 	DefaultWeightMsgCreateDenom      int = 100
 	DefaultWeightMsgMint             int = 100
 	DefaultWeightMsgBurn             int = 100
-	DefaultWeightMsgChangeAdmin      int = 100
+	DefaultWeightMsgProposeAdmin     int = 100
 	DefaultWeightMsgSetDenomMetadata int = 100
 	DefaultWeightMsgForceTransfer    int = 100
 )
@@ -37,8 +37,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	ibctestingtypes "github.com/cosmos/ibc-go/v7/testing/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
@@ -382,3 +386,37 @@ func GenesisStateWithValSet(
 	println(string(genesisState[banktypes.ModuleName]))
 	return genesisState, nil
 }
+
+// GetBaseApp returns the BaseApp of the TokenApp, for the IBC testing package
+func (app *TokenApp) GetBaseApp() *bam.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper of the TokenApp, for the IBC testing package
+func (app *TokenApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the IBC keeper of the TokenApp, for the IBC testing package
+func (app *TokenApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the scoped IBC keeper of the TokenApp, for the IBC testing package
+func (app *TokenApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig of the TokenApp, for the IBC testing package
+func (app *TokenApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
+// SetupTestingApp returns a function initializing a new TokenApp for every chain of the IBC
+// testing package, to be set as ibctesting.DefaultTestingAppInit
+func SetupTestingApp(t testing.TB) func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app, genesisState := setup(t, "", true, 5)
+		return app, genesisState
+	}
+}
@@ -12,7 +12,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/noria-net/token-factory/app"
+	wasmbinding "github.com/noria-net/token-factory/x/tokenfactory/bindings"
 	bindings "github.com/noria-net/token-factory/x/tokenfactory/bindings/types"
+	tfkeeper "github.com/noria-net/token-factory/x/tokenfactory/keeper"
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
 
@@ -294,6 +296,261 @@ type ReflectExec struct {
 	ReflectSubMsg *ReflectSubMsgs `json:"reflect_sub_msg,omitempty"`
 }
 
+func TestPausedBankMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "SUN"}}, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", reflect.String())
+
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: reflect.String(),
+	}}, sdk.Coin{})
+	require.NoError(t, err)
+
+	bankSend := func() error {
+		reflectBz, err := json.Marshal(ReflectExec{
+			ReflectMsg: &ReflectMsgs{
+				Msgs: []wasmvmtypes.CosmosMsg{{
+					Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+						ToAddress: lucky.String(),
+						Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(10, sunDenom)},
+					}},
+				}},
+			},
+		})
+		require.NoError(t, err)
+
+		contractKeeper := keeper.NewDefaultPermissionKeeper(osmosis.WasmKeeper)
+		_, err = contractKeeper.Execute(ctx, reflect, lucky, reflectBz, nil)
+		return err
+	}
+	require.NoError(t, bankSend())
+
+	// contracts can't send a paused denom
+	msgServer := tfkeeper.NewMsgServerImpl(osmosis.TokenFactoryKeeper)
+	_, err = msgServer.Pause(sdk.WrapSDKContext(ctx), types.NewMsgPause(reflect.String(), sunDenom))
+	require.NoError(t, err)
+	require.ErrorIs(t, bankSend(), types.ErrDenomPaused)
+
+	_, err = msgServer.Unpause(sdk.WrapSDKContext(ctx), types.NewMsgUnpause(reflect.String(), sunDenom))
+	require.NoError(t, err)
+	require.NoError(t, bankSend())
+	require.Equal(t, int64(20), osmosis.BankKeeper.GetBalance(ctx, lucky, sunDenom).Amount.Int64())
+}
+
+func TestFrozenMintMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "SUN"}}, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", reflect.String())
+
+	mint := bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+
+	// contracts can't mint to a frozen address
+	msgServer := tfkeeper.NewMsgServerImpl(osmosis.TokenFactoryKeeper)
+	_, err = msgServer.Freeze(sdk.WrapSDKContext(ctx), types.NewMsgFreeze(reflect.String(), sunDenom, []string{lucky.String()}))
+	require.NoError(t, err)
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{})
+	require.ErrorIs(t, err, types.ErrAddressFrozen)
+
+	_, err = msgServer.Unfreeze(sdk.WrapSDKContext(ctx), types.NewMsgUnfreeze(reflect.String(), sunDenom, []string{lucky.String()}))
+	require.NoError(t, err)
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), osmosis.BankKeeper.GetBalance(ctx, lucky, sunDenom).Amount.Int64())
+}
+
+func TestAllowlistCreateDenomMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	// the reflect contract doesn't know about allowlist mode, so the denom is created directly
+	_, err := wasmbinding.PerformCreateDenom(&osmosis.TokenFactoryKeeper, &osmosis.BankKeeper, ctx, reflect, &bindings.CreateDenom{
+		Subdenom:         "SUN",
+		AllowlistEnabled: true,
+	})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", reflect.String())
+	require.True(t, osmosis.TokenFactoryKeeper.IsAllowlistEnabled(ctx, sunDenom))
+
+	mint := bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+
+	// contracts can only mint to allowed addresses
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{})
+	require.ErrorIs(t, err, types.ErrAddressNotAllowed)
+
+	msgServer := tfkeeper.NewMsgServerImpl(osmosis.TokenFactoryKeeper)
+	_, err = msgServer.AddToAllowlist(sdk.WrapSDKContext(ctx), types.NewMsgAddToAllowlist(reflect.String(), sunDenom, []string{lucky.String()}))
+	require.NoError(t, err)
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), osmosis.BankKeeper.GetBalance(ctx, lucky, sunDenom).Amount.Int64())
+}
+
+func TestBeforeSendHookMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+	hook := instantiateBeforeSendHookContract(t, ctx, osmosis, lucky)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "SUN"}}, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", reflect.String())
+
+	// the reflect contract doesn't know about before send hooks, so the hook is set directly
+	err = wasmbinding.PerformSetBeforeSendHook(&osmosis.TokenFactoryKeeper, ctx, reflect, &bindings.SetBeforeSendHook{
+		Denom:        sunDenom,
+		ContractAddr: hook.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, hook.String(), osmosis.TokenFactoryKeeper.GetBeforeSendHook(ctx, sunDenom))
+
+	// mints can't be blocked, but are tracked
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(1000),
+		MintToAddress: reflect.String(),
+	}}, sdk.Coin{})
+	require.NoError(t, err)
+	tracked := string(osmosis.WasmKeeper.QueryRaw(ctx, hook, []byte("tracked")))
+	require.Contains(t, tracked, fmt.Sprintf(`"to":"%s"`, reflect))
+	require.Contains(t, tracked, `"amount":"1000"`)
+
+	bankSend := func(amount uint64) error {
+		reflectBz, err := json.Marshal(ReflectExec{
+			ReflectMsg: &ReflectMsgs{
+				Msgs: []wasmvmtypes.CosmosMsg{{
+					Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+						ToAddress: lucky.String(),
+						Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(amount, sunDenom)},
+					}},
+				}},
+			},
+		})
+		require.NoError(t, err)
+
+		contractKeeper := keeper.NewDefaultPermissionKeeper(osmosis.WasmKeeper)
+		_, err = contractKeeper.Execute(ctx, reflect, lucky, reflectBz, nil)
+		return err
+	}
+
+	// the hook blocks transfers of exactly 100 tokens
+	require.ErrorIs(t, bankSend(100), types.ErrBeforeSendHookFailed)
+	require.NoError(t, bankSend(10))
+	require.Equal(t, int64(10), osmosis.BankKeeper.GetBalance(ctx, lucky, sunDenom).Amount.Int64())
+	tracked = string(osmosis.WasmKeeper.QueryRaw(ctx, hook, []byte("tracked")))
+	require.Contains(t, tracked, fmt.Sprintf(`"from":"%s"`, reflect))
+	require.Contains(t, tracked, `"amount":"10"`)
+
+	// a hook running out of the gas limit param blocks every transfer
+	params := osmosis.TokenFactoryKeeper.GetParams(ctx)
+	params.BeforeSendHookGasLimit = 1_000
+	require.NoError(t, osmosis.TokenFactoryKeeper.SetParams(ctx, params))
+	require.ErrorIs(t, bankSend(10), types.ErrBeforeSendHookFailed)
+	params.BeforeSendHookGasLimit = types.DefaultBeforeSendHookGasLimit
+	require.NoError(t, osmosis.TokenFactoryKeeper.SetParams(ctx, params))
+
+	// removing the hook lets every transfer through
+	err = wasmbinding.PerformSetBeforeSendHook(&osmosis.TokenFactoryKeeper, ctx, reflect, &bindings.SetBeforeSendHook{Denom: sunDenom})
+	require.NoError(t, err)
+	require.NoError(t, bankSend(100))
+	require.Equal(t, int64(110), osmosis.BankKeeper.GetBalance(ctx, lucky, sunDenom).Amount.Int64())
+}
+
+func TestRenouncedCapabilitiesMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "SUN"}}, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", reflect.String())
+
+	mint := bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+	forceTransfer := bindings.TokenMsg{ForceTransfer: &bindings.ForceTransfer{
+		Denom:       sunDenom,
+		Amount:      sdk.NewInt(10),
+		FromAddress: lucky.String(),
+		ToAddress:   reflect.String(),
+	}}
+	require.NoError(t, executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{}))
+	require.NoError(t, executeCustom(t, ctx, osmosis, reflect, lucky, forceTransfer, sdk.Coin{}))
+
+	// contracts can't exercise the capabilities they renounced
+	msgServer := tfkeeper.NewMsgServerImpl(osmosis.TokenFactoryKeeper)
+	for _, capability := range types.AllCapabilities() {
+		_, err = msgServer.RenounceCapability(sdk.WrapSDKContext(ctx), types.NewMsgRenounceCapability(reflect.String(), sunDenom, capability))
+		require.NoError(t, err)
+	}
+	require.ErrorIs(t, executeCustom(t, ctx, osmosis, reflect, lucky, mint, sdk.Coin{}), types.ErrCapabilityRenounced)
+	require.ErrorIs(t, executeCustom(t, ctx, osmosis, reflect, lucky, forceTransfer, sdk.Coin{}), types.ErrCapabilityRenounced)
+	err = wasmbinding.PerformSetMetadata(&osmosis.TokenFactoryKeeper, &osmosis.BankKeeper, ctx, reflect, sunDenom, bindings.Metadata{
+		Description: "fixed forever",
+		DenomUnits:  []bindings.DenomUnit{{Denom: sunDenom, Exponent: 0}},
+		Display:     sunDenom,
+		Name:        "SUN",
+		Symbol:      "SUN",
+	})
+	require.ErrorIs(t, err, types.ErrCapabilityRenounced)
+	require.Equal(t, int64(90), osmosis.BankKeeper.GetBalance(ctx, lucky, sunDenom).Amount.Int64())
+}
+
 type ReflectMsgs struct {
 	Msgs []wasmvmtypes.CosmosMsg `json:"msgs"`
 }
@@ -302,6 +559,90 @@ type ReflectSubMsgs struct {
 	Msgs []wasmvmtypes.SubMsg `json:"msgs"`
 }
 
+func TestPermissionedCreateDenomMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	params := osmosis.TokenFactoryKeeper.GetParams(ctx)
+	params.DenomCreationMode = types.CreationModeAllowlist
+	require.NoError(t, osmosis.TokenFactoryKeeper.SetParams(ctx, params))
+
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.ErrorContains(t, err, types.ErrCreatorNotAllowed.Error())
+
+	// contracts instantiated from an allowed code id can create denoms
+	codeID := osmosis.WasmKeeper.GetContractInfo(ctx, reflect).CodeID
+	params.AllowedCreatorCodeIds = []uint64{codeID}
+	require.NoError(t, osmosis.TokenFactoryKeeper.SetParams(ctx, params))
+
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	_, err = osmosis.TokenFactoryKeeper.GetAuthorityMetadata(ctx, fmt.Sprintf("factory/%s/SUN", reflect.String()))
+	require.NoError(t, err)
+}
+
+func TestAuditLogMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	rcpt := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, osmosis, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, osmosis, reflect, reflectAmount)
+
+	// the actions of the contract are recorded in the audit log of its denom
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	msg = bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(500),
+		MintToAddress: lucky.String(),
+	}}
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+
+	msg = bindings.TokenMsg{ForceTransfer: &bindings.ForceTransfer{
+		Denom:       sunDenom,
+		Amount:      sdk.NewInt(100),
+		FromAddress: lucky.String(),
+		ToAddress:   rcpt.String(),
+	}}
+	err = executeCustom(t, ctx, osmosis, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+
+	entries := osmosis.TokenFactoryKeeper.GetAuditLog(ctx, sunDenom)
+	require.Len(t, entries, 3)
+	for i, action := range []string{types.TypeMsgCreateDenom, types.TypeMsgMint, types.TypeMsgForceTransfer} {
+		require.Equal(t, uint64(i), entries[i].Sequence)
+		require.Equal(t, action, entries[i].Action)
+		require.Equal(t, reflect.String(), entries[i].Actor)
+	}
+	require.Equal(t, []string{lucky.String()}, entries[1].Counterparties)
+	require.Equal(t, sdk.NewInt(500), entries[1].Amount)
+	require.Equal(t, []string{lucky.String(), rcpt.String()}, entries[2].Counterparties)
+	require.Equal(t, sdk.NewInt(100), entries[2].Amount)
+}
+
 func executeCustom(t *testing.T, ctx sdk.Context, osmosis *app.TokenApp, contract sdk.AccAddress, sender sdk.AccAddress, msg bindings.TokenMsg, funds sdk.Coin) error {
 	wrapped := bindings.TokenFactoryMsg{
 		Token: &msg,
@@ -11,7 +11,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/noria-net/token-factory/app"
+	wasmbinding "github.com/noria-net/token-factory/x/tokenfactory/bindings"
 	bindings "github.com/noria-net/token-factory/x/tokenfactory/bindings/types"
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
 
 func TestQueryFullDenom(t *testing.T) {
@@ -35,6 +37,149 @@ func TestQueryFullDenom(t *testing.T) {
 	require.EqualValues(t, expected, resp.Denom)
 }
 
+func TestQueryMaxSupply(t *testing.T) {
+	actor := RandomAccountAddress()
+	tokenz, ctx := SetupCustomApp(t, actor)
+
+	reflect := instantiateReflectContract(t, ctx, tokenz, actor)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, tokenz, reflect, reflectAmount)
+
+	maxSupply := sdk.NewInt(1_000)
+	_, err := wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, reflect, &bindings.CreateDenom{
+		Subdenom:  "capped",
+		MaxSupply: &maxSupply,
+	})
+	require.NoError(t, err)
+	_, err = wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, reflect, &bindings.CreateDenom{
+		Subdenom: "uncapped",
+	})
+	require.NoError(t, err)
+
+	// the reflect contract doesn't know the max supply query, so the query handler is called directly
+	queryHandler := wasmbinding.CustomQueryDecorator(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper)(nil)
+	queryMaxSupply := func(denom string) bindings.MaxSupplyResponse {
+		bz, err := json.Marshal(bindings.TokenFactoryQuery{
+			Token: &bindings.TokenQuery{MaxSupply: &bindings.DenomMaxSupply{Denom: denom}},
+		})
+		require.NoError(t, err)
+		resBz, err := queryHandler.HandleQuery(ctx, reflect, wasmvmtypes.QueryRequest{Custom: bz})
+		require.NoError(t, err)
+		resp := bindings.MaxSupplyResponse{}
+		require.NoError(t, json.Unmarshal(resBz, &resp))
+		return resp
+	}
+
+	require.Equal(t, maxSupply, queryMaxSupply(fmt.Sprintf("factory/%s/capped", reflect.String())).MaxSupply)
+	require.True(t, queryMaxSupply(fmt.Sprintf("factory/%s/uncapped", reflect.String())).MaxSupply.IsZero())
+
+	// the cap is enforced on mints from contracts
+	err = wasmbinding.PerformMint(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, reflect, &bindings.MintTokens{
+		Denom:         fmt.Sprintf("factory/%s/capped", reflect.String()),
+		Amount:        maxSupply.AddRaw(1),
+		MintToAddress: actor.String(),
+	})
+	require.ErrorIs(t, err, types.ErrMaxSupplyExceeded)
+}
+
+func TestQueryDenomsByAdmin(t *testing.T) {
+	actor := RandomAccountAddress()
+	tokenz, ctx := SetupCustomApp(t, actor)
+
+	reflect := instantiateReflectContract(t, ctx, tokenz, actor)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, tokenz, reflect, reflectAmount)
+
+	_, err := wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, reflect, &bindings.CreateDenom{
+		Subdenom: "ustart",
+	})
+	require.NoError(t, err)
+	denom := fmt.Sprintf("factory/%s/ustart", reflect.String())
+
+	// the reflect contract doesn't know the denoms by admin query, so the query handler is called directly
+	queryHandler := wasmbinding.CustomQueryDecorator(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper)(nil)
+	queryDenomsByAdmin := func(admin sdk.AccAddress) bindings.DenomsByAdminResponse {
+		bz, err := json.Marshal(bindings.TokenFactoryQuery{
+			Token: &bindings.TokenQuery{DenomsByAdmin: &bindings.DenomsByAdmin{Admin: admin.String()}},
+		})
+		require.NoError(t, err)
+		resBz, err := queryHandler.HandleQuery(ctx, reflect, wasmvmtypes.QueryRequest{Custom: bz})
+		require.NoError(t, err)
+		resp := bindings.DenomsByAdminResponse{}
+		require.NoError(t, json.Unmarshal(resBz, &resp))
+		return resp
+	}
+
+	require.Equal(t, []string{denom}, queryDenomsByAdmin(reflect).Denoms)
+	require.Empty(t, queryDenomsByAdmin(actor).Denoms)
+
+	// the denom is no longer listed once the contract renounces its adminship
+	err = wasmbinding.ChangeAdmin(&tokenz.TokenFactoryKeeper, ctx, reflect, &bindings.ChangeAdmin{
+		Denom:           denom,
+		ConfirmRenounce: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, queryDenomsByAdmin(reflect).Denoms)
+}
+
+func TestQueryDenomInfo(t *testing.T) {
+	actor := RandomAccountAddress()
+	tokenz, ctx := SetupCustomApp(t, actor)
+
+	reflect := instantiateReflectContract(t, ctx, tokenz, actor)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, tokenz, reflect, reflectAmount)
+
+	_, err := wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, reflect, &bindings.CreateDenom{
+		Subdenom: "ustart",
+	})
+	require.NoError(t, err)
+	denom := fmt.Sprintf("factory/%s/ustart", reflect.String())
+	err = wasmbinding.PerformMint(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, reflect, &bindings.MintTokens{
+		Denom:         denom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: actor.String(),
+	})
+	require.NoError(t, err)
+
+	// the reflect contract doesn't know the denom info query, so the query handler is called directly
+	queryHandler := wasmbinding.CustomQueryDecorator(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper)(nil)
+	queryDenomInfo := func(denom string) (bindings.DenomInfoResponse, error) {
+		bz, err := json.Marshal(bindings.TokenFactoryQuery{
+			Token: &bindings.TokenQuery{DenomInfo: &bindings.DenomInfo{Denom: denom}},
+		})
+		require.NoError(t, err)
+		resBz, err := queryHandler.HandleQuery(ctx, reflect, wasmvmtypes.QueryRequest{Custom: bz})
+		if err != nil {
+			return bindings.DenomInfoResponse{}, err
+		}
+		resp := bindings.DenomInfoResponse{}
+		require.NoError(t, json.Unmarshal(resBz, &resp))
+		return resp, nil
+	}
+
+	info, err := queryDenomInfo(denom)
+	require.NoError(t, err)
+	require.Equal(t, reflect.String(), info.Creator)
+	require.Equal(t, "ustart", info.Subdenom)
+	require.Equal(t, reflect.String(), info.Admin)
+	require.Equal(t, denom, info.Metadata.Base)
+	require.Equal(t, wasmvmtypes.NewCoin(100, denom), info.Supply)
+	require.Equal(t, ctx.BlockHeight(), info.CreationHeight)
+
+	_, err = queryDenomInfo(fmt.Sprintf("factory/%s/unknown", reflect.String()))
+	require.ErrorIs(t, err, types.ErrDenomDoesNotExist)
+}
+
 type ReflectQuery struct {
 	Chain *ChainRequest `json:"chain,omitempty"`
 }
@@ -70,6 +70,22 @@ func instantiateReflectContract(t *testing.T, ctx sdk.Context, tokenz *app.Token
 	return addr
 }
 
+// instantiateBeforeSendHookContract stores and instantiates a contract whose before send hook
+// blocks the transfers of exactly 100 tokens, and stores the last transfer it tracked
+func instantiateBeforeSendHookContract(t *testing.T, ctx sdk.Context, tokenz *app.TokenApp, funder sdk.AccAddress) sdk.AccAddress {
+	wasmCode, err := os.ReadFile("./testdata/before_send_hook.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := keeper.NewDefaultPermissionKeeper(tokenz.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, funder, wasmCode, nil)
+	require.NoError(t, err)
+
+	addr, _, err := contractKeeper.Instantiate(ctx, codeID, funder, funder, []byte("{}"), "before send hook", nil)
+	require.NoError(t, err)
+
+	return addr
+}
+
 func fundAccount(t *testing.T, ctx sdk.Context, tokenz *app.TokenApp, addr sdk.AccAddress, coins sdk.Coins) {
 	err := banktestutil.FundAccount(
 		tokenz.BankKeeper,
@@ -57,6 +57,12 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if tokenMsg.ChangeAdmin != nil {
 			return m.changeAdmin(ctx, contractAddr, tokenMsg.ChangeAdmin)
 		}
+		if tokenMsg.AcceptAdmin != nil {
+			return m.acceptAdmin(ctx, contractAddr, tokenMsg.AcceptAdmin)
+		}
+		if tokenMsg.CancelAdminProposal != nil {
+			return m.cancelAdminProposal(ctx, contractAddr, tokenMsg.CancelAdminProposal)
+		}
 		if tokenMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, tokenMsg.BurnTokens)
 		}
@@ -66,6 +72,18 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if tokenMsg.ForceTransfer != nil {
 			return m.forceTransfer(ctx, contractAddr, tokenMsg.ForceTransfer)
 		}
+		if tokenMsg.SetBeforeSendHook != nil {
+			return m.setBeforeSendHook(ctx, contractAddr, tokenMsg.SetBeforeSendHook)
+		}
+		if tokenMsg.BatchMintTokens != nil {
+			return m.batchMintTokens(ctx, contractAddr, tokenMsg.BatchMintTokens)
+		}
+		if tokenMsg.BatchBurnTokens != nil {
+			return m.batchBurnTokens(ctx, contractAddr, tokenMsg.BatchBurnTokens)
+		}
+		if tokenMsg.BatchForceTransfer != nil {
+			return m.batchForceTransfer(ctx, contractAddr, tokenMsg.BatchForceTransfer)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -89,6 +107,11 @@ func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper,
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
 
 	msgCreateDenom := tokenfactorytypes.NewMsgCreateDenom(contractAddr.String(), createDenom.Subdenom)
+	if createDenom.MaxSupply != nil {
+		msgCreateDenom.MaxSupply = *createDenom.MaxSupply
+	}
+	msgCreateDenom.AllowlistEnabled = createDenom.AllowlistEnabled
+	msgCreateDenom.FeeDenom = createDenom.FeeDenom
 
 	if err := msgCreateDenom.ValidateBasic(); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed validating MsgCreateDenom")
@@ -134,27 +157,20 @@ func PerformMint(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper, ctx sdk
 	}
 
 	coin := sdk.Coin{Denom: mint.Denom, Amount: mint.Amount}
-	sdkMsg := tokenfactorytypes.NewMsgMint(contractAddr.String(), coin)
+	sdkMsg := tokenfactorytypes.NewMsgMintTo(contractAddr.String(), coin, rcpt.String())
+	sdkMsg.ReferenceId = mint.ReferenceID
 
 	if err = sdkMsg.ValidateBasic(); err != nil {
 		return err
 	}
 
-	// Mint through token factory / message server
+	// Mint directly to the recipient through token factory / message server, so that the
+	// recipient is subject to the same checks as a regular mint
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
 	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), sdkMsg)
 	if err != nil {
 		return sdkerrors.Wrap(err, "minting coins from message")
 	}
-
-	if b.BlockedAddr(rcpt) {
-		return sdkerrors.Wrapf(err, "minting coins to blocked address %s", rcpt.String())
-	}
-
-	err = b.SendCoins(ctx, contractAddr, rcpt, sdk.NewCoins(coin))
-	if err != nil {
-		return sdkerrors.Wrap(err, "sending newly minted coins from message")
-	}
 	return nil
 }
 
@@ -168,28 +184,99 @@ func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contractAddr sdk.AccAddre
 }
 
 // ChangeAdmin is used with changeAdmin to validate changeAdmin messages and to dispatch.
+// A non-empty new admin is proposed, while an empty one renounces the admin.
 func ChangeAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindingstypes.ChangeAdmin) error {
 	if changeAdmin == nil {
 		return wasmvmtypes.InvalidRequest{Err: "changeAdmin is nil"}
 	}
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+
+	if changeAdmin.NewAdminAddress == "" {
+		changeAdminMsg := tokenfactorytypes.NewMsgChangeAdmin(contractAddr.String(), changeAdmin.Denom, "", changeAdmin.ConfirmRenounce)
+		if err := changeAdminMsg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		_, err := msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), changeAdminMsg)
+		if err != nil {
+			return sdkerrors.Wrap(err, "failed changing admin from message")
+		}
+		return nil
+	}
+
 	newAdminAddr, err := parseAddress(changeAdmin.NewAdminAddress)
 	if err != nil {
 		return err
 	}
 
-	changeAdminMsg := tokenfactorytypes.NewMsgChangeAdmin(contractAddr.String(), changeAdmin.Denom, newAdminAddr.String())
-	if err := changeAdminMsg.ValidateBasic(); err != nil {
+	proposeAdminMsg := tokenfactorytypes.NewMsgProposeAdmin(contractAddr.String(), changeAdmin.Denom, newAdminAddr.String())
+	if err := proposeAdminMsg.ValidateBasic(); err != nil {
 		return err
 	}
 
-	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
-	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), changeAdminMsg)
+	_, err = msgServer.ProposeAdmin(sdk.WrapSDKContext(ctx), proposeAdminMsg)
 	if err != nil {
 		return sdkerrors.Wrap(err, "failed changing admin from message")
 	}
 	return nil
 }
 
+// acceptAdmin accepts a proposed adminship.
+func (m *CustomMessenger) acceptAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, acceptAdmin *bindingstypes.AcceptAdmin) ([]sdk.Event, [][]byte, error) {
+	err := AcceptAdmin(m.tokenFactory, ctx, contractAddr, acceptAdmin)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to accept admin")
+	}
+	return nil, nil, nil
+}
+
+// AcceptAdmin is used with acceptAdmin to validate acceptAdmin messages and to dispatch.
+func AcceptAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, acceptAdmin *bindingstypes.AcceptAdmin) error {
+	if acceptAdmin == nil {
+		return wasmvmtypes.InvalidRequest{Err: "acceptAdmin is nil"}
+	}
+
+	acceptAdminMsg := tokenfactorytypes.NewMsgAcceptAdmin(contractAddr.String(), acceptAdmin.Denom)
+	if err := acceptAdminMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), acceptAdminMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed accepting admin from message")
+	}
+	return nil
+}
+
+// cancelAdminProposal cancels a pending admin proposal.
+func (m *CustomMessenger) cancelAdminProposal(ctx sdk.Context, contractAddr sdk.AccAddress, cancel *bindingstypes.CancelAdminProposal) ([]sdk.Event, [][]byte, error) {
+	err := CancelAdminProposal(m.tokenFactory, ctx, contractAddr, cancel)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to cancel admin proposal")
+	}
+	return nil, nil, nil
+}
+
+// CancelAdminProposal is used with cancelAdminProposal to validate cancelAdminProposal messages and to dispatch.
+func CancelAdminProposal(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, cancel *bindingstypes.CancelAdminProposal) error {
+	if cancel == nil {
+		return wasmvmtypes.InvalidRequest{Err: "cancelAdminProposal is nil"}
+	}
+
+	cancelMsg := tokenfactorytypes.NewMsgCancelAdminProposal(contractAddr.String(), cancel.Denom)
+	if err := cancelMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.CancelAdminProposal(sdk.WrapSDKContext(ctx), cancelMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed cancelling admin proposal from message")
+	}
+	return nil
+}
+
 // burnTokens burns tokens.
 func (m *CustomMessenger) burnTokens(ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindingstypes.BurnTokens) ([]sdk.Event, [][]byte, error) {
 	err := PerformBurn(m.tokenFactory, ctx, contractAddr, burn)
@@ -210,6 +297,7 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	if burn.BurnFromAddress != "" {
 		sdkMsg = tokenfactorytypes.NewMsgBurnFrom(contractAddr.String(), coin, burn.BurnFromAddress)
 	}
+	sdkMsg.ReferenceId = burn.ReferenceID
 
 	if err := sdkMsg.ValidateBasic(); err != nil {
 		return err
@@ -265,6 +353,129 @@ func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contrac
 	return nil
 }
 
+// setBeforeSendHook sets the before send hook of a denom.
+func (m *CustomMessenger) setBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindingstypes.SetBeforeSendHook) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetBeforeSendHook(m.tokenFactory, ctx, contractAddr, setBeforeSendHook)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform set before send hook")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetBeforeSendHook is used with setBeforeSendHook to validate setBeforeSendHook messages and to dispatch.
+func PerformSetBeforeSendHook(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindingstypes.SetBeforeSendHook) error {
+	if setBeforeSendHook == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set before send hook null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetBeforeSendHook(contractAddr.String(), setBeforeSendHook.Denom, setBeforeSendHook.ContractAddr)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetBeforeSendHook(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "setting before send hook from message")
+	}
+	return nil
+}
+
+// batchMintTokens mints tokens of a specified denom to many addresses.
+func (m *CustomMessenger) batchMintTokens(ctx sdk.Context, contractAddr sdk.AccAddress, batchMint *bindingstypes.BatchMintTokens) ([]sdk.Event, [][]byte, error) {
+	err := PerformBatchMint(m.tokenFactory, ctx, contractAddr, batchMint)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform batch mint")
+	}
+	return nil, nil, nil
+}
+
+// PerformBatchMint is used with batchMintTokens to validate the batch mint message and mint
+// through token factory.
+func PerformBatchMint(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, batchMint *bindingstypes.BatchMintTokens) error {
+	if batchMint == nil {
+		return wasmvmtypes.InvalidRequest{Err: "batch mint null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgBatchMint(contractAddr.String(), batchMint.Denom, wasmBatchEntriesToSdk(batchMint.Entries))
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.BatchMint(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "batch minting coins from message")
+	}
+	return nil
+}
+
+// batchBurnTokens burns tokens of a specified denom from many addresses.
+func (m *CustomMessenger) batchBurnTokens(ctx sdk.Context, contractAddr sdk.AccAddress, batchBurn *bindingstypes.BatchBurnTokens) ([]sdk.Event, [][]byte, error) {
+	err := PerformBatchBurn(m.tokenFactory, ctx, contractAddr, batchBurn)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform batch burn")
+	}
+	return nil, nil, nil
+}
+
+// PerformBatchBurn is used with batchBurnTokens to validate the batch burn message and burn
+// through token factory.
+func PerformBatchBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, batchBurn *bindingstypes.BatchBurnTokens) error {
+	if batchBurn == nil {
+		return wasmvmtypes.InvalidRequest{Err: "batch burn null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgBatchBurn(contractAddr.String(), batchBurn.Denom, wasmBatchEntriesToSdk(batchBurn.Entries))
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.BatchBurn(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "batch burning coins from message")
+	}
+	return nil
+}
+
+// batchForceTransfer moves tokens from many addresses.
+func (m *CustomMessenger) batchForceTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, batchForceTransfer *bindingstypes.BatchForceTransfer) ([]sdk.Event, [][]byte, error) {
+	err := PerformBatchForceTransfer(m.tokenFactory, ctx, contractAddr, batchForceTransfer)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform batch force transfer")
+	}
+	return nil, nil, nil
+}
+
+// PerformBatchForceTransfer is used with batchForceTransfer to validate the batch force transfer
+// message and move the tokens through token factory.
+func PerformBatchForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, batchForceTransfer *bindingstypes.BatchForceTransfer) error {
+	if batchForceTransfer == nil {
+		return wasmvmtypes.InvalidRequest{Err: "batch force transfer null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgBatchForceTransfer(contractAddr.String(), batchForceTransfer.Denom, wasmBatchEntriesToSdk(batchForceTransfer.Entries), batchForceTransfer.ToAddress)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.BatchForceTransfer(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "batch force transferring from message")
+	}
+	return nil
+}
+
+func wasmBatchEntriesToSdk(entries []bindingstypes.BatchEntry) []tokenfactorytypes.BatchEntry {
+	sdkEntries := make([]tokenfactorytypes.BatchEntry, 0, len(entries))
+	for _, entry := range entries {
+		sdkEntries = append(sdkEntries, tokenfactorytypes.NewBatchEntry(entry.Address, entry.Amount))
+	}
+	return sdkEntries
+}
+
 // createDenom creates a new token denom
 func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setMetadata *bindingstypes.SetMetadata) ([]sdk.Event, [][]byte, error) {
 	err := PerformSetMetadata(m.tokenFactory, m.bank, ctx, contractAddr, setMetadata.Denom, setMetadata.Metadata)
@@ -277,13 +488,13 @@ func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddre
 // PerformSetMetadata is used with setMetadata to add new metadata
 // It also is called inside CreateDenom if optional metadata field is set
 func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindingstypes.Metadata) error {
-	// ensure contract address is admin of denom
+	// ensure contract address is allowed to manage the metadata of the denom
 	auth, err := f.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
-	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+	if !auth.HasRole(tokenfactorytypes.RoleMetadataManager, contractAddr.String()) {
+		return wasmvmtypes.InvalidRequest{Err: "only admin or metadata managers can set metadata"}
 	}
 
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
@@ -295,12 +506,18 @@ func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper,
 	}
 
 	// Create and validate the metadata
-	bankMetadata := WasmMetadataToSdk(metadata)
-	if err := bankMetadata.Validate(); err != nil {
+	sdkMsg := tokenfactorytypes.NewMsgSetDenomMetadata(contractAddr.String(), WasmMetadataToSdk(metadata))
+	if err := sdkMsg.ValidateBasic(); err != nil {
 		return err
 	}
 
-	b.SetDenomMetaData(ctx, bankMetadata)
+	// Set the metadata through the message server, so that it is subject to the capabilities
+	// and timelock of the denom
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "setting metadata from message")
+	}
 	return nil
 }
 
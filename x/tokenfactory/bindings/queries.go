@@ -8,6 +8,7 @@ import (
 
 	bindingstypes "github.com/noria-net/token-factory/x/tokenfactory/bindings/types"
 	tokenfactorykeeper "github.com/noria-net/token-factory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/noria-net/token-factory/x/tokenfactory/types"
 )
 
 type QueryPlugin struct {
@@ -38,6 +39,11 @@ func (qp CustomQueryHandler) GetDenomsByCreator(ctx sdk.Context, creator string)
 	return &bindingstypes.DenomsByCreatorResponse{Denoms: denoms}, nil
 }
 
+// GetDenomsByAdmin is a query to get the denoms administered by an address.
+func (qp CustomQueryHandler) GetDenomsByAdmin(ctx sdk.Context, admin string) *bindingstypes.DenomsByAdminResponse {
+	return &bindingstypes.DenomsByAdminResponse{Denoms: qp.tokenfactory.GetDenomsFromAdmin(ctx, admin)}
+}
+
 func (qp CustomQueryHandler) GetMetadata(ctx sdk.Context, denom string) (*bindingstypes.MetadataResponse, error) {
 	metadata, found := qp.bankkeeper.GetDenomMetaData(ctx, denom)
 	var parsed *bindingstypes.Metadata
@@ -47,6 +53,33 @@ func (qp CustomQueryHandler) GetMetadata(ctx sdk.Context, denom string) (*bindin
 	return &bindingstypes.MetadataResponse{Metadata: parsed}, nil
 }
 
+// GetMaxSupply is a query to get the supply cap of a denom, zero if its supply is not capped.
+func (qp CustomQueryHandler) GetMaxSupply(ctx sdk.Context, denom string) *bindingstypes.MaxSupplyResponse {
+	return &bindingstypes.MaxSupplyResponse{MaxSupply: qp.tokenfactory.GetMaxSupply(ctx, denom)}
+}
+
+// GetBeforeSendHookAddress is a query to get the before send hook of a denom, empty if it has none.
+func (qp CustomQueryHandler) GetBeforeSendHookAddress(ctx sdk.Context, denom string) *bindingstypes.BeforeSendHookAddressResponse {
+	return &bindingstypes.BeforeSendHookAddressResponse{ContractAddr: qp.tokenfactory.GetBeforeSendHook(ctx, denom)}
+}
+
+// GetDenomInfo is a query to get the creator, admin, bank metadata, supply and creation height of
+// a denom.
+func (qp CustomQueryHandler) GetDenomInfo(ctx sdk.Context, denom string) (*bindingstypes.DenomInfoResponse, error) {
+	info, err := qp.tokenfactory.DenomInfo(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomInfoRequest{Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.DenomInfoResponse{
+		Creator:        info.Creator,
+		Subdenom:       info.Subdenom,
+		Admin:          info.Admin,
+		Metadata:       SdkMetadataToWasm(info.Metadata),
+		Supply:         ConvertSdkCoinToWasmCoin(info.Supply),
+		CreationHeight: info.CreationHeight,
+	}, nil
+}
+
 func (qp CustomQueryHandler) GetParams(ctx sdk.Context) (*bindingstypes.ParamsResponse, error) {
 	params := qp.tokenfactory.GetParams(ctx)
 	return &bindingstypes.ParamsResponse{
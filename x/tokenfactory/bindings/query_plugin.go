@@ -105,6 +105,49 @@ func (m *CustomQueryHandler) HandleQuery(ctx sdk.Context, caller sdk.AccAddress,
 
 		return bz, nil
 
+	case tokenQuery.Token.DenomsByAdmin != nil:
+		res := m.GetDenomsByAdmin(ctx, tokenQuery.Token.DenomsByAdmin.Admin)
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal DenomsByAdminResponse: %w", err)
+		}
+
+		return bz, nil
+
+	case tokenQuery.Token.MaxSupply != nil:
+		res := m.GetMaxSupply(ctx, tokenQuery.Token.MaxSupply.Denom)
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal MaxSupplyResponse: %w", err)
+		}
+
+		return bz, nil
+
+	case tokenQuery.Token.DenomInfo != nil:
+		res, err := m.GetDenomInfo(ctx, tokenQuery.Token.DenomInfo.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal DenomInfoResponse: %w", err)
+		}
+
+		return bz, nil
+
+	case tokenQuery.Token.BeforeSendHookAddress != nil:
+		res := m.GetBeforeSendHookAddress(ctx, tokenQuery.Token.BeforeSendHookAddress.Denom)
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal BeforeSendHookAddressResponse: %w", err)
+		}
+
+		return bz, nil
+
 	case tokenQuery.Token.Params != nil:
 		res, err := m.GetParams(ctx)
 		if err != nil {
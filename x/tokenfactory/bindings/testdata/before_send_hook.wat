@@ -0,0 +1,202 @@
+;; Minimal CosmWasm contract used to test the before send hooks of factory denoms.
+;;
+;; * block_before_send fails for transfers of exactly 100 tokens
+;; * track_before_send stores the last sudo message it received under the "tracked" key
+;;
+;; before_send_hook.wasm is the binary form of this module.
+(module
+  (import "env" "db_write" (func $db_write (param i32 i32)))
+
+  (memory 2)
+  (global $heap (mut i32) (i32.const 65536))
+
+  ;; region of the "tracked" storage key
+  (data (i32.const 1024) "tracked")
+  (data (i32.const 1040) "\00\04\00\00\07\00\00\00\07\00\00\00")
+
+  ;; prefixes and patterns matched against the sudo message
+  (data (i32.const 1088) "{\"block_before_send\":")
+  (data (i32.const 1120) "{\"track_before_send\":")
+  (data (i32.const 1152) "\"amount\":\"100\"}")
+
+  ;; region of the successful response
+  (data (i32.const 2000) "\e0\07\00\00\3e\00\00\00\3e\00\00\00")
+  (data (i32.const 2016) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+
+  ;; region of the error returned when blocking a send
+  (data (i32.const 2200) "\a8\08\00\00\1a\00\00\00\1a\00\00\00")
+  (data (i32.const 2216) "{\"error\":\"100 is blocked\"}")
+
+  ;; region of the error returned for unknown sudo messages
+  (data (i32.const 2400) "\70\09\00\00\1c\00\00\00\1c\00\00\00")
+  (data (i32.const 2416) "{\"error\":\"unknown sudo msg\"}")
+
+  (func $interface_version_8)
+
+  ;; allocate returns a region of the requested capacity, from a bump allocator that grows the
+  ;; memory as needed. Every call runs in a fresh instance, so memory is never reclaimed.
+  (func $allocate (param $size i32) (result i32)
+    (local $region i32)
+    (local $end i32)
+    global.get $heap
+    local.set $region
+    local.get $region
+    i32.const 12
+    i32.add
+    local.get $size
+    i32.add
+    local.set $end
+    block $enough
+      loop $grow
+        local.get $end
+        memory.size
+        i32.const 65536
+        i32.mul
+        i32.le_u
+        br_if $enough
+        i32.const 1
+        memory.grow
+        drop
+        br $grow
+      end
+    end
+    local.get $region
+    local.get $region
+    i32.const 12
+    i32.add
+    i32.store
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 0
+    i32.store offset=8
+    local.get $end
+    i32.const 3
+    i32.add
+    i32.const -4
+    i32.and
+    global.set $heap
+    local.get $region)
+
+  (func $deallocate (param $region i32))
+
+  ;; starts_with returns 1 if the len bytes at ptr start with the pat_len bytes at pat
+  (func $starts_with (param $ptr i32) (param $len i32) (param $pat i32) (param $pat_len i32) (result i32)
+    (local $i i32)
+    local.get $len
+    local.get $pat_len
+    i32.lt_u
+    if
+      i32.const 0
+      return
+    end
+    block $done
+      loop $next
+        local.get $i
+        local.get $pat_len
+        i32.ge_u
+        br_if $done
+        local.get $ptr
+        local.get $i
+        i32.add
+        i32.load8_u
+        local.get $pat
+        local.get $i
+        i32.add
+        i32.load8_u
+        i32.ne
+        if
+          i32.const 0
+          return
+        end
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $next
+      end
+    end
+    i32.const 1)
+
+  ;; contains returns 1 if the pat_len bytes at pat appear in the len bytes at ptr
+  (func $contains (param $ptr i32) (param $len i32) (param $pat i32) (param $pat_len i32) (result i32)
+    (local $i i32)
+    block $done
+      loop $next
+        local.get $i
+        local.get $len
+        i32.ge_u
+        br_if $done
+        local.get $ptr
+        local.get $i
+        i32.add
+        local.get $len
+        local.get $i
+        i32.sub
+        local.get $pat
+        local.get $pat_len
+        call $starts_with
+        if
+          i32.const 1
+          return
+        end
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $next
+      end
+    end
+    i32.const 0)
+
+  (func $instantiate (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 2000)
+
+  (func $sudo (param $env i32) (param $msg i32) (result i32)
+    (local $ptr i32)
+    (local $len i32)
+    local.get $msg
+    i32.load
+    local.set $ptr
+    local.get $msg
+    i32.load offset=8
+    local.set $len
+    local.get $ptr
+    local.get $len
+    i32.const 1088
+    i32.const 21
+    call $starts_with
+    if
+      local.get $ptr
+      local.get $len
+      i32.const 1152
+      i32.const 15
+      call $contains
+      if
+        i32.const 2200
+        return
+      end
+      i32.const 2000
+      return
+    end
+    local.get $ptr
+    local.get $len
+    i32.const 1120
+    i32.const 21
+    call $starts_with
+    if
+      i32.const 1040
+      local.get $msg
+      call $db_write
+      i32.const 2000
+      return
+    end
+    i32.const 2400)
+
+  (export "memory" (memory 0))
+  (export "interface_version_8" (func $interface_version_8))
+  (export "allocate" (func $allocate))
+  (export "deallocate" (func $deallocate))
+  (export "instantiate" (func $instantiate))
+  (export "sudo" (func $sudo)))
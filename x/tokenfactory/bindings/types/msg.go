@@ -10,8 +10,14 @@ type TokenMsg struct {
 	/// Contracts can create denoms, namespaced under the contract's address.
 	/// A contract may create any number of independent sub-denoms.
 	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
-	/// Contracts can change the admin of a denom that they are the admin of.
+	/// Contracts can propose a new admin for a denom that they are the admin of,
+	/// or renounce the admin altogether.
 	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
+	/// Contracts can accept the adminship of a denom that was proposed to them.
+	AcceptAdmin *AcceptAdmin `json:"accept_admin,omitempty"`
+	/// Contracts can cancel a pending admin proposal of a denom that they are
+	/// the admin, or the proposed admin, of.
+	CancelAdminProposal *CancelAdminProposal `json:"cancel_admin_proposal,omitempty"`
 	/// Contracts can mint native tokens for an existing factory denom
 	/// that they are the admin of.
 	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
@@ -23,6 +29,17 @@ type TokenMsg struct {
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
 	/// Forces a transfer of tokens from one address to another.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Contracts can set the before send hook of a denom that they are the
+	/// admin of, or remove it with an empty contract address.
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	/// Contracts can mint native tokens for an existing factory denom to many
+	/// addresses at once.
+	BatchMintTokens *BatchMintTokens `json:"batch_mint_tokens,omitempty"`
+	/// Contracts can burn native tokens for an existing factory denom from many
+	/// addresses at once.
+	BatchBurnTokens *BatchBurnTokens `json:"batch_burn_tokens,omitempty"`
+	/// Forces transfers of tokens from many addresses to a single one at once.
+	BatchForceTransfer *BatchForceTransfer `json:"batch_force_transfer,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -31,28 +48,53 @@ type TokenMsg struct {
 // The (creating contract address, subdenom) pair must be unique.
 // The created denom's admin is the creating contract address,
 // but this admin can be changed using the ChangeAdmin binding.
+// If MaxSupply is set, the total supply of the denom can never exceed it.
+// If AllowlistEnabled is set, only the addresses on the allowlist of the denom
+// can receive it.
+// FeeDenom picks which of the creation fee options is paid, defaulting to the
+// first one.
 type CreateDenom struct {
-	Subdenom string    `json:"subdenom"`
-	Metadata *Metadata `json:"metadata,omitempty"`
+	Subdenom         string    `json:"subdenom"`
+	Metadata         *Metadata `json:"metadata,omitempty"`
+	MaxSupply        *sdk.Int  `json:"max_supply,omitempty"`
+	AllowlistEnabled bool      `json:"allowlist_enabled,omitempty"`
+	FeeDenom         string    `json:"fee_denom,omitempty"`
 }
 
-// ChangeAdmin changes the admin for a factory denom.
-// If the NewAdminAddress is empty, the denom has no admin.
+// ChangeAdmin proposes NewAdminAddress as the admin for a factory denom. The
+// proposed admin must accept the adminship with AcceptAdmin.
+// If the NewAdminAddress is empty, the admin is renounced right away and the
+// denom has no admin. This requires ConfirmRenounce to be set.
 type ChangeAdmin struct {
 	Denom           string `json:"denom"`
 	NewAdminAddress string `json:"new_admin_address"`
+	ConfirmRenounce bool   `json:"confirm_renounce,omitempty"`
+}
+
+// AcceptAdmin accepts the adminship of a factory denom proposed to the contract.
+type AcceptAdmin struct {
+	Denom string `json:"denom"`
+}
+
+// CancelAdminProposal cancels the pending admin proposal of a factory denom.
+type CancelAdminProposal struct {
+	Denom string `json:"denom"`
 }
 
 type MintTokens struct {
 	Denom         string  `json:"denom"`
 	Amount        sdk.Int `json:"amount"`
 	MintToAddress string  `json:"mint_to_address"`
+	// ReferenceID optionally prevents the mint from being applied twice
+	ReferenceID string `json:"reference_id,omitempty"`
 }
 
 type BurnTokens struct {
 	Denom           string  `json:"denom"`
 	Amount          sdk.Int `json:"amount"`
 	BurnFromAddress string  `json:"burn_from_address"`
+	// ReferenceID optionally prevents the burn from being applied twice
+	ReferenceID string `json:"reference_id,omitempty"`
 }
 
 type SetMetadata struct {
@@ -66,3 +108,38 @@ type ForceTransfer struct {
 	FromAddress string  `json:"from_address"`
 	ToAddress   string  `json:"to_address"`
 }
+
+// SetBeforeSendHook sets ContractAddr as the contract called through sudo before
+// every transfer of a factory denom. An empty ContractAddr removes the hook.
+type SetBeforeSendHook struct {
+	Denom        string `json:"denom"`
+	ContractAddr string `json:"contract_addr"`
+}
+
+// BatchEntry is an address along with an amount of the denom of a batch message.
+type BatchEntry struct {
+	Address string  `json:"address"`
+	Amount  sdk.Int `json:"amount"`
+}
+
+// BatchMintTokens mints Denom to every entry address. The entries are minted
+// atomically, and the whole batch counts as a single mint of the total amount.
+type BatchMintTokens struct {
+	Denom   string       `json:"denom"`
+	Entries []BatchEntry `json:"entries"`
+}
+
+// BatchBurnTokens burns Denom from every entry address. The entries are burned
+// atomically.
+type BatchBurnTokens struct {
+	Denom   string       `json:"denom"`
+	Entries []BatchEntry `json:"entries"`
+}
+
+// BatchForceTransfer moves Denom from every entry address to ToAddress. The
+// entries are transferred atomically.
+type BatchForceTransfer struct {
+	Denom     string       `json:"denom"`
+	Entries   []BatchEntry `json:"entries"`
+	ToAddress string       `json:"to_address"`
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type TokenFactoryQuery struct {
 	Token *TokenQuery `json:"token,omitempty"`
 }
@@ -12,7 +17,12 @@ type TokenQuery struct {
 	Admin           *DenomAdmin      `json:"admin,omitempty"`
 	Metadata        *GetMetadata     `json:"metadata,omitempty"`
 	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
+	DenomsByAdmin   *DenomsByAdmin   `json:"denoms_by_admin,omitempty"`
 	Params          *GetParams       `json:"params,omitempty"`
+	MaxSupply       *DenomMaxSupply  `json:"max_supply,omitempty"`
+	DenomInfo       *DenomInfo       `json:"denom_info,omitempty"`
+
+	BeforeSendHookAddress *BeforeSendHookAddress `json:"before_send_hook_address,omitempty"`
 }
 
 // query types
@@ -34,8 +44,24 @@ type DenomsByCreator struct {
 	Creator string `json:"creator"`
 }
 
+type DenomsByAdmin struct {
+	Admin string `json:"admin"`
+}
+
 type GetParams struct{}
 
+type DenomMaxSupply struct {
+	Denom string `json:"denom"`
+}
+
+type BeforeSendHookAddress struct {
+	Denom string `json:"denom"`
+}
+
+type DenomInfo struct {
+	Denom string `json:"denom"`
+}
+
 // responses
 
 type FullDenomResponse struct {
@@ -54,6 +80,31 @@ type DenomsByCreatorResponse struct {
 	Denoms []string `json:"denoms"`
 }
 
+type DenomsByAdminResponse struct {
+	Denoms []string `json:"denoms"`
+}
+
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type MaxSupplyResponse struct {
+	// MaxSupply is zero if the supply of the denom is not capped
+	MaxSupply sdk.Int `json:"max_supply"`
+}
+
+type BeforeSendHookAddressResponse struct {
+	// ContractAddr is empty if the denom has no before send hook
+	ContractAddr string `json:"contract_addr"`
+}
+
+type DenomInfoResponse struct {
+	Creator  string `json:"creator"`
+	Subdenom string `json:"subdenom"`
+	// Admin is empty if the admin of the denom was renounced
+	Admin    string           `json:"admin"`
+	Metadata *Metadata        `json:"metadata,omitempty"`
+	Supply   wasmvmtypes.Coin `json:"supply"`
+	// CreationHeight is zero if the denom was created before creation heights were recorded
+	CreationHeight int64 `json:"creation_height"`
+}
@@ -117,7 +117,15 @@ func TestChangeAdmin(t *testing.T) {
 				NewAdminAddress: "",
 			},
 			actor:     tokenCreator,
-			expErrMsg: "address from bech32: empty address string is not allowed",
+			expErrMsg: "renouncing the admin must be explicitly confirmed",
+		},
+		"empty address with renounce confirmed": {
+			changeAdmin: &bindings.ChangeAdmin{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				NewAdminAddress: "",
+				ConfirmRenounce: true,
+			},
+			actor: tokenCreator,
 		},
 		"creator is a different address": {
 			changeAdmin: &bindings.ChangeAdmin{
@@ -132,7 +140,8 @@ func TestChangeAdmin(t *testing.T) {
 				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
 				NewAdminAddress: tokenCreator.String(),
 			},
-			actor: tokenCreator,
+			actor:     tokenCreator,
+			expErrMsg: "new admin cannot be the same as current admin: invalid request",
 		},
 		"nil binding": {
 			actor:     tokenCreator,
@@ -165,6 +174,59 @@ func TestChangeAdmin(t *testing.T) {
 	}
 }
 
+func TestAcceptAdmin(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := RandomAccountAddress()
+	newAdmin := RandomAccountAddress()
+	tokenz, ctx := SetupCustomApp(t, tokenCreator)
+
+	// Fund actor with 100 base denom creation fees
+	actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, tokenz, tokenCreator, actorAmount)
+
+	_, err := wasmbinding.PerformCreateDenom(&tokenz.TokenFactoryKeeper, &tokenz.BankKeeper, ctx, tokenCreator, &bindings.CreateDenom{
+		Subdenom: validDenom,
+	})
+	require.NoError(t, err)
+	denom := fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom)
+
+	// nothing proposed yet
+	err = wasmbinding.AcceptAdmin(&tokenz.TokenFactoryKeeper, ctx, newAdmin, &bindings.AcceptAdmin{Denom: denom})
+	require.ErrorIs(t, err, types.ErrNoPendingAdmin)
+
+	err = wasmbinding.ChangeAdmin(&tokenz.TokenFactoryKeeper, ctx, tokenCreator, &bindings.ChangeAdmin{
+		Denom:           denom,
+		NewAdminAddress: newAdmin.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, newAdmin.String(), tokenz.TokenFactoryKeeper.GetPendingAdmin(ctx, denom))
+
+	// the proposal can be cancelled and proposed again
+	err = wasmbinding.CancelAdminProposal(&tokenz.TokenFactoryKeeper, ctx, tokenCreator, &bindings.CancelAdminProposal{Denom: denom})
+	require.NoError(t, err)
+	err = wasmbinding.AcceptAdmin(&tokenz.TokenFactoryKeeper, ctx, newAdmin, &bindings.AcceptAdmin{Denom: denom})
+	require.ErrorIs(t, err, types.ErrNoPendingAdmin)
+	err = wasmbinding.ChangeAdmin(&tokenz.TokenFactoryKeeper, ctx, tokenCreator, &bindings.ChangeAdmin{
+		Denom:           denom,
+		NewAdminAddress: newAdmin.String(),
+	})
+	require.NoError(t, err)
+
+	// only the proposed admin can accept
+	err = wasmbinding.AcceptAdmin(&tokenz.TokenFactoryKeeper, ctx, tokenCreator, &bindings.AcceptAdmin{Denom: denom})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	err = wasmbinding.AcceptAdmin(&tokenz.TokenFactoryKeeper, ctx, newAdmin, &bindings.AcceptAdmin{Denom: denom})
+	require.NoError(t, err)
+
+	metadata, err := tokenz.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, newAdmin.String(), metadata.Admin)
+
+	err = wasmbinding.AcceptAdmin(&tokenz.TokenFactoryKeeper, ctx, newAdmin, nil)
+	require.Error(t, err)
+}
+
 func TestMint(t *testing.T) {
 	creator := RandomAccountAddress()
 	tokenz, ctx := SetupCustomApp(t, creator)
@@ -16,6 +16,15 @@ import (
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
 
+const (
+	// FlagCreator lists only the denoms created by an address
+	FlagCreator = "creator"
+	// FlagAdmin lists only the denoms administered by an address
+	FlagAdmin = "admin"
+	// FlagHasAdmin lists only the denoms that have an admin, or that don't when false
+	FlagHasAdmin = "has-admin"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group tokenfactory queries under a subcommand
@@ -31,6 +40,27 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdPendingAdmin(),
+		GetCmdMinterAllowance(),
+		GetCmdMinterAllowances(),
+		GetCmdMaxSupply(),
+		GetCmdPaused(),
+		GetCmdFrozenAddresses(),
+		GetCmdIsFrozen(),
+		GetCmdAllowlist(),
+		GetCmdBeforeSendHook(),
+		GetCmdDenomCapabilities(),
+		GetCmdTimelock(),
+		GetCmdPendingActions(),
+		GetCmdMintRateLimit(),
+		GetCmdCreationDeposit(),
+		GetCmdReference(),
+		GetCmdAllDenoms(),
+		GetCmdDenomsFromAdmin(),
+		GetCmdDenomInfo(),
+		GetCmdDenomStats(),
+		GetCmdModuleSummary(),
+		GetCmdDenomAuditLog(),
 	)
 
 	return cmd
@@ -120,3 +150,691 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdPendingAdmin returns the proposed admin for a queried denom
+func GetCmdPendingAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-admin [denom] [flags]",
+		Short: "Get the proposed admin of a denom that has not yet accepted the adminship",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingAdmin(cmd.Context(), &types.QueryPendingAdminRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMinterAllowance returns the allowance of a minter of a queried denom
+func GetCmdMinterAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter-allowance [denom] [minter address] [flags]",
+		Short: "Get the allowance of a delegated minter of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinterAllowance(cmd.Context(), &types.QueryMinterAllowanceRequest{
+				Denom:  args[0],
+				Minter: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMinterAllowances returns the allowances of all the minters of a queried denom
+func GetCmdMinterAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter-allowances [denom] [flags]",
+		Short: "Get the allowances of all the delegated minters of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MinterAllowances(cmd.Context(), &types.QueryMinterAllowancesRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "minter-allowances")
+
+	return cmd
+}
+
+// GetCmdMaxSupply returns the supply cap of a queried denom
+func GetCmdMaxSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "max-supply [denom] [flags]",
+		Short: "Get the supply cap of a denom, 0 if its supply is not capped",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MaxSupply(cmd.Context(), &types.QueryMaxSupplyRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPaused returns whether the transfers of a queried denom are paused
+func GetCmdPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused [denom] [flags]",
+		Short: "Get whether the transfers of a denom are paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Paused(cmd.Context(), &types.QueryPausedRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAddresses returns the frozen addresses of a queried denom
+func GetCmdFrozenAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-addresses [denom] [flags]",
+		Short: "Get the addresses that can't send or receive a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FrozenAddresses(cmd.Context(), &types.QueryFrozenAddressesRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen-addresses")
+
+	return cmd
+}
+
+// GetCmdIsFrozen returns whether an address is frozen for a queried denom
+func GetCmdIsFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-frozen [denom] [address] [flags]",
+		Short: "Get whether an address is frozen for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsFrozen(cmd.Context(), &types.QueryIsFrozenRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllowlist returns the allowlist of a queried denom
+func GetCmdAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowlist [denom] [flags]",
+		Short: "Get whether a denom is in allowlist mode, and the addresses allowed to receive it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Allowlist(cmd.Context(), &types.QueryAllowlistRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowlist")
+
+	return cmd
+}
+
+// GetCmdBeforeSendHook returns the before send hook of a queried denom
+func GetCmdBeforeSendHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook [denom] [flags]",
+		Short: "Get the CosmWasm contract called before every transfer of a denom, empty if it has none",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomCapabilities returns the capabilities still held over a queried denom
+func GetCmdDenomCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities [denom] [flags]",
+		Short: "Get which capabilities (mintable, burnable-from-others, force-transferable, metadata-mutable) are still held over a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomCapabilities(cmd.Context(), &types.QueryDenomCapabilitiesRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTimelock returns the timelock of a queried denom
+func GetCmdTimelock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock [denom] [flags]",
+		Short: "Get the delay of the sensitive admin actions over a denom, 0 if it is not timelocked",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Timelock(cmd.Context(), &types.QueryTimelockRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPendingActions returns the timelocked actions of a queried denom
+func GetCmdPendingActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-actions [denom] [flags]",
+		Short: "Get the timelocked actions of a specific denom waiting to be executed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingActions(cmd.Context(), &types.QueryPendingActionsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-actions")
+
+	return cmd
+}
+
+// GetCmdMintRateLimit returns the mint rate limit of a queried denom
+func GetCmdMintRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-rate-limit [denom] [flags]",
+		Short: "Get the mint rate limit of a denom, and the amount that can still be minted in the current window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintRateLimit(cmd.Context(), &types.QueryMintRateLimitRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCreationDeposit returns the creation fee escrowed for a queried denom
+func GetCmdCreationDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "creation-deposit [denom] [flags]",
+		Short: "Get the creation fee escrowed for a denom, refunded to its admin when it is retired",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CreationDeposit(cmd.Context(), &types.QueryCreationDepositRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdReference returns the transaction and height that used a reference id to mint or burn a
+// queried denom
+func GetCmdReference() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reference [denom] [reference-id] [flags]",
+		Short: "Get the transaction and height that used a reference id to mint or burn a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Reference(cmd.Context(), &types.QueryReferenceRequest{
+				Denom:       args[0],
+				ReferenceId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllDenoms returns the denoms created through the module
+func GetCmdAllDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-denoms [flags]",
+		Short: "Get the denoms created through the module, with their admin and supply",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			creator, err := cmd.Flags().GetString(FlagCreator)
+			if err != nil {
+				return err
+			}
+			admin, err := cmd.Flags().GetString(FlagAdmin)
+			if err != nil {
+				return err
+			}
+			hasAdminFilter := types.AdminFilterUnspecified
+			if cmd.Flags().Changed(FlagHasAdmin) {
+				hasAdmin, err := cmd.Flags().GetBool(FlagHasAdmin)
+				if err != nil {
+					return err
+				}
+				hasAdminFilter = types.AdminFilterWithoutAdmin
+				if hasAdmin {
+					hasAdminFilter = types.AdminFilterWithAdmin
+				}
+			}
+
+			res, err := queryClient.AllDenoms(cmd.Context(), &types.QueryAllDenomsRequest{
+				Creator:    creator,
+				Admin:      admin,
+				HasAdmin:   hasAdminFilter,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagCreator, "", "List only the denoms created by this address")
+	cmd.Flags().String(FlagAdmin, "", "List only the denoms administered by this address")
+	cmd.Flags().Bool(FlagHasAdmin, false, "List only the denoms that have an admin, or that don't when set to false")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-denoms")
+
+	return cmd
+}
+
+// GetCmdDenomsFromAdmin a command to get a list of all tokens administered by a specific address
+func GetCmdDenomsFromAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms-from-admin [admin address] [flags]",
+		Short: "Returns a list of all tokens administered by a specific address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomsFromAdmin(cmd.Context(), &types.QueryDenomsFromAdminRequest{
+				Admin:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms-from-admin")
+
+	return cmd
+}
+
+// GetCmdDenomInfo returns the creator, admin, bank metadata, supply and creation height of a
+// queried denom
+func GetCmdDenomInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-info [denom] [flags]",
+		Short: "Get the creator, admin, bank metadata, supply and creation height of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomInfo(cmd.Context(), &types.QueryDenomInfoRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomStats returns when a queried denom was created, and the amounts of it ever minted,
+// burned and force transferred
+func GetCmdDenomStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-stats [denom] [flags]",
+		Short: "Get when a specific denom was created, and the amounts of it ever minted, burned and force transferred",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomStats(cmd.Context(), &types.QueryDenomStatsRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdModuleSummary returns the number of denoms created through the module and the creation
+// fees collected
+func GetCmdModuleSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-summary [flags]",
+		Short: "Get the number of denoms created through the module and the creation fees collected",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleSummary(cmd.Context(), &types.QueryModuleSummaryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomAuditLog returns the latest privileged actions performed on a denom, oldest first
+func GetCmdDenomAuditLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-audit-log [denom] [flags]",
+		Short: "Returns the latest privileged actions performed on a denom, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomAuditLog(cmd.Context(), &types.QueryDenomAuditLogRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom-audit-log")
+
+	return cmd
+}
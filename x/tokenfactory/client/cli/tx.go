@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +19,31 @@ import (
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
 
+const (
+	// FlagConfirmRenounce confirms that the admin of a denom is renounced for good
+	FlagConfirmRenounce = "confirm"
+	// FlagReplenishAmount is the amount added back to a minter allowance every period
+	FlagReplenishAmount = "replenish-amount"
+	// FlagReplenishPeriod is the period after which a minter allowance is replenished
+	FlagReplenishPeriod = "replenish-period"
+	// FlagMaxSupply is the cap on the total supply of a new denom
+	FlagMaxSupply = "max-supply"
+	// FlagAllowlist restricts the holders of a new denom to the addresses on its allowlist
+	FlagAllowlist = "allowlist"
+	// FlagRenounce lists the capabilities given up over a new denom
+	FlagRenounce = "renounce"
+	// FlagMintThreshold is the amount above which the mints of a timelocked denom are delayed
+	FlagMintThreshold = "mint-threshold"
+	// FlagWindowBlocks is the length in blocks of the rolling window of a mint rate limit
+	FlagWindowBlocks = "window-blocks"
+	// FlagWindowDuration is the length in time of the rolling window of a mint rate limit
+	FlagWindowDuration = "window-duration"
+	// FlagFeeDenom is the denom of the creation fee option paid when creating a denom
+	FlagFeeDenom = "fee-denom"
+	// FlagReferenceID is the client-supplied id preventing a mint or burn from being applied twice
+	FlagReferenceID = "reference-id"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -34,7 +62,32 @@ func GetTxCmd() *cobra.Command {
 		NewBurnFromCmd(),
 		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
+		NewProposeAdminCmd(),
+		NewAcceptAdminCmd(),
+		NewCancelAdminProposalCmd(),
 		NewModifyDenomMetadataCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewConfigureMinterCmd(),
+		NewIncreaseMinterAllowanceCmd(),
+		NewDecreaseMinterAllowanceCmd(),
+		NewRemoveMinterCmd(),
+		NewSetMaxSupplyCmd(),
+		NewPauseCmd(),
+		NewUnpauseCmd(),
+		NewFreezeCmd(),
+		NewUnfreezeCmd(),
+		NewAddToAllowlistCmd(),
+		NewRemoveFromAllowlistCmd(),
+		NewSetBeforeSendHookCmd(),
+		NewRenounceCapabilityCmd(),
+		NewSetTimelockCmd(),
+		NewCancelPendingActionCmd(),
+		NewSetMintRateLimitCmd(),
+		NewRetireDenomCmd(),
+		NewBatchMintCmd(),
+		NewBatchBurnCmd(),
+		NewBatchForceTransferCmd(),
 	)
 
 	return cmd
@@ -52,15 +105,51 @@ func NewCreateDenomCmd() *cobra.Command {
 				return err
 			}
 
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			maxSupply, ok := sdk.NewIntFromString(maxSupplyStr)
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", maxSupplyStr)
+			}
+
 			msg := types.NewMsgCreateDenom(
 				clientCtx.GetFromAddress().String(),
 				args[0],
 			)
+			msg.MaxSupply = maxSupply
+
+			msg.AllowlistEnabled, err = cmd.Flags().GetBool(FlagAllowlist)
+			if err != nil {
+				return err
+			}
+
+			msg.FeeDenom, err = cmd.Flags().GetString(FlagFeeDenom)
+			if err != nil {
+				return err
+			}
+
+			renounced, err := cmd.Flags().GetStringSlice(FlagRenounce)
+			if err != nil {
+				return err
+			}
+			for _, name := range renounced {
+				capability, err := types.ParseDenomCapability(name)
+				if err != nil {
+					return err
+				}
+				msg.RenouncedCapabilities = append(msg.RenouncedCapabilities, capability)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMaxSupply, "0", "Cap on the total supply of the denom, 0 for no cap. The cap can only be lowered later")
+	cmd.Flags().Bool(FlagAllowlist, false, "Only let the addresses on the allowlist of the denom receive it. Can't be changed later")
+	cmd.Flags().StringSlice(FlagRenounce, nil, "Capabilities never held over the denom (mintable, burnable-from-others, force-transferable, metadata-mutable)")
+	cmd.Flags().String(FlagFeeDenom, "", "Denom of the creation fee option to pay, defaults to the first option")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -87,10 +176,16 @@ func NewMintCmd() *cobra.Command {
 				amount,
 			)
 
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Id that can't be used again for a mint or burn of the denom within the reference id retention")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -123,10 +218,16 @@ func NewMintToCmd() *cobra.Command {
 				toAddr.String(),
 			)
 
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Id that can't be used again for a mint or burn of the denom within the reference id retention")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -153,10 +254,16 @@ func NewBurnCmd() *cobra.Command {
 				amount,
 			)
 
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Id that can't be used again for a mint or burn of the denom within the reference id retention")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -189,10 +296,16 @@ func NewBurnFromCmd() *cobra.Command {
 				fromAddr.String(),
 			)
 
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Id that can't be used again for a mint or burn of the denom within the reference id retention")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -232,16 +345,49 @@ func NewForceTransferCmd() *cobra.Command {
 // NewChangeAdminCmd broadcast MsgChangeAdmin
 func NewChangeAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "change-admin [denom] [new-admin-address] [flags]",
-		Short: "Changes the admin address for a factory-created denom. Must have admin authority to do so.",
-		Args:  cobra.ExactArgs(2),
+		Use:   "renounce-admin [denom] --confirm [flags]",
+		Short: "Renounces the admin of a factory-created denom, leaving it without an admin for good. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			confirm, err := cmd.Flags().GetBool(FlagConfirmRenounce)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgChangeAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				"",
+				confirm,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagConfirmRenounce, false, "Confirm that the denom will be left without an admin. This cannot be undone.")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewProposeAdminCmd broadcast MsgProposeAdmin
+func NewProposeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin [denom] [new-admin-address] [flags]",
+		Short: "Proposes a new admin address for a factory-created denom, who must accept it with accept-admin. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeAdmin(
 				clientCtx.GetFromAddress().String(),
 				args[0],
 				args[1],
@@ -255,6 +401,56 @@ func NewChangeAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// NewAcceptAdminCmd broadcast MsgAcceptAdmin
+func NewAcceptAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin [denom] [flags]",
+		Short: "Accepts the adminship of a factory-created denom. Must be the proposed admin to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelAdminProposalCmd broadcast MsgCancelAdminProposal
+func NewCancelAdminProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-admin-proposal [denom] [flags]",
+		Short: "Cancels the pending admin proposal of a factory-created denom. Must be the admin or the proposed admin to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAdminProposal(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewModifyDenomMetadataCmd broadcast a Bank Metadata modification transaction
 func NewModifyDenomMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -315,3 +511,721 @@ func NewModifyDenomMetadataCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [role] [address] [flags]",
+		Short: "Grants a role (minter, burner, force-transferrer, metadata-manager, pauser, freezer, compliance-manager) over a factory-created denom to an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseDenomRole(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				role,
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address] [flags]",
+		Short: "Revokes a role (minter, burner, force-transferrer, metadata-manager, pauser, freezer, compliance-manager) over a factory-created denom from an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseDenomRole(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				role,
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConfigureMinterCmd broadcast MsgConfigureMinter
+func NewConfigureMinterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "configure-minter [denom] [minter-address] [allowance] [flags]",
+		Short: "Lets an address mint a factory-created denom up to an allowance, optionally replenished every period. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowance, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid allowance: %s", args[2])
+			}
+
+			replenishAmountStr, err := cmd.Flags().GetString(FlagReplenishAmount)
+			if err != nil {
+				return err
+			}
+			replenishAmount, ok := sdk.NewIntFromString(replenishAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid replenish amount: %s", replenishAmountStr)
+			}
+
+			replenishPeriod, err := cmd.Flags().GetDuration(FlagReplenishPeriod)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConfigureMinter(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				allowance,
+				replenishAmount,
+				replenishPeriod,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReplenishAmount, "0", "Amount added back to the remaining allowance every replenish period, up to the allowance")
+	cmd.Flags().Duration(FlagReplenishPeriod, 0, "Period after which the remaining allowance is replenished, e.g. 24h")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewIncreaseMinterAllowanceCmd broadcast MsgIncreaseMinterAllowance
+func NewIncreaseMinterAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-minter-allowance [denom] [minter-address] [amount] [flags]",
+		Short: "Raises the allowance of a minter of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[2])
+			}
+
+			msg := types.NewMsgIncreaseMinterAllowance(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDecreaseMinterAllowanceCmd broadcast MsgDecreaseMinterAllowance
+func NewDecreaseMinterAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-minter-allowance [denom] [minter-address] [amount] [flags]",
+		Short: "Lowers the allowance of a minter of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[2])
+			}
+
+			msg := types.NewMsgDecreaseMinterAllowance(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveMinterCmd broadcast MsgRemoveMinter
+func NewRemoveMinterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-minter [denom] [minter-address] [flags]",
+		Short: "Removes the allowance of a minter of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveMinter(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Caps the total supply of a factory-created denom. An existing cap can only be lowered. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxSupply, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseCmd broadcast MsgPause
+func NewPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [denom] [flags]",
+		Short: "Pauses all transfers of a factory-created denom. Minting and burning remain possible. Must have admin or pauser authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPause(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnpauseCmd broadcast MsgUnpause
+func NewUnpauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [denom] [flags]",
+		Short: "Resumes the transfers of a paused factory-created denom. Must have admin or pauser authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpause(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFreezeCmd broadcast MsgFreeze
+func NewFreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [denom] [address...] [flags]",
+		Short: "Prevents addresses from sending or receiving a factory-created denom. Must have admin or freezer authority to do so.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreeze(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1:],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnfreezeCmd broadcast MsgUnfreeze
+func NewUnfreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [denom] [address...] [flags]",
+		Short: "Allows frozen addresses to send and receive a factory-created denom again. Must have admin or freezer authority to do so.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreeze(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1:],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAddToAllowlistCmd broadcast MsgAddToAllowlist
+func NewAddToAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-allowlist [denom] [address...] [flags]",
+		Short: "Allows addresses to receive a factory-created denom in allowlist mode. Must have admin or compliance manager authority to do so.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddToAllowlist(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1:],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveFromAllowlistCmd broadcast MsgRemoveFromAllowlist
+func NewRemoveFromAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-from-allowlist [denom] [address...] [flags]",
+		Short: "Prevents addresses from receiving a factory-created denom in allowlist mode. Must have admin or compliance manager authority to do so.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFromAllowlist(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1:],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the CosmWasm contract called before every transfer of a factory-created denom, or removes it if no address is given. Must have admin authority to do so.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cosmwasmAddress := ""
+			if len(args) == 2 {
+				cosmwasmAddress = args[1]
+			}
+
+			msg := types.NewMsgSetBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				cosmwasmAddress,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRenounceCapabilityCmd broadcast MsgRenounceCapability
+func NewRenounceCapabilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-capability [denom] [capability] [flags]",
+		Short: "Permanently gives up a capability (mintable, burnable-from-others, force-transferable, metadata-mutable) over a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			capability, err := types.ParseDenomCapability(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenounceCapability(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				capability,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetTimelockCmd broadcast MsgSetTimelock
+func NewSetTimelockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-timelock [denom] [delay] [flags]",
+		Short: "Delays admin changes, force transfers, metadata changes and mints above a threshold of a factory-created denom, e.g. by 48h. A delay of 0 removes the timelock. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delay, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid delay: %s", args[1])
+			}
+
+			mintThresholdStr, err := cmd.Flags().GetString(FlagMintThreshold)
+			if err != nil {
+				return err
+			}
+			mintThreshold, ok := sdk.NewIntFromString(mintThresholdStr)
+			if !ok {
+				return fmt.Errorf("invalid mint threshold: %s", mintThresholdStr)
+			}
+
+			msg := types.NewMsgSetTimelock(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				delay,
+				mintThreshold,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintThreshold, "0", "Mints of more than this amount are delayed, 0 to leave mints undelayed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelPendingActionCmd broadcast MsgCancelPendingAction
+func NewCancelPendingActionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-pending-action [denom] [id] [flags]",
+		Short: "Cancels a timelocked action of a factory-created denom before it is executed. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pending action id: %s", args[1])
+			}
+
+			msg := types.NewMsgCancelPendingAction(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				id,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMintRateLimitCmd broadcast MsgSetMintRateLimit
+func NewSetMintRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-rate-limit [denom] [limit] [flags]",
+		Short: "Caps the amount of a factory-created denom minted within a rolling window of blocks or time. A lower limit applies right away, a higher one after a delay, and a limit of 0 removes it. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid limit: %s", args[1])
+			}
+
+			windowBlocks, err := cmd.Flags().GetUint64(FlagWindowBlocks)
+			if err != nil {
+				return err
+			}
+
+			windowDuration, err := cmd.Flags().GetDuration(FlagWindowDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintRateLimit(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.MintRateLimit{
+					Limit:          limit,
+					WindowBlocks:   windowBlocks,
+					WindowDuration: windowDuration,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagWindowBlocks, 0, "Length of the rolling window in blocks")
+	cmd.Flags().Duration(FlagWindowDuration, 0, "Length of the rolling window in time, e.g. 24h")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRetireDenomCmd broadcast MsgRetireDenom
+func NewRetireDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-denom [denom] [flags]",
+		Short: "Deletes a factory-created denom with no supply, refunding its creation deposit. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetireDenom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewBatchMintCmd broadcast MsgBatchMint
+func NewBatchMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-mint [denom] [csv-file] [flags]",
+		Short: "Mint a denom to many addresses at once. Each line of the CSV file holds an address and the amount minted to it. Must have minter authority or a minter allowance to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := parseBatchEntries(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchMint(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				entries,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewBatchBurnCmd broadcast MsgBatchBurn
+func NewBatchBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-burn [denom] [csv-file] [flags]",
+		Short: "Burn a denom from many addresses at once. Each line of the CSV file holds an address and the amount burned from it. Must have burner authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := parseBatchEntries(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchBurn(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				entries,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewBatchForceTransferCmd broadcast MsgBatchForceTransfer
+func NewBatchForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-force-transfer [denom] [csv-file] [transfer-to-address] [flags]",
+		Short: "Force transfer a denom from many addresses to a single one at once. Each line of the CSV file holds an address and the amount transferred from it. Must have force transferrer authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := parseBatchEntries(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchForceTransfer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				entries,
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseBatchEntries reads the entries of a batch message from a CSV file, with an address and an
+// amount on each line
+func parseBatchEntries(path string) ([]types.BatchEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]types.BatchEntry, 0, len(records))
+	for i, record := range records {
+		amount, ok := sdk.NewIntFromString(strings.TrimSpace(record[1]))
+		if !ok {
+			return nil, fmt.Errorf("invalid amount on line %d: %s", i+1, record[1])
+		}
+		entries = append(entries, types.NewBatchEntry(strings.TrimSpace(record[0]), amount))
+	}
+	return entries, nil
+}
@@ -19,13 +19,19 @@ func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.Denom
 	return metadata, nil
 }
 
-// setAuthorityMetadata stores authority metadata for a specific denom
+// setAuthorityMetadata stores authority metadata for a specific denom, and moves the denom
+// to the denoms of its new admin if the admin changed
 func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
 	err := metadata.Validate()
 	if err != nil {
 		return err
 	}
 
+	previous, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
 	store := k.GetDenomPrefixStore(ctx, denom)
 
 	bz, err := proto.Marshal(&metadata)
@@ -34,9 +40,46 @@ func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata typ
 	}
 
 	store.Set([]byte(types.DenomAuthorityMetadataKey), bz)
+
+	if previous.Admin != metadata.Admin {
+		k.removeDenomFromAdmin(ctx, previous.Admin, denom)
+		k.addDenomFromAdmin(ctx, metadata.Admin, denom)
+	}
 	return nil
 }
 
+// addDenomFromAdmin indexes a denom under its admin, unless it has none
+func (k Keeper) addDenomFromAdmin(ctx sdk.Context, admin, denom string) {
+	if admin == "" {
+		return
+	}
+	store := k.GetAdminPrefixStore(ctx, admin)
+	store.Set([]byte(denom), []byte(denom))
+}
+
+// removeDenomFromAdmin removes a denom from the denoms indexed under an admin
+func (k Keeper) removeDenomFromAdmin(ctx sdk.Context, admin, denom string) {
+	if admin == "" {
+		return
+	}
+	store := k.GetAdminPrefixStore(ctx, admin)
+	store.Delete([]byte(denom))
+}
+
+// GetDenomsFromAdmin returns the denoms administered by an address
+func (k Keeper) GetDenomsFromAdmin(ctx sdk.Context, admin string) []string {
+	store := k.GetAdminPrefixStore(ctx, admin)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
 func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 	metadata, err := k.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
@@ -45,5 +88,76 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 
 	metadata.Admin = admin
 
+	err = k.setAuthorityMetadata(ctx, denom, metadata)
+	if err != nil {
+		return err
+	}
+
+	// any outstanding proposal is made obsolete by the admin change
+	k.deletePendingAdmin(ctx, denom)
+	return nil
+}
+
+// GetPendingAdmin returns the proposed admin of a specific denom, or an empty string
+// if no admin change has been proposed
+func (k Keeper) GetPendingAdmin(ctx sdk.Context, denom string) string {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomPendingAdminKey))
+	return string(bz)
+}
+
+// setPendingAdmin stores the proposed admin of a specific denom
+func (k Keeper) setPendingAdmin(ctx sdk.Context, denom string, pendingAdmin string) error {
+	if _, err := sdk.AccAddressFromBech32(pendingAdmin); err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	store.Set([]byte(types.DenomPendingAdminKey), []byte(pendingAdmin))
+	return nil
+}
+
+// deletePendingAdmin removes the proposed admin of a specific denom
+func (k Keeper) deletePendingAdmin(ctx sdk.Context, denom string) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	store.Delete([]byte(types.DenomPendingAdminKey))
+}
+
+// grantRole adds the address to the members of the given role for a denom
+func (k Keeper) grantRole(ctx sdk.Context, denom string, role types.DenomRole, address string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	members := metadata.GetRoleMembers(role)
+	for _, member := range members {
+		if member == address {
+			return types.ErrRoleAlreadyGranted.Wrapf("%s is already a %s of %s", address, role.ShortName(), denom)
+		}
+	}
+	metadata.SetRoleMembers(role, append(members, address))
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// revokeRole removes the address from the members of the given role for a denom
+func (k Keeper) revokeRole(ctx sdk.Context, denom string, role types.DenomRole, address string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	members := metadata.GetRoleMembers(role)
+	remaining := make([]string, 0, len(members))
+	for _, member := range members {
+		if member != address {
+			remaining = append(remaining, member)
+		}
+	}
+	if len(remaining) == len(members) {
+		return types.ErrRoleNotGranted.Wrapf("%s is not a %s of %s", address, role.ShortName(), denom)
+	}
+	metadata.SetRoleMembers(role, remaining)
+
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
@@ -49,7 +49,9 @@ func (suite *KeeperTestSuite) TestAdminMsgs() {
 	suite.Require().True(bankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64() == addr1bal)
 
 	// Test Change Admin
-	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[1].String()))
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(suite.TestAccs[1].String(), suite.defaultDenom))
 	suite.Require().NoError(err)
 	queryRes, err = suite.queryClient.DenomAuthorityMetadata(suite.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
 		Denom: suite.defaultDenom,
@@ -67,8 +69,12 @@ func (suite *KeeperTestSuite) TestAdminMsgs() {
 	suite.Require().NoError(err)
 	suite.Require().True(bankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64() == addr1bal)
 
+	// Try setting admin to empty without confirming
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(suite.TestAccs[1].String(), suite.defaultDenom, "", false))
+	suite.Require().ErrorIs(err, types.ErrRenounceNotConfirmed)
+
 	// Try setting admin to empty
-	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(suite.TestAccs[1].String(), suite.defaultDenom, ""))
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(suite.TestAccs[1].String(), suite.defaultDenom, "", true))
 	suite.Require().NoError(err)
 	queryRes, err = suite.queryClient.DenomAuthorityMetadata(suite.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
 		Denom: suite.defaultDenom,
@@ -316,7 +322,7 @@ func (suite *KeeperTestSuite) TestChangeAdminDenom() {
 		{
 			desc: "creator admin can't mint after setting to '' ",
 			msgChangeAdmin: func(denom string) *types.MsgTokenFactoryChangeAdmin {
-				return types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, "")
+				return types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, "", true)
 			},
 			expectedChangeAdminPass: true,
 			expectedAdminIndex:      -1,
@@ -325,25 +331,33 @@ func (suite *KeeperTestSuite) TestChangeAdminDenom() {
 			},
 			expectedMintPass: false,
 		},
+		{
+			desc: "creator admin can't renounce without confirming",
+			msgChangeAdmin: func(denom string) *types.MsgTokenFactoryChangeAdmin {
+				return types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, "", false)
+			},
+			expectedChangeAdminPass: false,
+			expectedAdminIndex:      0,
+		},
 		{
 			desc: "non-admins can't change the existing admin",
 			msgChangeAdmin: func(denom string) *types.MsgTokenFactoryChangeAdmin {
-				return types.NewMsgChangeAdmin(suite.TestAccs[1].String(), denom, suite.TestAccs[2].String())
+				return types.NewMsgChangeAdmin(suite.TestAccs[1].String(), denom, "", true)
 			},
 			expectedChangeAdminPass: false,
 			expectedAdminIndex:      0,
 		},
 		{
-			desc: "success change admin",
+			desc: "admin can't be changed to a new address without a proposal",
 			msgChangeAdmin: func(denom string) *types.MsgTokenFactoryChangeAdmin {
-				return types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, suite.TestAccs[1].String())
+				return types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, suite.TestAccs[1].String(), true)
 			},
-			expectedAdminIndex:      1,
-			expectedChangeAdminPass: true,
+			expectedAdminIndex:      0,
+			expectedChangeAdminPass: false,
 			msgMint: func(denom string) *types.MsgTokenFactoryMint {
 				return types.NewMsgMint(suite.TestAccs[1].String(), sdk.NewInt64Coin(denom, 5))
 			},
-			expectedMintPass: true,
+			expectedMintPass: false,
 		},
 	} {
 		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
@@ -392,6 +406,91 @@ func (suite *KeeperTestSuite) TestChangeAdminDenom() {
 	}
 }
 
+// TestAdminProposal ensures the following properties of the admin handover:
+// * Only the admin can propose a new admin
+// * Only the proposed admin can accept the adminship
+// * The admin and the proposed admin can cancel the proposal
+// * Renouncing the admin clears any pending proposal
+func (suite *KeeperTestSuite) TestAdminProposal() {
+	suite.CreateDefaultDenom()
+	admin, proposed, other := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	queryPendingAdmin := func() string {
+		res, err := suite.queryClient.PendingAdmin(suite.Ctx.Context(), &types.QueryPendingAdminRequest{
+			Denom: suite.defaultDenom,
+		})
+		suite.Require().NoError(err)
+		return res.PendingAdmin
+	}
+
+	// nothing to accept or cancel yet
+	_, err := suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(proposed, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdmin)
+	_, err = suite.msgServer.CancelAdminProposal(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelAdminProposal(admin, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdmin)
+
+	// non-admins can't propose
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(other, suite.defaultDenom, proposed))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().Equal("", queryPendingAdmin())
+
+	// the admin stays in place until the proposal is accepted
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin, suite.defaultDenom, proposed))
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposed, queryPendingAdmin())
+	metadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(admin, metadata.Admin)
+
+	// only the proposed admin can accept
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(other, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// others can't cancel, the admin can
+	_, err = suite.msgServer.CancelAdminProposal(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelAdminProposal(other, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.CancelAdminProposal(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelAdminProposal(admin, suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal("", queryPendingAdmin())
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(proposed, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdmin)
+
+	// the proposed admin can decline
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin, suite.defaultDenom, proposed))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CancelAdminProposal(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelAdminProposal(proposed, suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal("", queryPendingAdmin())
+
+	// a new proposal replaces the previous one
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin, suite.defaultDenom, other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin, suite.defaultDenom, proposed))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(other, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// accepting hands over the adminship and clears the proposal
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(proposed, suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal("", queryPendingAdmin())
+	metadata, err = suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposed, metadata.Admin)
+
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin, suite.defaultDenom, other))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// renouncing clears the pending proposal
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(proposed, suite.defaultDenom, other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(proposed, suite.defaultDenom, "", true))
+	suite.Require().NoError(err)
+	suite.Require().Equal("", queryPendingAdmin())
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(other, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrNoPendingAdmin)
+}
+
 func (suite *KeeperTestSuite) TestSetDenomMetaData() {
 	// setup test
 	suite.SetupTest()
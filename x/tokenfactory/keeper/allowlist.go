@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// IsAllowlistEnabled returns true if only the addresses on the allowlist can receive a
+// specific denom
+func (k Keeper) IsAllowlistEnabled(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomAllowlistEnabledKey))
+}
+
+// setAllowlistEnabled enables or disables the allowlist mode of a specific denom
+func (k Keeper) setAllowlistEnabled(ctx sdk.Context, denom string, enabled bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if enabled {
+		store.Set([]byte(types.DenomAllowlistEnabledKey), []byte{1})
+	} else {
+		store.Delete([]byte(types.DenomAllowlistEnabledKey))
+	}
+}
+
+// IsAddressAllowed returns true if the address is on the allowlist of a specific denom
+func (k Keeper) IsAddressAllowed(ctx sdk.Context, denom string, address string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetAllowlistKey(address))
+}
+
+// GetAllowlist returns the addresses on the allowlist of a specific denom
+func (k Keeper) GetAllowlist(ctx sdk.Context, denom string) []string {
+	store := k.GetAllowlistPrefixStore(ctx, denom)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+	return addresses
+}
+
+// GetAllowlistPrefixStore returns the substore that contains the allowlist of a specific denom
+func (k Keeper) GetAllowlistPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetAllowlistPrefix())
+}
+
+// setAllowed adds an address to, or removes it from, the allowlist of a specific denom
+func (k Keeper) setAllowed(ctx sdk.Context, denom string, address string, allowed bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if allowed {
+		store.Set(types.GetAllowlistKey(address), []byte{1})
+	} else {
+		store.Delete(types.GetAllowlistKey(address))
+	}
+}
+
+// checkCanReceive returns an error if the denom is in allowlist mode and the address is not
+// on its allowlist
+func (k Keeper) checkCanReceive(ctx sdk.Context, denom string, address string) error {
+	if k.IsAllowlistEnabled(ctx, denom) && !k.IsAddressAllowed(ctx, denom, address) {
+		return types.ErrAddressNotAllowed.Wrapf("%s can't receive %s", address, denom)
+	}
+	return nil
+}
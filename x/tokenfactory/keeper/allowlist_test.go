@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestAllowlist ensures the following properties of denoms in allowlist mode:
+// * Allowlist mode can only be enabled at creation
+// * Only the admin and compliance managers can manage the allowlist
+// * Only allowed addresses can receive the denom, through mints, sends, vesting account creations
+// and force transfers
+func (suite *KeeperTestSuite) TestAllowlist() {
+	suite.CreateDefaultDenom()
+	admin, manager, holder := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	msg := types.NewMsgCreateDenom(admin, "compliant")
+	msg.AllowlistEnabled = true
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	send := func(from, to sdk.AccAddress) error {
+		msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+		_, err := suite.App.MsgServiceRouter().Handler(msg)(suite.Ctx, msg)
+		return err
+	}
+	mint := func(to string) error {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), to))
+		return err
+	}
+	forceTransfer := func(from, to string) error {
+		_, err := suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 10), from, to))
+		return err
+	}
+
+	// the allowlist of a denom not in allowlist mode can't be managed
+	_, err = suite.msgServer.AddToAllowlist(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAddToAllowlist(admin, suite.defaultDenom, []string{holder}))
+	suite.Require().ErrorIs(err, types.ErrAllowlistDisabled)
+
+	// no one can receive the denom until allowed, not even the admin
+	suite.Require().ErrorIs(mint(admin), types.ErrAddressNotAllowed)
+	suite.Require().ErrorIs(mint(holder), types.ErrAddressNotAllowed)
+
+	// only the admin and compliance managers can manage the allowlist
+	_, err = suite.msgServer.AddToAllowlist(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAddToAllowlist(manager, denom, []string{admin, holder}))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, denom, types.RoleComplianceManager, manager))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AddToAllowlist(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAddToAllowlist(manager, denom, []string{admin, holder}))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AddToAllowlist(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAddToAllowlist(admin, denom, []string{manager, holder}))
+	suite.Require().ErrorIs(err, types.ErrAddressAlreadyAllowed)
+
+	// allowed addresses can receive the denom, others can't
+	suite.Require().NoError(mint(holder))
+	suite.Require().NoError(send(suite.TestAccs[2], suite.TestAccs[0]))
+	suite.Require().ErrorIs(send(suite.TestAccs[2], suite.TestAccs[1]), types.ErrAddressNotAllowed)
+	vestingMsg := vestingtypes.NewMsgCreateVestingAccount(suite.TestAccs[2], sdk.AccAddress("vesting_account_0001"), sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), suite.Ctx.BlockTime().Unix()+3600, false)
+	_, err = suite.App.MsgServiceRouter().Handler(vestingMsg)(suite.Ctx, vestingMsg)
+	suite.Require().ErrorIs(err, types.ErrAddressNotAllowed)
+	suite.Require().NoError(forceTransfer(holder, admin))
+	suite.Require().ErrorIs(forceTransfer(holder, manager), types.ErrAddressNotAllowed)
+
+	allowlist, err := suite.queryClient.Allowlist(suite.Ctx.Context(), &types.QueryAllowlistRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(allowlist.Enabled)
+	suite.Require().Len(allowlist.Addresses, 1)
+	suite.Require().Equal(uint64(2), allowlist.Pagination.Total)
+
+	// removed addresses can no longer receive the denom, but can still send it
+	_, err = suite.msgServer.RemoveFromAllowlist(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRemoveFromAllowlist(manager, denom, []string{holder, manager}))
+	suite.Require().ErrorIs(err, types.ErrAddressNotAllowed)
+	_, err = suite.msgServer.RemoveFromAllowlist(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRemoveFromAllowlist(manager, denom, []string{holder}))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(send(suite.TestAccs[0], suite.TestAccs[2]), types.ErrAddressNotAllowed)
+	suite.Require().NoError(send(suite.TestAccs[2], suite.TestAccs[0]))
+
+	// denoms created without allowlist mode are unrestricted
+	allowlist, err = suite.queryClient.Allowlist(suite.Ctx.Context(), &types.QueryAllowlistRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().False(allowlist.Enabled)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), holder))
+	suite.Require().NoError(err)
+}
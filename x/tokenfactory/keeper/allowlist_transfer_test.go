@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/noria-net/token-factory/app"
+	"github.com/noria-net/token-factory/app/apptesting"
+	"github.com/noria-net/token-factory/x/tokenfactory/keeper"
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+type AllowlistTransferTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestAllowlistTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(AllowlistTransferTestSuite))
+}
+
+func (suite *AllowlistTransferTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp(suite.T())
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+// TestAllowlistTransfer ensures that the allowed holders of a denom in allowlist mode can transfer
+// it over IBC and send it to module accounts, while other accounts still can't receive it
+func (suite *AllowlistTransferTestSuite) TestAllowlistTransfer() {
+	tokenApp := suite.chainA.App.(*app.TokenApp)
+	admin := suite.chainA.SenderAccount.GetAddress()
+
+	createMsg := types.NewMsgCreateDenom(admin.String(), "bitcoin")
+	createMsg.AllowlistEnabled = true
+	_, err := suite.chainA.SendMsgs(createMsg)
+	suite.Require().NoError(err)
+	denom, err := types.GetTokenDenom(admin.String(), "bitcoin")
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(types.NewMsgAddToAllowlist(admin.String(), denom, []string{admin.String()}))
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().NoError(err)
+
+	// the escrow address of the channel is not on the allowlist, but can receive the denom
+	_, err = suite.chainA.SendMsgs(transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID,
+		suite.path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(denom, 400),
+		admin.String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110),
+		0,
+		"",
+	))
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	escrow := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().Equal(int64(400), tokenApp.BankKeeper.GetBalance(ctx, escrow, denom).Amount.Int64())
+
+	// and so can module accounts, e.g. when paying fees
+	bankKeeper := keeper.NewSendRestrictedBankKeeper(tokenApp.BankKeeper, &tokenApp.TokenFactoryKeeper)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	suite.Require().NoError(bankKeeper.SendCoinsFromAccountToModule(ctx, admin, authtypes.FeeCollectorName, coins))
+
+	// while the other accounts still have to be allowed
+	other := apptesting.CreateRandomAccounts(1)[0]
+	suite.Require().ErrorIs(bankKeeper.SendCoins(ctx, admin, other, coins), types.ErrAddressNotAllowed)
+}
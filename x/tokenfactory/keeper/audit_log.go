@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetAuditLog returns the entries of the audit log of a specific denom, oldest first
+func (k Keeper) GetAuditLog(ctx sdk.Context, denom string) []types.AuditLogEntry {
+	iterator := k.GetAuditLogPrefixStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var entries []types.AuditLogEntry
+	for ; iterator.Valid(); iterator.Next() {
+		entry := types.AuditLogEntry{}
+		k.mustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// GetAuditLogPrefixStore returns the substore that contains the audit log of a specific denom,
+// keyed by sequence
+func (k Keeper) GetAuditLogPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetAuditLogPrefix())
+}
+
+// getNextAuditLogSequence returns the sequence of the next entry of the audit log of a specific
+// denom
+func (k Keeper) getNextAuditLogSequence(ctx sdk.Context, denom string) uint64 {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomNextAuditLogSequenceKey))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setAuditLogEntry stores an entry of the audit log of a specific denom, after which the log
+// continues
+func (k Keeper) setAuditLogEntry(ctx sdk.Context, denom string, entry types.AuditLogEntry) error {
+	err := entry.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	store.Set(types.GetAuditLogKey(entry.Sequence), bz)
+	if entry.Sequence >= k.getNextAuditLogSequence(ctx, denom) {
+		store.Set([]byte(types.DenomNextAuditLogSequenceKey), sdk.Uint64ToBigEndian(entry.Sequence+1))
+	}
+	return nil
+}
+
+// appendAuditLog records a privileged action performed on a specific denom in its audit log, and
+// prunes the oldest entries beyond the maximum set by the params. Nothing is recorded if the
+// audit log is disabled.
+func (k Keeper) appendAuditLog(ctx sdk.Context, denom, action, actor string, counterparties []string, amount math.Int) error {
+	maxEntries := k.GetParams(ctx).AuditLogMaxEntries
+	if maxEntries == 0 {
+		return nil
+	}
+
+	// timelocked actions are executed at the end of a block, outside of any transaction
+	var txHash string
+	if len(ctx.TxBytes()) > 0 {
+		txHash = fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+	}
+
+	sequence := k.getNextAuditLogSequence(ctx, denom)
+	err := k.setAuditLogEntry(ctx, denom, types.AuditLogEntry{
+		Sequence:       sequence,
+		Action:         action,
+		Actor:          actor,
+		Counterparties: counterparties,
+		Amount:         amount,
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		TxHash:         txHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	if sequence+1 > maxEntries {
+		k.pruneAuditLog(ctx, denom, sequence+1-maxEntries)
+	}
+	return nil
+}
+
+// pruneAuditLog deletes the entries of the audit log of a specific denom that precede a sequence
+func (k Keeper) pruneAuditLog(ctx sdk.Context, denom string, before uint64) {
+	store := k.GetAuditLogPrefixStore(ctx, denom)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(before))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestAuditLog ensures the following properties of the audit log of a denom:
+// * Every privileged action appends an entry, with its actor, counterparties, amount, height and
+// transaction hash, while failed actions are not recorded
+// * The log is paginated oldest first
+// * Only the latest entries are kept, up to the maximum set by the params
+// * Timelocked actions are recorded when they are executed
+// * A maximum of zero disables the audit log
+func (suite *KeeperTestSuite) TestAuditLog() {
+	admin, holder, newAdmin := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+	keeper := suite.App.TokenFactoryKeeper
+
+	txBytes := []byte("compliance")
+	suite.Ctx = suite.Ctx.WithTxBytes(txBytes)
+	suite.CreateDefaultDenom()
+	denom := suite.defaultDenom
+
+	queryAuditLog := func(pagination *query.PageRequest) ([]types.AuditLogEntry, *query.PageResponse) {
+		// queried on the keeper, as the query client is bound to the initial block
+		res, err := keeper.DenomAuditLog(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomAuditLogRequest{Denom: denom, Pagination: pagination})
+		suite.Require().NoError(err)
+		return res.Entries, res.Pagination
+	}
+	sequences := func(entries []types.AuditLogEntry) []uint64 {
+		var sequences []uint64
+		for _, entry := range entries {
+			sequences = append(sequences, entry.Sequence)
+		}
+		return sequences
+	}
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), holder))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 30), holder))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 20), holder, admin))
+	suite.Require().NoError(err)
+	// failed actions are not recorded
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 1000), holder))
+	suite.Require().Error(err)
+
+	entries, _ := queryAuditLog(nil)
+	suite.Require().Equal([]uint64{0, 1, 2, 3}, sequences(entries))
+	suite.Require().Equal(types.TypeMsgCreateDenom, entries[0].Action)
+	suite.Require().Equal(admin, entries[0].Actor)
+	suite.Require().Empty(entries[0].Counterparties)
+	suite.Require().True(entries[0].Amount.IsZero())
+	suite.Require().Equal(types.AuditLogEntry{
+		Sequence:       1,
+		Action:         types.TypeMsgMint,
+		Actor:          admin,
+		Counterparties: []string{holder},
+		Amount:         sdk.NewInt(100),
+		Height:         suite.Ctx.BlockHeight(),
+		Time:           suite.Ctx.BlockTime(),
+		TxHash:         fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+	}, entries[1])
+	suite.Require().Equal(types.TypeMsgBurn, entries[2].Action)
+	suite.Require().Equal([]string{holder}, entries[2].Counterparties)
+	suite.Require().Equal(sdk.NewInt(30), entries[2].Amount)
+	suite.Require().Equal(types.TypeMsgForceTransfer, entries[3].Action)
+	suite.Require().Equal([]string{holder, admin}, entries[3].Counterparties)
+	suite.Require().Equal(sdk.NewInt(20), entries[3].Amount)
+
+	// the log is paginated oldest first
+	entries, pageRes := queryAuditLog(&query.PageRequest{Limit: 3})
+	suite.Require().Equal([]uint64{0, 1, 2}, sequences(entries))
+	entries, _ = queryAuditLog(&query.PageRequest{Key: pageRes.NextKey})
+	suite.Require().Equal([]uint64{3}, sequences(entries))
+
+	// the oldest entries are pruned beyond the maximum
+	params := keeper.GetParams(suite.Ctx)
+	params.AuditLogMaxEntries = 4
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin, denom, newAdmin))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(newAdmin, denom))
+	suite.Require().NoError(err)
+	entries = keeper.GetAuditLog(suite.Ctx, denom)
+	suite.Require().Equal([]uint64{2, 3, 4, 5}, sequences(entries))
+	suite.Require().Equal(types.TypeMsgProposeAdmin, entries[2].Action)
+	suite.Require().Equal([]string{newAdmin}, entries[2].Counterparties)
+	suite.Require().Equal(types.TypeMsgAcceptAdmin, entries[3].Action)
+	suite.Require().Equal(newAdmin, entries[3].Actor)
+	suite.Require().Equal([]string{admin}, entries[3].Counterparties)
+
+	// timelocked actions are recorded when executed at the end of a block, outside of a transaction
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(newAdmin, denom, time.Hour, sdk.NewInt(50)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(newAdmin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 3, 4, 5}, sequences(keeper.GetAuditLog(suite.Ctx, denom)))
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour)).WithTxBytes(nil)
+	keeper.ExecutePendingActions(suite.Ctx)
+	entries = keeper.GetAuditLog(suite.Ctx, denom)
+	suite.Require().Equal([]uint64{3, 4, 5, 6}, sequences(entries))
+	suite.Require().Equal(types.TypeMsgMint, entries[3].Action)
+	suite.Require().Equal(newAdmin, entries[3].Actor)
+	suite.Require().Equal(suite.Ctx.BlockTime(), entries[3].Time)
+	suite.Require().Empty(entries[3].TxHash)
+
+	// nothing is recorded when the audit log is disabled
+	params.AuditLogMaxEntries = 0
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(newAdmin, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3, 4, 5, 6}, sequences(keeper.GetAuditLog(suite.Ctx, denom)))
+
+	_, err = keeper.DenomAuditLog(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomAuditLogRequest{Denom: fmt.Sprintf("factory/%s/litecoin", admin)})
+	suite.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
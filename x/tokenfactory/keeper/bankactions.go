@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
@@ -15,6 +16,25 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	err = k.checkMaxSupply(ctx, amount)
+	if err != nil {
+		return err
+	}
+
+	err = k.useMintRateLimit(ctx, amount)
+	if err != nil {
+		return err
+	}
+
+	if k.IsAddressFrozen(ctx, amount.Denom, mintTo) {
+		return types.ErrAddressFrozen.Wrapf("%s can't receive %s", mintTo, amount.Denom)
+	}
+
+	err = k.checkCanReceive(ctx, amount.Denom, mintTo)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -29,12 +49,21 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return fmt.Errorf("failed to mint to blocked address: %s", addr)
 	}
 
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
+	k.trackBeforeSend(ctx, authtypes.NewModuleAddress(types.ModuleName), addr, sdk.NewCoins(amount))
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
 		addr,
 		sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	return k.trackMinted(ctx, amount)
 }
 
-func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
+// burnFrom burns an amount of a factory denom from an address, unless the address is a module
+// account or one of the protected addresses returned by getProtectedAddresses
+func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string, protected map[string]string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
 	if err != nil {
@@ -50,6 +79,13 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return fmt.Errorf("failed to burn from blocked address: %s", addr)
 	}
 
+	err = k.checkNotProtected(ctx, protected, addr)
+	if err != nil {
+		return err
+	}
+
+	k.trackBeforeSend(ctx, addr, authtypes.NewModuleAddress(types.ModuleName), sdk.NewCoins(amount))
+
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
 		addr,
 		types.ModuleName,
@@ -58,10 +94,18 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return err
 	}
 
-	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	return k.trackBurned(ctx, amount)
 }
 
-func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
+// forceTransfer moves an amount of a factory denom between addresses, unless the address it is
+// taken from is a module account or one of the protected addresses returned by
+// getProtectedAddresses
+func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string, protected map[string]string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
 	if err != nil {
@@ -82,5 +126,27 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		return fmt.Errorf("failed to force transfer to blocked address: %s", toSdkAddr)
 	}
 
-	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	err = k.checkNotProtected(ctx, protected, fromSdkAddr)
+	if err != nil {
+		return err
+	}
+
+	// a frozen address can still be force transferred out of, but not into
+	if k.IsAddressFrozen(ctx, amount.Denom, toAddr) {
+		return types.ErrAddressFrozen.Wrapf("%s can't receive %s", toAddr, amount.Denom)
+	}
+
+	err = k.checkCanReceive(ctx, amount.Denom, toAddr)
+	if err != nil {
+		return err
+	}
+
+	k.trackBeforeSend(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+
+	err = k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	return k.trackForceTransferred(ctx, amount)
 }
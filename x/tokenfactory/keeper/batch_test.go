@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/app/apptesting"
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestBatchMsgs ensures the following properties of batch messages:
+// * Authority is checked once for the whole batch, which counts as a single action of the total
+// amount against minter allowances and timelocks
+// * A batch failing on any entry leaves no entry applied
+// * A single event summarizes the batch
+func (suite *KeeperTestSuite) TestBatchMsgs() {
+	suite.CreateDefaultDenom()
+	admin, minter, holder := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+	other := apptesting.CreateRandomAccounts(1)[0].String()
+	denom := suite.defaultDenom
+	keeper := suite.App.TokenFactoryKeeper
+
+	entries := []types.BatchEntry{
+		types.NewBatchEntry(holder, sdk.NewInt(100)),
+		types.NewBatchEntry(other, sdk.NewInt(200)),
+	}
+	balance := func(address string) int64 {
+		return suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32(address), denom).Amount.Int64()
+	}
+	batchMint := func(sender string, entries []types.BatchEntry) error {
+		// executed in a cached context, as a transaction would be
+		cacheCtx, write := suite.Ctx.CacheContext()
+		_, err := suite.msgServer.BatchMint(sdk.WrapSDKContext(cacheCtx), types.NewMsgBatchMint(sender, denom, entries))
+		if err == nil {
+			write()
+		}
+		return err
+	}
+	batchBurn := func(sender string) error {
+		_, err := suite.msgServer.BatchBurn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBatchBurn(sender, denom, entries))
+		return err
+	}
+	batchForceTransfer := func(sender string) error {
+		_, err := suite.msgServer.BatchForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBatchForceTransfer(sender, denom, entries, admin))
+		return err
+	}
+
+	// only minters and delegated minters can batch mint, the latter within their allowance
+	suite.Require().ErrorIs(batchMint(minter, entries), types.ErrUnauthorized)
+	_, err := suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(admin, denom, minter, sdk.NewInt(500), sdk.ZeroInt(), 0))
+	suite.Require().NoError(err)
+	suite.Require().NoError(batchMint(minter, entries))
+	suite.Require().ErrorIs(batchMint(minter, entries), types.ErrMinterAllowanceExceeded)
+	allowance, found := keeper.GetMinterAllowance(suite.Ctx, denom, minter)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(200), allowance.Remaining)
+
+	// a single event summarizes the batch
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(batchMint(admin, entries))
+	suite.AssertEventEmitted(suite.Ctx, types.TypeMsgBatchMint, 1)
+	suite.Require().Equal(int64(200), balance(holder))
+	suite.Require().Equal(int64(400), balance(other))
+
+	// a batch failing on any entry leaves every entry untouched
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin, denom, []string{other}))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(batchMint(admin, entries), types.ErrAddressFrozen)
+	suite.Require().Equal(int64(200), balance(holder))
+	_, err = suite.msgServer.Unfreeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnfreeze(admin, denom, []string{other}))
+	suite.Require().NoError(err)
+
+	// only burners can batch burn, and force transferrers batch force transfer
+	suite.Require().ErrorIs(batchBurn(minter), types.ErrUnauthorized)
+	suite.Require().ErrorIs(batchForceTransfer(minter), types.ErrUnauthorized)
+	suite.Require().NoError(batchForceTransfer(admin))
+	suite.Require().Equal(int64(100), balance(holder))
+	suite.Require().Equal(int64(200), balance(other))
+	suite.Require().Equal(int64(300), balance(admin))
+	suite.Require().NoError(batchBurn(admin))
+	suite.Require().Equal(int64(0), balance(holder))
+	suite.Require().Equal(int64(0), balance(other))
+
+	// burning from others requires the capability
+	_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(admin, denom, types.CapabilityBurnableFromOthers))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(batchBurn(admin), types.ErrCapabilityRenounced)
+
+	// the total amount of a batch mint is checked against the mint threshold of the timelock
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, time.Hour, sdk.NewInt(250)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(batchMint(admin, entries))
+	suite.Require().Equal(int64(0), balance(holder))
+	actions := keeper.GetAllPendingActions(suite.Ctx)
+	suite.Require().Len(actions, 1)
+	suite.Require().NotNil(actions[0].GetBatchMint())
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	keeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Equal(int64(100), balance(holder))
+	suite.Require().Equal(int64(200), balance(other))
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// validateBeforeSendHook returns an error unless cosmwasmAddress is empty, which removes the hook,
+// or the address of a contract
+func (k Keeper) validateBeforeSendHook(ctx sdk.Context, cosmwasmAddress string) error {
+	if cosmwasmAddress != "" && k.getContractCodeID(ctx, cosmwasmAddress) == 0 {
+		return types.ErrInvalidBeforeSendHook.Wrapf("%s is not a contract", cosmwasmAddress)
+	}
+	return nil
+}
+
+// GetBeforeSendHook returns the address of the contract called before the transfers of a
+// specific denom, or an empty string if the denom has no hook
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	return string(k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomBeforeSendHookKey)))
+}
+
+// setBeforeSendHook sets the contract called before the transfers of a specific denom, or
+// removes it if cosmwasmAddress is empty
+func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.DenomBeforeSendHookKey))
+	} else {
+		store.Set([]byte(types.DenomBeforeSendHookKey), []byte(cosmwasmAddress))
+	}
+}
+
+// blockBeforeSend calls the before send hook of the denom of coin, and returns an error if it
+// rejects the transfer
+func (k Keeper) blockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, coin sdk.Coin) error {
+	msg := types.BlockBeforeSendSudoMsg{BlockBeforeSend: newBeforeSendMsg(from, to, coin)}
+	return k.callBeforeSendHook(ctx, coin.Denom, msg)
+}
+
+// trackBeforeSend notifies the before send hooks of the factory denoms in amt of a movement of
+// coins. Unlike blockBeforeSend, it can't prevent the movement: errors are logged and the state
+// changes of the failing hook are discarded.
+func (k Keeper) trackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) {
+	for _, coin := range amt {
+		msg := types.TrackBeforeSendSudoMsg{TrackBeforeSend: newBeforeSendMsg(from, to, coin)}
+		if err := k.callBeforeSendHook(ctx, coin.Denom, msg); err != nil {
+			k.Logger(ctx).Error("before send hook failed to track a transfer", "denom", coin.Denom, "error", err)
+		}
+	}
+}
+
+// callBeforeSendHook sends msg to the before send hook of denom, if it has one. The call is
+// bounded by the before_send_hook_gas_limit param and its state changes are only kept if it succeeds.
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, denom string, msg any) (err error) {
+	if !strings.HasPrefix(denom, types.ModuleDenomPrefix+"/") || k.contractKeeper == nil {
+		return nil
+	}
+
+	hook := k.GetBeforeSendHook(ctx, denom)
+	if hook == "" {
+		return nil
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(hook)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(k.GetParams(ctx).BeforeSendHookGasLimitOrDefault()))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = types.ErrBeforeSendHookFailed.Wrapf("%s ran out of gas for %s", hook, denom)
+		}
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "tokenfactory before send hook")
+	}()
+
+	_, err = k.contractKeeper.Sudo(cacheCtx, contractAddr, bz)
+	if err != nil {
+		return types.ErrBeforeSendHookFailed.Wrapf("%s rejected the transfer of %s: %s", hook, denom, err)
+	}
+
+	write()
+	return nil
+}
+
+func newBeforeSendMsg(from, to sdk.AccAddress, coin sdk.Coin) types.BeforeSendMsg {
+	return types.BeforeSendMsg{
+		From:   from.String(),
+		To:     to.String(),
+		Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+	}
+}
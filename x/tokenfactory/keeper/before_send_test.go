@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"os"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestBeforeSendHook ensures the following properties of before send hooks:
+// * Only the admin can set or remove the hook of a denom
+// * Only contracts can be set as hooks
+// * A hook that can't be called blocks the transfers of the denom, including vesting account
+// creations and community pool fundings, but not its mints
+func (suite *KeeperTestSuite) TestBeforeSendHook() {
+	suite.CreateDefaultDenom()
+	admin, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	// the reflect contract has no sudo entry point, so every call to the hook fails
+	wasmCode, err := os.ReadFile("../bindings/testdata/token_reflect.wasm")
+	suite.Require().NoError(err)
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(suite.App.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(suite.Ctx, suite.TestAccs[0], wasmCode, nil)
+	suite.Require().NoError(err)
+	contractAddr, _, err := contractKeeper.Instantiate(suite.Ctx, codeID, suite.TestAccs[0], nil, []byte("{}"), "reflect", nil)
+	suite.Require().NoError(err)
+	hook := contractAddr.String()
+
+	mint := func() error {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	handle := func(msg sdk.Msg) error {
+		_, err := suite.App.MsgServiceRouter().Handler(msg)(suite.Ctx, msg)
+		return err
+	}
+	send := func() error {
+		return handle(banktypes.NewMsgSend(suite.TestAccs[0], suite.TestAccs[1], coins))
+	}
+	createVestingAccount := func(to sdk.AccAddress) error {
+		return handle(vestingtypes.NewMsgCreateVestingAccount(suite.TestAccs[0], to, coins, suite.Ctx.BlockTime().Unix()+3600, false))
+	}
+	fundCommunityPool := func() error {
+		return handle(distrtypes.NewMsgFundCommunityPool(coins, suite.TestAccs[0]))
+	}
+
+	suite.Require().NoError(mint())
+
+	// only contracts can be hooks
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, other))
+	suite.Require().ErrorIs(err, types.ErrInvalidBeforeSendHook)
+
+	// only the admin can set the hook
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(other, suite.defaultDenom, hook))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, hook))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.BeforeSendHookAddress(suite.Ctx.Context(), &types.QueryBeforeSendHookAddressRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hook, res.CosmwasmAddress)
+
+	suite.Require().ErrorIs(send(), types.ErrBeforeSendHookFailed)
+	suite.Require().ErrorIs(createVestingAccount(sdk.AccAddress("vesting_account_0001")), types.ErrBeforeSendHookFailed)
+	suite.Require().ErrorIs(fundCommunityPool(), types.ErrBeforeSendHookFailed)
+	suite.Require().NoError(mint())
+
+	// removing the hook lets the transfers through
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetBeforeSendHook(suite.Ctx, suite.defaultDenom))
+	suite.Require().NoError(send())
+	suite.Require().NoError(createVestingAccount(sdk.AccAddress("vesting_account_0002")))
+	suite.Require().NoError(fundCommunityPool())
+}
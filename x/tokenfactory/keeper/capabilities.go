@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// HasCapability returns true if a capability over a specific denom has not been renounced
+func (k Keeper) HasCapability(ctx sdk.Context, denom string, capability types.DenomCapability) bool {
+	return !k.GetDenomPrefixStore(ctx, denom).Has(types.GetRenouncedCapabilityKey(capability))
+}
+
+// GetRenouncedCapabilities returns the capabilities renounced over a specific denom
+func (k Keeper) GetRenouncedCapabilities(ctx sdk.Context, denom string) []types.DenomCapability {
+	var renounced []types.DenomCapability
+	for _, capability := range types.AllCapabilities() {
+		if !k.HasCapability(ctx, denom, capability) {
+			renounced = append(renounced, capability)
+		}
+	}
+	return renounced
+}
+
+// GetCapabilities returns the capabilities still held over a specific denom
+func (k Keeper) GetCapabilities(ctx sdk.Context, denom string) types.DenomCapabilities {
+	return types.NewDenomCapabilities(k.GetRenouncedCapabilities(ctx, denom))
+}
+
+// renounceCapability permanently gives up a capability over a specific denom
+func (k Keeper) renounceCapability(ctx sdk.Context, denom string, capability types.DenomCapability) {
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetRenouncedCapabilityKey(capability), []byte{1})
+}
+
+// checkCapability returns an error if a capability over a specific denom has been renounced
+func (k Keeper) checkCapability(ctx sdk.Context, denom string, capability types.DenomCapability) error {
+	if !k.HasCapability(ctx, denom, capability) {
+		return types.ErrCapabilityRenounced.Wrapf("%s is no longer %s", denom, capability.ShortName())
+	}
+	return nil
+}
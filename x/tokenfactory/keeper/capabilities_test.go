@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestCapabilities ensures the following properties of denom capabilities:
+// * Every capability is held unless renounced, at creation or later by the admin
+// * A renounced capability can't be held again
+// * Mints, burns from other accounts, force transfers and metadata changes require their
+// capability, even for the admin
+func (suite *KeeperTestSuite) TestCapabilities() {
+	admin, holder := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	msg := types.NewMsgCreateDenom(admin, "fixed")
+	msg.RenouncedCapabilities = []types.DenomCapability{types.CapabilityForceTransferable}
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	mint := func() error {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), holder))
+		return err
+	}
+	burnFrom := func(from string) error {
+		_, err := suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 10), from))
+		return err
+	}
+	setMetadata := func() error {
+		_, err := suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(admin, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+			Display:    denom,
+			Name:       "FIXED",
+			Symbol:     "FIXED",
+		}))
+		return err
+	}
+	renounce := func(sender string, capability types.DenomCapability) error {
+		_, err := suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(sender, denom, capability))
+		return err
+	}
+
+	capabilities, err := suite.queryClient.DenomCapabilities(suite.Ctx.Context(), &types.QueryDenomCapabilitiesRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomCapabilities{Mintable: true, BurnableFromOthers: true, MetadataMutable: true}, capabilities.Capabilities)
+
+	// capabilities renounced at creation are never held
+	suite.Require().NoError(mint())
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 10), holder, admin))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+	suite.Require().ErrorIs(renounce(admin, types.CapabilityForceTransferable), types.ErrCapabilityRenounced)
+
+	// only the admin can renounce a capability
+	suite.Require().ErrorIs(renounce(holder, types.CapabilityMintable), types.ErrUnauthorized)
+	suite.Require().NoError(renounce(admin, types.CapabilityMintable))
+	suite.Require().ErrorIs(mint(), types.ErrCapabilityRenounced)
+
+	// burning from other accounts and burning one's own tokens are distinct capabilities
+	suite.Require().NoError(burnFrom(holder))
+	suite.Require().NoError(renounce(admin, types.CapabilityBurnableFromOthers))
+	suite.Require().ErrorIs(burnFrom(holder), types.ErrCapabilityRenounced)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(holder, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(setMetadata())
+	suite.Require().NoError(renounce(admin, types.CapabilityMetadataMutable))
+	suite.Require().ErrorIs(setMetadata(), types.ErrCapabilityRenounced)
+
+	capabilities, err = suite.queryClient.DenomCapabilities(suite.Ctx.Context(), &types.QueryDenomCapabilitiesRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomCapabilities{}, capabilities.Capabilities)
+}
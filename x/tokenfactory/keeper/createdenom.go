@@ -9,19 +9,30 @@ import (
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
 
-// ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount
-func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
+// CreateDenom creates a denom for an address, which pays the creation fee option in feeDenom,
+// or the first option if feeDenom is empty
+func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string, feeDenom string) (newTokenDenom string, err error) {
 	denom, err := k.validateCreateDenom(ctx, creatorAddr, subdenom)
 	if err != nil {
 		return "", err
 	}
 
-	err = k.chargeForCreateDenom(ctx, creatorAddr, subdenom)
+	deposit, err := k.chargeForCreateDenom(ctx, creatorAddr, feeDenom)
 	if err != nil {
 		return "", err
 	}
 
 	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
+	if err != nil {
+		return "", err
+	}
+
+	err = k.setCreationDeposit(ctx, denom, deposit)
+	if err != nil {
+		return "", err
+	}
+
+	err = k.useCreationLimits(ctx, creatorAddr)
 	return denom, err
 }
 
@@ -46,11 +57,31 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string,
 		return err
 	}
 
+	// a reused subdenom keeps the audit log of the retired denom, but its stats start over
+	if k.IsRetired(ctx, denom) {
+		err = k.setDenomStats(ctx, denom, types.NewDenomStats())
+		if err != nil {
+			return err
+		}
+	}
+
 	k.addDenomFromCreator(ctx, creatorAddr, denom)
-	return nil
+	k.setCreationHeight(ctx, denom, ctx.BlockHeight())
+	k.setCreationTime(ctx, denom, ctx.BlockTime())
+	k.setRetired(ctx, denom, false)
+	return k.trackDenomCreated(ctx)
 }
 
 func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
+	if !k.GetParams(ctx).IsCreatorAllowed(creatorAddr, k.getContractCodeID(ctx, creatorAddr)) {
+		return "", types.ErrCreatorNotAllowed.Wrapf("%s", creatorAddr)
+	}
+
+	err = k.checkCreationLimits(ctx, creatorAddr)
+	if err != nil {
+		return "", err
+	}
+
 	// Temporary check until IBC bug is sorted out
 	if k.bankKeeper.HasSupply(ctx, subdenom) {
 		return "", fmt.Errorf("temporary error until IBC bug is sorted out, " +
@@ -62,25 +93,92 @@ func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdeno
 		return "", err
 	}
 
-	_, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
-	if found {
+	// a retired denom has no bank metadata, so it is checked for first
+	if k.IsRetired(ctx, denom) {
+		if !k.GetParams(ctx).AllowSubdenomReuse {
+			return "", types.ErrDenomRetired.Wrapf("denom: %s", denom)
+		}
+	} else if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
 		return "", types.ErrDenomExists
 	}
 
 	return denom, nil
 }
 
-func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string, _ string) (err error) {
-	// Send creation fee to community pool
-	creationFee := k.GetParams(ctx).DenomCreationFee
+// getContractCodeID returns the code id of the contract at an address, or zero if it is not a
+// contract
+func (k Keeper) getContractCodeID(ctx sdk.Context, address string) uint64 {
+	if k.contractInfoKeeper == nil {
+		return 0
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return 0
+	}
+
+	contractInfo := k.contractInfoKeeper.GetContractInfo(ctx, accAddr)
+	if contractInfo == nil {
+		return 0
+	}
+	return contractInfo.CodeID
+}
+
+// chargeForCreateDenom consumes the denom creation gas, and charges the creation fee option in
+// feeDenom. The fee is either escrowed by the module account, in which case it is returned as the
+// deposit of the denom, or split between burning, the fee recipient and the community pool.
+func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string, feeDenom string) (deposit sdk.Coins, err error) {
+	params := k.GetParams(ctx)
+	if params.DenomCreationGasConsume > 0 {
+		ctx.GasMeter().ConsumeGas(params.DenomCreationGasConsume, "consume denom creation gas")
+	}
+
+	creationFee, err := params.GetDenomCreationFeeOption(feeDenom)
+	if err != nil {
+		return nil, err
+	}
 	accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if creationFee != nil {
-		if err := k.communityPoolKeeper.FundCommunityPool(ctx, creationFee, accAddr); err != nil {
-			return err
+
+	if params.DenomCreationFeeAsDeposit {
+		if creationFee.IsZero() {
+			return sdk.NewCoins(), nil
+		}
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, creationFee)
+		if err != nil {
+			return nil, err
+		}
+		return creationFee, nil
+	}
+
+	burned, recipient, communityPool := params.SplitDenomCreationFee(creationFee)
+	if !burned.IsZero() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, burned)
+		if err != nil {
+			return nil, err
+		}
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !recipient.IsZero() {
+		recipientAddr, err := sdk.AccAddressFromBech32(params.DenomCreationFeeRecipient)
+		if err != nil {
+			return nil, err
+		}
+		err = k.bankKeeper.SendCoins(ctx, accAddr, recipientAddr, recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !communityPool.IsZero() {
+		err = k.communityPoolKeeper.FundCommunityPool(ctx, communityPool, accAddr)
+		if err != nil {
+			return nil, err
 		}
 	}
-	return nil
+	return sdk.NewCoins(), k.trackFeesCollected(ctx, creationFee)
 }
@@ -161,3 +161,140 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 		})
 	}
 }
+
+// TestPermissionedCreateDenom ensures that in allowlist mode, only the addresses on the creator
+// allowlist can create denoms
+func (suite *KeeperTestSuite) TestPermissionedCreateDenom() {
+	allowed, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	createDenom := func(sender string) error {
+		_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(sender, "bitcoin"))
+		return err
+	}
+
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.DenomCreationMode = types.CreationModeAllowlist
+	params.CreatorAllowlist = []string{allowed}
+	_, err := suite.msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUpdateParams(suite.App.TokenFactoryKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	suite.Require().ErrorIs(createDenom(other), types.ErrCreatorNotAllowed)
+	suite.Require().NoError(createDenom(allowed))
+
+	// switching back to permissionless mode lets anyone create denoms
+	params.DenomCreationMode = types.CreationModePermissionless
+	_, err = suite.msgServer.UpdateParams(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUpdateParams(suite.App.TokenFactoryKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.Require().NoError(createDenom(other))
+}
+
+// TestCreationLimits ensures that denom creations are capped per creator and per block
+func (suite *KeeperTestSuite) TestCreationLimits() {
+	creator, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	createDenom := func(sender, subdenom string) error {
+		_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(sender, subdenom))
+		return err
+	}
+
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.MaxDenomsPerCreator = 2
+	params.MaxCreationsPerBlock = 3
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	// a creator can't create more denoms than the limit
+	suite.Require().NoError(createDenom(creator, "bitcoin"))
+	suite.Require().NoError(createDenom(creator, "litecoin"))
+	suite.Require().ErrorIs(createDenom(creator, "dogecoin"), types.ErrCreatorDenomLimit)
+	suite.Require().Equal(uint64(2), suite.App.TokenFactoryKeeper.GetCreatorDenomCount(suite.Ctx, creator))
+
+	// the creations of all the creators count towards the block limit
+	suite.Require().NoError(createDenom(other, "bitcoin"))
+	suite.Require().ErrorIs(createDenom(other, "litecoin"), types.ErrBlockCreationLimit)
+
+	// the block limit is reset at the next block
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.Require().NoError(createDenom(other, "litecoin"))
+	suite.Require().Equal(types.BlockCreationCount{Height: suite.Ctx.BlockHeight(), Count: 1}, suite.App.TokenFactoryKeeper.GetBlockCreationCount(suite.Ctx))
+
+	// lifting the limits allows further creations
+	params.MaxDenomsPerCreator = 0
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+	suite.Require().NoError(createDenom(creator, "dogecoin"))
+}
+
+// TestCreateDenomFee ensures that the creator pays one of the creation fee options, split
+// between burning, the fee recipient and the community pool, and consumes the creation gas
+func (suite *KeeperTestSuite) TestCreateDenomFee() {
+	creator, feeRecipient := suite.TestAccs[0], suite.TestAccs[1]
+	primaryDenom := types.DefaultParams().DenomCreationFee[0].Denom
+	secondaryDenom := testhelpers.SecondaryDenom
+
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(primaryDenom, 1000), sdk.NewInt64Coin(secondaryDenom, 50))
+	params.DenomCreationFeeBurnRatio = sdk.NewDecWithPrec(5, 1)
+	params.DenomCreationFeeRecipient = feeRecipient.String()
+	params.DenomCreationFeeRecipientRatio = sdk.NewDecWithPrec(25, 2)
+	params.DenomCreationGasConsume = 500_000
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	createDenom := func(subdenom, feeDenom string) error {
+		msg := types.NewMsgCreateDenom(creator.String(), subdenom)
+		msg.FeeDenom = feeDenom
+		_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), msg)
+		return err
+	}
+	balance := func(address sdk.AccAddress, denom string) int64 {
+		return suite.App.BankKeeper.GetBalance(suite.Ctx, address, denom).Amount.Int64()
+	}
+	communityPool := func(denom string) sdk.Dec {
+		return suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx).AmountOf(denom)
+	}
+
+	// a fee denom that is not one of the options is rejected
+	suite.Require().ErrorIs(createDenom("bitcoin", "uatom"), types.ErrInvalidFeeDenom)
+
+	// the chosen option is split between burning, the fee recipient and the community pool
+	creatorBalance, recipientBalance := balance(creator, secondaryDenom), balance(feeRecipient, secondaryDenom)
+	supply, pool := suite.App.BankKeeper.GetSupply(suite.Ctx, secondaryDenom).Amount, communityPool(secondaryDenom)
+	gasConsumed := suite.Ctx.GasMeter().GasConsumed()
+	suite.Require().NoError(createDenom("bitcoin", secondaryDenom))
+	suite.Require().GreaterOrEqual(suite.Ctx.GasMeter().GasConsumed()-gasConsumed, params.DenomCreationGasConsume)
+
+	suite.Require().Equal(creatorBalance-50, balance(creator, secondaryDenom))
+	suite.Require().Equal(supply.SubRaw(25), suite.App.BankKeeper.GetSupply(suite.Ctx, secondaryDenom).Amount)
+	suite.Require().Equal(recipientBalance+12, balance(feeRecipient, secondaryDenom))
+	suite.Require().Equal(pool.Add(sdk.NewDec(13)), communityPool(secondaryDenom))
+	suite.Require().Equal(int64(0), balance(suite.App.AccountKeeper.GetModuleAddress(types.ModuleName), secondaryDenom))
+
+	// without a fee denom, the first option is paid
+	creatorBalance = balance(creator, primaryDenom)
+	suite.Require().NoError(createDenom("litecoin", ""))
+	suite.Require().Equal(creatorBalance-1000, balance(creator, primaryDenom))
+}
+
+// TestCreationDeposit ensures that when creation fees are taken as deposits, the fee is escrowed
+// in full by the module account and tracked per denom
+func (suite *KeeperTestSuite) TestCreationDeposit() {
+	creator := suite.TestAccs[0]
+	feeDenom := types.DefaultParams().DenomCreationFee[0].Denom
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000))
+	params.DenomCreationFeeBurnRatio = sdk.NewDecWithPrec(5, 1)
+	params.DenomCreationFeeAsDeposit = true
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	creatorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, feeDenom).Amount
+	supply := suite.App.BankKeeper.GetSupply(suite.Ctx, feeDenom).Amount
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	suite.Require().NoError(err)
+
+	// nothing is burned, the whole fee is held by the module account
+	suite.Require().Equal(creatorBalance.SubRaw(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, creator, feeDenom).Amount)
+	suite.Require().Equal(supply, suite.App.BankKeeper.GetSupply(suite.Ctx, feeDenom).Amount)
+	suite.Require().Equal(int64(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, feeDenom).Amount.Int64())
+
+	deposit, err := suite.App.TokenFactoryKeeper.CreationDeposit(sdk.WrapSDKContext(suite.Ctx), &types.QueryCreationDepositRequest{Denom: res.GetNewTokenDenom()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.DenomCreationFee, deposit.CreationDeposit)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetCreationDeposit returns the creation fee escrowed for a specific denom, which is nil if
+// no deposit is held
+func (k Keeper) GetCreationDeposit(ctx sdk.Context, denom string) sdk.Coins {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomCreationDepositKey))
+	if bz == nil {
+		return nil
+	}
+
+	deposit := types.CreationDeposit{}
+	k.mustUnmarshal(bz, &deposit)
+	return deposit.Amount
+}
+
+// setCreationDeposit stores the creation fee escrowed for a specific denom. An empty deposit
+// removes it.
+func (k Keeper) setCreationDeposit(ctx sdk.Context, denom string, amount sdk.Coins) error {
+	err := amount.Validate()
+	if err != nil {
+		return types.ErrInvalidCreationDeposit.Wrapf("creation deposit of %s: %s", denom, err)
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if amount.IsZero() {
+		store.Delete([]byte(types.DenomCreationDepositKey))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&types.CreationDeposit{Amount: amount})
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomCreationDepositKey), bz)
+	return nil
+}
+
+// refundCreationDeposit pays the creation fee escrowed for a specific denom back to its admin,
+// and removes the deposit
+func (k Keeper) refundCreationDeposit(ctx sdk.Context, denom string) error {
+	deposit := k.GetCreationDeposit(ctx, denom)
+	if deposit.IsZero() {
+		return nil
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	adminAddr, err := sdk.AccAddressFromBech32(authorityMetadata.GetAdmin())
+	if err != nil {
+		return types.ErrUnauthorized.Wrapf("no admin to refund the creation deposit of %s to", denom)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, adminAddr, deposit)
+	if err != nil {
+		return err
+	}
+	return k.setCreationDeposit(ctx, denom, nil)
+}
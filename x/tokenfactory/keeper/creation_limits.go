@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetCreatorDenomCount returns the number of denoms created by an address
+func (k Keeper) GetCreatorDenomCount(ctx sdk.Context, creator string) uint64 {
+	bz := k.getCreatorDenomCountsPrefixStore(ctx).Get([]byte(creator))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetAllCreatorDenomCounts returns the number of denoms created by every creator
+func (k Keeper) GetAllCreatorDenomCounts(ctx sdk.Context) []types.CreatorDenomCount {
+	iterator := k.getCreatorDenomCountsPrefixStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	counts := []types.CreatorDenomCount{}
+	for ; iterator.Valid(); iterator.Next() {
+		counts = append(counts, types.CreatorDenomCount{
+			Creator: string(iterator.Key()),
+			Count:   sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return counts
+}
+
+// setCreatorDenomCount stores the number of denoms created by an address. A zero count
+// removes it.
+func (k Keeper) setCreatorDenomCount(ctx sdk.Context, creator string, count uint64) {
+	store := k.getCreatorDenomCountsPrefixStore(ctx)
+	if count == 0 {
+		store.Delete([]byte(creator))
+		return
+	}
+	store.Set([]byte(creator), sdk.Uint64ToBigEndian(count))
+}
+
+func (k Keeper) getCreatorDenomCountsPrefixStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCreatorDenomCountsPrefix())
+}
+
+// GetBlockCreationCount returns the number of denoms created chain-wide in the last block with a
+// creation
+func (k Keeper) GetBlockCreationCount(ctx sdk.Context) types.BlockCreationCount {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.BlockCreationCountKey))
+	if bz == nil {
+		return types.BlockCreationCount{}
+	}
+
+	count := types.BlockCreationCount{}
+	k.mustUnmarshal(bz, &count)
+	return count
+}
+
+func (k Keeper) setBlockCreationCount(ctx sdk.Context, count types.BlockCreationCount) error {
+	bz, err := proto.Marshal(&count)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set([]byte(types.BlockCreationCountKey), bz)
+	return nil
+}
+
+// getCreationsInBlock returns the number of denoms created chain-wide in the current block
+func (k Keeper) getCreationsInBlock(ctx sdk.Context) uint64 {
+	count := k.GetBlockCreationCount(ctx)
+	if count.Height != ctx.BlockHeight() {
+		return 0
+	}
+	return count.Count
+}
+
+// checkCreationLimits returns an error if creating a denom would exceed the number of denoms
+// the creator can create, or the number of denoms that can be created in the current block
+func (k Keeper) checkCreationLimits(ctx sdk.Context, creator string) error {
+	params := k.GetParams(ctx)
+
+	if params.MaxDenomsPerCreator > 0 && k.GetCreatorDenomCount(ctx, creator) >= params.MaxDenomsPerCreator {
+		return types.ErrCreatorDenomLimit.Wrapf("%s already created %d denoms", creator, params.MaxDenomsPerCreator)
+	}
+
+	if params.MaxCreationsPerBlock > 0 && k.getCreationsInBlock(ctx) >= params.MaxCreationsPerBlock {
+		return types.ErrBlockCreationLimit.Wrapf("%d denoms already created at height %d", params.MaxCreationsPerBlock, ctx.BlockHeight())
+	}
+
+	return nil
+}
+
+// useCreationLimits counts a denom created by an address in the current block
+func (k Keeper) useCreationLimits(ctx sdk.Context, creator string) error {
+	k.setCreatorDenomCount(ctx, creator, k.GetCreatorDenomCount(ctx, creator)+1)
+
+	return k.setBlockCreationCount(ctx, types.BlockCreationCount{
+		Height: ctx.BlockHeight(),
+		Count:  k.getCreationsInBlock(ctx) + 1,
+	})
+}
@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
 
 func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator, denom string) {
@@ -25,3 +29,60 @@ func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator string) []string {
 func (k Keeper) GetAllDenomsIterator(ctx sdk.Context) sdk.Iterator {
 	return k.GetCreatorsPrefixStore(ctx).Iterator(nil, nil)
 }
+
+// HasDenom returns true if the denom was created through the module and hasn't been retired.
+// It only looks at the denoms of the creator, not at the bank metadata, which can also be set for
+// denoms the module doesn't manage.
+func (k Keeper) HasDenom(ctx sdk.Context, denom string) bool {
+	creator, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return false
+	}
+	return k.GetCreatorPrefixStore(ctx, creator).Has([]byte(denom))
+}
+
+// GetCreationHeight returns the height of the block a denom was created in, or zero if it was
+// created before creation heights were recorded
+func (k Keeper) GetCreationHeight(ctx sdk.Context, denom string) int64 {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomCreationHeightKey))
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// setCreationHeight stores the height of the block a denom was created in. A zero height
+// removes it.
+func (k Keeper) setCreationHeight(ctx sdk.Context, denom string, height int64) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if height <= 0 {
+		store.Delete([]byte(types.DenomCreationHeightKey))
+		return
+	}
+	store.Set([]byte(types.DenomCreationHeightKey), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetCreationTime returns the time of the block a denom was created in, or the zero time if it
+// was created before creation times were recorded
+func (k Keeper) GetCreationTime(ctx sdk.Context, denom string) time.Time {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomCreationTimeKey))
+	if bz == nil {
+		return time.Time{}
+	}
+	creationTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return creationTime
+}
+
+// setCreationTime stores the time of the block a denom was created in. The zero time removes
+// it.
+func (k Keeper) setCreationTime(ctx sdk.Context, denom string, creationTime time.Time) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if creationTime.IsZero() {
+		store.Delete([]byte(types.DenomCreationTimeKey))
+		return
+	}
+	store.Set([]byte(types.DenomCreationTimeKey), sdk.FormatTimeBytes(creationTime))
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// IsAddressFrozen returns true if the address can't send or receive a specific denom
+func (k Keeper) IsAddressFrozen(ctx sdk.Context, denom string, address string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetFrozenAddressKey(address))
+}
+
+// GetAllFrozenAddresses returns the frozen addresses of a specific denom
+func (k Keeper) GetAllFrozenAddresses(ctx sdk.Context, denom string) []string {
+	store := k.GetFrozenAddressesPrefixStore(ctx, denom)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+	return addresses
+}
+
+// GetFrozenAddressesPrefixStore returns the substore that contains the frozen addresses of a
+// specific denom
+func (k Keeper) GetFrozenAddressesPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAddressesPrefix())
+}
+
+// setFrozen freezes or unfreezes an address for a specific denom
+func (k Keeper) setFrozen(ctx sdk.Context, denom string, address string, frozen bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if frozen {
+		store.Set(types.GetFrozenAddressKey(address), []byte{1})
+	} else {
+		store.Delete(types.GetFrozenAddressKey(address))
+	}
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestFreeze ensures the following properties of frozen addresses:
+// * Only the admin and freezers can freeze and unfreeze addresses
+// * Frozen addresses can neither send nor receive the denom, other denoms are unaffected
+// * Minting and force transferring to a frozen address fail, while the admin can still burn and
+// force transfer from it
+// * A frozen address can't move its balance into a new vesting account
+func (suite *KeeperTestSuite) TestFreeze() {
+	suite.CreateDefaultDenom()
+	admin, freezer, frozen := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 1000), frozen))
+	suite.Require().NoError(err)
+
+	send := func(from, to sdk.AccAddress, coins sdk.Coins) error {
+		msg := banktypes.NewMsgSend(from, to, coins)
+		_, err := suite.App.MsgServiceRouter().Handler(msg)(suite.Ctx, msg)
+		return err
+	}
+	isFrozen := func(address string) bool {
+		res, err := suite.queryClient.IsFrozen(suite.Ctx.Context(), &types.QueryIsFrozenRequest{Denom: suite.defaultDenom, Address: address})
+		suite.Require().NoError(err)
+		return res.Frozen
+	}
+	factoryCoins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// only the admin and freezers can freeze
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(freezer, suite.defaultDenom, []string{frozen}))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleFreezer, freezer))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(freezer, suite.defaultDenom, []string{frozen}))
+	suite.Require().NoError(err)
+	suite.Require().True(isFrozen(frozen))
+	suite.Require().False(isFrozen(admin))
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin, suite.defaultDenom, []string{freezer, frozen}))
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+
+	// the frozen address can neither send nor receive the denom
+	suite.Require().ErrorIs(send(suite.TestAccs[2], suite.TestAccs[0], factoryCoins), types.ErrAddressFrozen)
+	suite.Require().ErrorIs(send(suite.TestAccs[0], suite.TestAccs[2], factoryCoins), types.ErrAddressFrozen)
+	suite.Require().NoError(send(suite.TestAccs[0], suite.TestAccs[1], factoryCoins))
+	suite.Require().NoError(send(suite.TestAccs[2], suite.TestAccs[0], otherCoins))
+	suite.Require().NoError(send(suite.TestAccs[0], suite.TestAccs[2], otherCoins))
+
+	// vesting account creations are sends
+	vestingAccount := sdk.AccAddress("vesting_account_0001")
+	msg := vestingtypes.NewMsgCreateVestingAccount(suite.TestAccs[2], vestingAccount, factoryCoins, suite.Ctx.BlockTime().Unix()+3600, false)
+	_, err = suite.App.MsgServiceRouter().Handler(msg)(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+
+	// minting and force transferring to the frozen address fail, burning and force transferring
+	// from it don't
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), frozen))
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), admin, frozen))
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), frozen))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), frozen, admin))
+	suite.Require().NoError(err)
+
+	// frozen addresses are paginated
+	_, err = suite.msgServer.Freeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFreeze(admin, suite.defaultDenom, []string{freezer}))
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.FrozenAddresses(suite.Ctx.Context(), &types.QueryFrozenAddressesRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Addresses, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// only the admin and freezers can unfreeze, and the addresses must be frozen
+	_, err = suite.msgServer.Unfreeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnfreeze(frozen, suite.defaultDenom, []string{frozen}))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.Unfreeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnfreeze(freezer, suite.defaultDenom, []string{frozen, admin}))
+	suite.Require().ErrorIs(err, types.ErrAddressNotFrozen)
+	_, err = suite.msgServer.Unfreeze(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnfreeze(freezer, suite.defaultDenom, []string{frozen, freezer}))
+	suite.Require().NoError(err)
+	suite.Require().False(isFrozen(frozen))
+	suite.Require().False(isFrozen(freezer))
+
+	suite.Require().NoError(send(suite.TestAccs[2], suite.TestAccs[0], factoryCoins))
+	suite.Require().NoError(send(suite.TestAccs[0], suite.TestAccs[2], factoryCoins))
+}
@@ -14,7 +14,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if genState.Params.DenomCreationFee == nil {
 		genState.Params.DenomCreationFee = sdk.NewCoins()
 	}
-	k.SetParams(ctx, genState.Params)
+	err := k.SetParams(ctx, genState.Params)
+	if err != nil {
+		panic(err)
+	}
 
 	for _, genDenom := range genState.GetFactoryDenoms() {
 		creator, _, err := types.DeconstructDenom(genDenom.GetDenom())
@@ -29,6 +32,128 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if genDenom.GetPendingAdmin() != "" {
+			err = k.setPendingAdmin(ctx, genDenom.GetDenom(), genDenom.GetPendingAdmin())
+			if err != nil {
+				panic(err)
+			}
+		}
+		if !genDenom.MaxSupply.IsNil() {
+			err = k.setMaxSupply(ctx, genDenom.GetDenom(), genDenom.MaxSupply)
+			if err != nil {
+				panic(err)
+			}
+		}
+		k.setPaused(ctx, genDenom.GetDenom(), genDenom.GetPaused())
+		for _, address := range genDenom.GetFrozenAddresses() {
+			k.setFrozen(ctx, genDenom.GetDenom(), address, true)
+		}
+		k.setAllowlistEnabled(ctx, genDenom.GetDenom(), genDenom.GetAllowlistEnabled())
+		for _, address := range genDenom.GetAllowlist() {
+			k.setAllowed(ctx, genDenom.GetDenom(), address, true)
+		}
+		k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookAddress())
+		err = k.setTimelock(ctx, genDenom.GetDenom(), genDenom.GetTimelock())
+		if err != nil {
+			panic(err)
+		}
+		if !genDenom.MintRateLimit.Limit.IsNil() {
+			err = k.setMintRateLimit(ctx, genDenom.GetDenom(), genDenom.MintRateLimit)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.PendingMintRateLimit != nil {
+			err = k.setPendingMintRateLimit(ctx, genDenom.GetDenom(), *genDenom.PendingMintRateLimit)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, record := range genDenom.GetMintRecords() {
+			err = k.setMintRecord(ctx, genDenom.GetDenom(), record)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, capability := range genDenom.GetRenouncedCapabilities() {
+			k.renounceCapability(ctx, genDenom.GetDenom(), capability)
+		}
+		for _, allowance := range genDenom.GetMinterAllowances() {
+			err = k.setMinterAllowance(ctx, genDenom.GetDenom(), allowance)
+			if err != nil {
+				panic(err)
+			}
+		}
+		err = k.setCreationDeposit(ctx, genDenom.GetDenom(), genDenom.GetCreationDeposit())
+		if err != nil {
+			panic(err)
+		}
+		k.setCreationHeight(ctx, genDenom.GetDenom(), genDenom.GetCreationHeight())
+		k.setCreationTime(ctx, genDenom.GetDenom(), genDenom.GetCreationTime())
+		if !genDenom.Stats.Minted.IsNil() {
+			err = k.setDenomStats(ctx, genDenom.GetDenom(), genDenom.Stats)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, record := range genDenom.GetReferences() {
+			err = k.setReference(ctx, genDenom.GetDenom(), record)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, entry := range genDenom.GetAuditLog() {
+			err = k.setAuditLogEntry(ctx, genDenom.GetDenom(), entry)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if genState.GetNextPendingActionId() != 0 {
+		k.setNextPendingActionID(ctx, genState.GetNextPendingActionId())
+	}
+	for _, action := range genState.GetPendingActions() {
+		err := k.setPendingAction(ctx, action)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, count := range genState.GetCreatorDenomCounts() {
+		k.setCreatorDenomCount(ctx, count.Creator, count.Count)
+	}
+	err = k.setBlockCreationCount(ctx, genState.BlockCreationCount)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, denom := range genState.GetRetiredDenoms() {
+		k.setRetired(ctx, denom, true)
+	}
+	for _, history := range genState.GetRetiredDenomHistories() {
+		k.setCreationHeight(ctx, history.GetDenom(), history.GetCreationHeight())
+		k.setCreationTime(ctx, history.GetDenom(), history.GetCreationTime())
+		if !history.Stats.Minted.IsNil() {
+			err = k.setDenomStats(ctx, history.GetDenom(), history.Stats)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, entry := range history.GetAuditLog() {
+			err = k.setAuditLogEntry(ctx, history.GetDenom(), entry)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// the denoms are counted as they are created
+	summary := k.GetModuleSummary(ctx)
+	summary.TotalFeesCollected = genState.GetTotalFeesCollected()
+	err = k.setModuleSummary(ctx, summary)
+	if err != nil {
+		panic(err)
 	}
 }
 
@@ -45,14 +170,43 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom,
-			AuthorityMetadata: authorityMetadata,
-		})
+		genDenom := types.GenesisDenom{
+			Denom:                 denom,
+			AuthorityMetadata:     authorityMetadata,
+			PendingAdmin:          k.GetPendingAdmin(ctx, denom),
+			MinterAllowances:      k.GetAllMinterAllowances(ctx, denom),
+			MaxSupply:             k.GetMaxSupply(ctx, denom),
+			Paused:                k.IsPaused(ctx, denom),
+			FrozenAddresses:       k.GetAllFrozenAddresses(ctx, denom),
+			AllowlistEnabled:      k.IsAllowlistEnabled(ctx, denom),
+			Allowlist:             k.GetAllowlist(ctx, denom),
+			BeforeSendHookAddress: k.GetBeforeSendHook(ctx, denom),
+			RenouncedCapabilities: k.GetRenouncedCapabilities(ctx, denom),
+			Timelock:              k.GetTimelock(ctx, denom),
+			MintRateLimit:         k.GetMintRateLimit(ctx, denom),
+			MintRecords:           k.GetMintRecords(ctx, denom),
+			CreationDeposit:       k.GetCreationDeposit(ctx, denom),
+			References:            k.GetReferences(ctx, denom),
+			CreationHeight:        k.GetCreationHeight(ctx, denom),
+			CreationTime:          k.GetCreationTime(ctx, denom),
+			Stats:                 k.GetDenomStats(ctx, denom),
+			AuditLog:              k.GetAuditLog(ctx, denom),
+		}
+		if pending, found := k.GetPendingMintRateLimit(ctx, denom); found {
+			genDenom.PendingMintRateLimit = &pending
+		}
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
-		FactoryDenoms: genDenoms,
-		Params:        k.GetParams(ctx),
+		FactoryDenoms:         genDenoms,
+		Params:                k.GetParams(ctx),
+		NextPendingActionId:   k.GetNextPendingActionID(ctx),
+		PendingActions:        k.GetAllPendingActions(ctx),
+		CreatorDenomCounts:    k.GetAllCreatorDenomCounts(ctx),
+		BlockCreationCount:    k.GetBlockCreationCount(ctx),
+		RetiredDenoms:         k.GetAllRetiredDenoms(ctx),
+		TotalFeesCollected:    k.GetModuleSummary(ctx).TotalFeesCollected,
+		RetiredDenomHistories: k.GetAllRetiredDenomHistories(ctx),
 	}
 }
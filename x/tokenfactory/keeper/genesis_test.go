@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -9,24 +11,115 @@ import (
 
 func (suite *KeeperTestSuite) TestGenesis() {
 	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
 		FactoryDenoms: []types.GenesisDenom{
 			{
 				Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
 				},
+				MaxSupply:             sdk.NewInt(21_000_000),
+				RenouncedCapabilities: []types.DenomCapability{types.CapabilityMintable, types.CapabilityForceTransferable},
+				Timelock:              types.NewDenomTimelock(48*time.Hour, sdk.NewInt(1_000_000)),
+				MintRateLimit:         types.NewBlockMintRateLimit(sdk.ZeroInt(), 0),
+				CreationDeposit:       sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)),
+				References: []types.ReferenceRecord{
+					{ReferenceId: "payroll-1", Action: types.TypeMsgMint, TxHash: "AB12", Height: 3, ExpireTime: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)},
+				},
+				CreationHeight: 2,
+				CreationTime:   time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+				Stats: types.DenomStats{
+					Minted:           sdk.NewInt(1_000),
+					Burned:           sdk.NewInt(400),
+					ForceTransferred: sdk.NewInt(50),
+				},
+				AuditLog: []types.AuditLogEntry{
+					{
+						Sequence:       7,
+						Action:         types.TypeMsgForceTransfer,
+						Actor:          "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						Counterparties: []string{"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"},
+						Amount:         sdk.NewInt(50),
+						Height:         2,
+						Time:           time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+						TxHash:         "AB12",
+					},
+				},
 			},
 			{
 				Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/diff-admin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
 				},
+				PendingAdmin:     "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+				MaxSupply:        sdk.ZeroInt(),
+				Paused:           true,
+				AllowlistEnabled: true,
+				Allowlist:        []string{"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p"},
+				Timelock:         types.NewDenomTimelock(0, sdk.ZeroInt()),
+				MintRateLimit:    types.NewBlockMintRateLimit(sdk.ZeroInt(), 0),
+				Stats:            types.NewDenomStats(),
 			},
 			{
 				Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
 				},
+				MaxSupply:             sdk.ZeroInt(),
+				FrozenAddresses:       []string{"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p"},
+				BeforeSendHookAddress: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+				Timelock:              types.NewDenomTimelock(0, sdk.ZeroInt()),
+				MintRateLimit:         types.NewBlockMintRateLimit(sdk.NewInt(1000), 100),
+				PendingMintRateLimit: &types.PendingMintRateLimit{
+					RateLimit:     types.NewTimeMintRateLimit(sdk.NewInt(5000), 24*time.Hour),
+					EffectiveTime: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				MintRecords: []types.MintRecord{
+					{Height: 1, Time: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Amount: sdk.NewInt(400)},
+				},
+				Stats: types.NewDenomStats(),
+			},
+		},
+		NextPendingActionId: 2,
+		PendingActions: []types.PendingAction{
+			{
+				Id:        1,
+				Denom:     "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+				ReadyTime: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+				Action: &types.PendingAction_ProposeAdmin{ProposeAdmin: types.NewMsgProposeAdmin(
+					"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+					"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+					"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+				)},
+			},
+		},
+		CreatorDenomCounts: []types.CreatorDenomCount{
+			{Creator: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Count: 3},
+		},
+		BlockCreationCount: types.BlockCreationCount{Height: 5, Count: 1},
+		RetiredDenoms:      []string{"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/dogecoin"},
+		TotalFeesCollected: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 300)),
+		RetiredDenomHistories: []types.RetiredDenomHistory{
+			{
+				Denom:          "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/dogecoin",
+				CreationHeight: 1,
+				CreationTime:   time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+				Stats: types.DenomStats{
+					Minted:           sdk.NewInt(500),
+					Burned:           sdk.NewInt(500),
+					ForceTransferred: sdk.ZeroInt(),
+				},
+				AuditLog: []types.AuditLogEntry{
+					{
+						Sequence: 3,
+						Action:   types.TypeMsgRetireDenom,
+						Actor:    "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						Amount:   sdk.ZeroInt(),
+						Height:   4,
+						Time:     time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+						TxHash:   "CD34",
+					},
+				},
 			},
 		},
 	}
@@ -56,4 +149,16 @@ func (suite *KeeperTestSuite) TestGenesis() {
 	exportedGenesis := app.TokenFactoryKeeper.ExportGenesis(suite.Ctx)
 	suite.Require().NotNil(exportedGenesis)
 	suite.Require().Equal(genesisState, *exportedGenesis)
+
+	// the denoms are counted in the module summary
+	suite.Require().Equal(uint64(3), app.TokenFactoryKeeper.GetModuleSummary(suite.Ctx).TotalDenoms)
+
+	// the denoms are indexed under their admin rather than their creator
+	suite.Require().Equal([]string{
+		"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+		"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+	}, app.TokenFactoryKeeper.GetDenomsFromAdmin(suite.Ctx, "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"))
+	suite.Require().Equal([]string{
+		"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/diff-admin",
+	}, app.TokenFactoryKeeper.GetDenomsFromAdmin(suite.Ctx, "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p"))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 )
@@ -33,3 +34,271 @@ func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFro
 	denoms := k.GetDenomsFromCreator(sdkCtx, req.GetCreator())
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) PendingAdmin(ctx context.Context, req *types.QueryPendingAdminRequest) (*types.QueryPendingAdminResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pendingAdmin := k.GetPendingAdmin(sdkCtx, req.GetDenom())
+	return &types.QueryPendingAdminResponse{PendingAdmin: pendingAdmin}, nil
+}
+
+func (k Keeper) MinterAllowance(ctx context.Context, req *types.QueryMinterAllowanceRequest) (*types.QueryMinterAllowanceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	allowance, found := k.GetMinterAllowance(sdkCtx, req.GetDenom(), req.GetMinter())
+	if !found {
+		return nil, types.ErrMinterNotFound.Wrapf("minter %s of %s", req.GetMinter(), req.GetDenom())
+	}
+
+	return &types.QueryMinterAllowanceResponse{MinterAllowance: allowance.Replenish(sdkCtx.BlockTime())}, nil
+}
+
+func (k Keeper) MinterAllowances(ctx context.Context, req *types.QueryMinterAllowancesRequest) (*types.QueryMinterAllowancesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	allowances := []types.MinterAllowance{}
+	store := k.GetMinterAllowancesPrefixStore(sdkCtx, req.GetDenom())
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		allowance := types.MinterAllowance{}
+		k.mustUnmarshal(value, &allowance)
+		allowances = append(allowances, allowance.Replenish(sdkCtx.BlockTime()))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMinterAllowancesResponse{MinterAllowances: allowances, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MaxSupply(ctx context.Context, req *types.QueryMaxSupplyRequest) (*types.QueryMaxSupplyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryMaxSupplyResponse{MaxSupply: k.GetMaxSupply(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) CreationDeposit(ctx context.Context, req *types.QueryCreationDepositRequest) (*types.QueryCreationDepositResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryCreationDepositResponse{CreationDeposit: k.GetCreationDeposit(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) Paused(ctx context.Context, req *types.QueryPausedRequest) (*types.QueryPausedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryPausedResponse{Paused: k.IsPaused(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) FrozenAddresses(ctx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addresses := []string{}
+	store := k.GetFrozenAddressesPrefixStore(sdkCtx, req.GetDenom())
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) IsFrozen(ctx context.Context, req *types.QueryIsFrozenRequest) (*types.QueryIsFrozenResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryIsFrozenResponse{Frozen: k.IsAddressFrozen(sdkCtx, req.GetDenom(), req.GetAddress())}, nil
+}
+
+func (k Keeper) Allowlist(ctx context.Context, req *types.QueryAllowlistRequest) (*types.QueryAllowlistResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addresses := []string{}
+	store := k.GetAllowlistPrefixStore(sdkCtx, req.GetDenom())
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowlistResponse{
+		Enabled:    k.IsAllowlistEnabled(sdkCtx, req.GetDenom()),
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: k.GetBeforeSendHook(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) DenomCapabilities(ctx context.Context, req *types.QueryDenomCapabilitiesRequest) (*types.QueryDenomCapabilitiesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryDenomCapabilitiesResponse{Capabilities: k.GetCapabilities(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) Timelock(ctx context.Context, req *types.QueryTimelockRequest) (*types.QueryTimelockResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryTimelockResponse{Timelock: k.GetTimelock(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) PendingActions(ctx context.Context, req *types.QueryPendingActionsRequest) (*types.QueryPendingActionsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	actions := []types.PendingAction{}
+	store := k.GetPendingActionsPrefixStore(sdkCtx, req.GetDenom())
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		action := types.PendingAction{}
+		k.mustUnmarshal(value, &action)
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingActionsResponse{PendingActions: actions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MintRateLimit(ctx context.Context, req *types.QueryMintRateLimitRequest) (*types.QueryMintRateLimitResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	rateLimit := k.GetMintRateLimit(sdkCtx, req.GetDenom())
+	minted := k.GetMintedInWindow(sdkCtx, req.GetDenom())
+	remaining := sdk.ZeroInt()
+	if rateLimit.IsEnabled() {
+		remaining = sdk.MaxInt(rateLimit.Limit.Sub(minted), sdk.ZeroInt())
+	}
+
+	res := &types.QueryMintRateLimitResponse{RateLimit: rateLimit, Minted: minted, Remaining: remaining}
+	if pending, found := k.GetPendingMintRateLimit(sdkCtx, req.GetDenom()); found {
+		res.PendingRateLimit = &pending
+	}
+	return res, nil
+}
+
+func (k Keeper) Reference(ctx context.Context, req *types.QueryReferenceRequest) (*types.QueryReferenceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	record, found := k.GetReference(sdkCtx, req.GetDenom(), req.GetReferenceId())
+	if !found {
+		return nil, types.ErrReferenceIDNotFound.Wrapf("reference id %s of %s", req.GetReferenceId(), req.GetDenom())
+	}
+	return &types.QueryReferenceResponse{Reference: record}, nil
+}
+
+func (k Keeper) AllDenoms(ctx context.Context, req *types.QueryAllDenomsRequest) (*types.QueryAllDenomsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := k.GetCreatorsPrefixStore(sdkCtx)
+	if req.GetCreator() != "" {
+		store = k.GetCreatorPrefixStore(sdkCtx, req.GetCreator())
+	}
+
+	denoms := []types.FactoryDenom{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		denom := string(value)
+		authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, denom)
+		if err != nil {
+			return false, err
+		}
+		if !authorityMetadata.MatchesAdminFilter(req.GetAdmin(), req.GetHasAdmin()) {
+			return false, nil
+		}
+		if accumulate {
+			denoms = append(denoms, types.FactoryDenom{
+				Denom:  denom,
+				Admin:  authorityMetadata.GetAdmin(),
+				Supply: k.bankKeeper.GetSupply(sdkCtx, denom),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DenomsFromAdmin(ctx context.Context, req *types.QueryDenomsFromAdminRequest) (*types.QueryDenomsFromAdminResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denoms := []string{}
+	store := k.GetAdminPrefixStore(sdkCtx, req.GetAdmin())
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsFromAdminResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DenomInfo(ctx context.Context, req *types.QueryDenomInfoRequest) (*types.QueryDenomInfoResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !k.HasDenom(sdkCtx, req.GetDenom()) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", req.GetDenom())
+	}
+	creator, subdenom, err := types.DeconstructDenom(req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+	authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+	metadata, _ := k.bankKeeper.GetDenomMetaData(sdkCtx, req.GetDenom())
+
+	return &types.QueryDenomInfoResponse{
+		Creator:        creator,
+		Subdenom:       subdenom,
+		Admin:          authorityMetadata.GetAdmin(),
+		Metadata:       metadata,
+		Supply:         k.bankKeeper.GetSupply(sdkCtx, req.GetDenom()),
+		CreationHeight: k.GetCreationHeight(sdkCtx, req.GetDenom()),
+	}, nil
+}
+
+func (k Keeper) DenomStats(ctx context.Context, req *types.QueryDenomStatsRequest) (*types.QueryDenomStatsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !k.HasDenom(sdkCtx, req.GetDenom()) && !k.IsRetired(sdkCtx, req.GetDenom()) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", req.GetDenom())
+	}
+
+	return &types.QueryDenomStatsResponse{
+		CreationHeight: k.GetCreationHeight(sdkCtx, req.GetDenom()),
+		CreationTime:   k.GetCreationTime(sdkCtx, req.GetDenom()),
+		Stats:          k.GetDenomStats(sdkCtx, req.GetDenom()),
+	}, nil
+}
+
+func (k Keeper) ModuleSummary(ctx context.Context, _ *types.QueryModuleSummaryRequest) (*types.QueryModuleSummaryResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryModuleSummaryResponse{Summary: k.GetModuleSummary(sdkCtx)}, nil
+}
+
+func (k Keeper) DenomAuditLog(ctx context.Context, req *types.QueryDenomAuditLogRequest) (*types.QueryDenomAuditLogResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !k.HasDenom(sdkCtx, req.GetDenom()) && !k.IsRetired(sdkCtx, req.GetDenom()) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", req.GetDenom())
+	}
+
+	entries := []types.AuditLogEntry{}
+	store := k.GetAuditLogPrefixStore(sdkCtx, req.GetDenom())
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		entry := types.AuditLogEntry{}
+		k.mustUnmarshal(value, &entry)
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomAuditLogResponse{Entries: entries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestAllDenoms ensures that every denom is listed with its admin and supply, that the filters
+// combine, and that the filtered results are paginated.
+func (suite *KeeperTestSuite) TestAllDenoms() {
+	creator, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	keeper := suite.App.TokenFactoryKeeper
+
+	createDenom := func(sender, subdenom string) string {
+		res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(sender, subdenom))
+		suite.Require().NoError(err)
+		return res.GetNewTokenDenom()
+	}
+	bitcoin, litecoin, dogecoin := createDenom(creator, "bitcoin"), createDenom(creator, "litecoin"), createDenom(other, "dogecoin")
+
+	// bitcoin has a supply, litecoin has no admin, and dogecoin is handed over to the creator of
+	// the others
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(creator, sdk.NewInt64Coin(bitcoin, 100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(creator, litecoin, "", true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(other, dogecoin, creator))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(creator, dogecoin))
+	suite.Require().NoError(err)
+
+	allDenoms := func(req types.QueryAllDenomsRequest) []string {
+		// queried on the keeper, as the query client is bound to the initial block
+		res, err := keeper.AllDenoms(sdk.WrapSDKContext(suite.Ctx), &req)
+		suite.Require().NoError(err)
+		denoms := []string{}
+		for _, denom := range res.Denoms {
+			denoms = append(denoms, denom.Denom)
+		}
+		return denoms
+	}
+
+	res, err := keeper.AllDenoms(sdk.WrapSDKContext(suite.Ctx), &types.QueryAllDenomsRequest{Creator: creator, HasAdmin: types.AdminFilterWithAdmin})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FactoryDenom{{Denom: bitcoin, Admin: creator, Supply: sdk.NewInt64Coin(bitcoin, 100)}}, res.Denoms)
+
+	suite.Require().ElementsMatch([]string{bitcoin, litecoin, dogecoin}, allDenoms(types.QueryAllDenomsRequest{}))
+	suite.Require().ElementsMatch([]string{bitcoin, litecoin}, allDenoms(types.QueryAllDenomsRequest{Creator: creator}))
+	suite.Require().ElementsMatch([]string{bitcoin, dogecoin}, allDenoms(types.QueryAllDenomsRequest{Admin: creator}))
+	suite.Require().ElementsMatch([]string{dogecoin}, allDenoms(types.QueryAllDenomsRequest{Creator: other, Admin: creator}))
+	suite.Require().ElementsMatch([]string{litecoin}, allDenoms(types.QueryAllDenomsRequest{HasAdmin: types.AdminFilterWithoutAdmin}))
+	suite.Require().Empty(allDenoms(types.QueryAllDenomsRequest{Admin: other}))
+
+	// the filtered denoms are paginated
+	res, err = keeper.AllDenoms(sdk.WrapSDKContext(suite.Ctx), &types.QueryAllDenomsRequest{
+		HasAdmin:   types.AdminFilterWithAdmin,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Denoms, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	next, err := keeper.AllDenoms(sdk.WrapSDKContext(suite.Ctx), &types.QueryAllDenomsRequest{
+		HasAdmin:   types.AdminFilterWithAdmin,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(next.Denoms, 1)
+	suite.Require().ElementsMatch([]string{bitcoin, dogecoin}, []string{res.Denoms[0].Denom, next.Denoms[0].Denom})
+}
+
+// TestDenomsFromAdmin ensures that the denoms are indexed under their current admin as adminship
+// moves, is renounced, or the denom is retired, and that the index is paginated.
+func (suite *KeeperTestSuite) TestDenomsFromAdmin() {
+	creator, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	keeper := suite.App.TokenFactoryKeeper
+
+	createDenom := func(subdenom string) string {
+		res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator, subdenom))
+		suite.Require().NoError(err)
+		return res.GetNewTokenDenom()
+	}
+	bitcoin, litecoin := createDenom("bitcoin"), createDenom("litecoin")
+	suite.Require().Equal([]string{bitcoin, litecoin}, keeper.GetDenomsFromAdmin(suite.Ctx, creator))
+	suite.Require().Empty(keeper.GetDenomsFromAdmin(suite.Ctx, other))
+
+	// the denom moves to the denoms of the new admin once it accepts adminship
+	_, err := suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(creator, litecoin, other))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{bitcoin, litecoin}, keeper.GetDenomsFromAdmin(suite.Ctx, creator))
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAcceptAdmin(other, litecoin))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{bitcoin}, keeper.GetDenomsFromAdmin(suite.Ctx, creator))
+	suite.Require().Equal([]string{litecoin}, keeper.GetDenomsFromAdmin(suite.Ctx, other))
+
+	// the denoms are paginated
+	res, err := keeper.DenomsFromAdmin(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomsFromAdminRequest{
+		Admin:      other,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{litecoin}, res.Denoms)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+
+	// a renounced or retired denom is no longer indexed
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(other, litecoin, "", true))
+	suite.Require().NoError(err)
+	suite.Require().Empty(keeper.GetDenomsFromAdmin(suite.Ctx, other))
+	_, err = suite.msgServer.RetireDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRetireDenom(creator, bitcoin))
+	suite.Require().NoError(err)
+	suite.Require().Empty(keeper.GetDenomsFromAdmin(suite.Ctx, creator))
+}
+
+// TestDenomInfo ensures that the info of a denom aggregates its creator, admin, bank metadata,
+// supply and creation height, and that unknown and retired denoms are told apart from denoms
+// whose admin was renounced.
+func (suite *KeeperTestSuite) TestDenomInfo() {
+	creator := suite.TestAccs[0].String()
+	keeper := suite.App.TokenFactoryKeeper
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(42)
+	suite.CreateDefaultDenom()
+	denom := suite.defaultDenom
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(creator, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+
+	denomInfo := func(denom string) (*types.QueryDenomInfoResponse, error) {
+		// queried on the keeper, as the query client is bound to the initial block
+		return keeper.DenomInfo(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomInfoRequest{Denom: denom})
+	}
+
+	res, err := denomInfo(denom)
+	suite.Require().NoError(err)
+	metadata, _ := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, denom)
+	suite.Require().Equal(&types.QueryDenomInfoResponse{
+		Creator:        creator,
+		Subdenom:       "bitcoin",
+		Admin:          creator,
+		Metadata:       metadata,
+		Supply:         sdk.NewInt64Coin(denom, 100),
+		CreationHeight: 42,
+	}, res)
+
+	// a denom whose admin was renounced still exists
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(creator, denom, "", true))
+	suite.Require().NoError(err)
+	res, err = denomInfo(denom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Admin)
+
+	// unknown and retired denoms don't, even though retired denoms keep their bank metadata
+	_, err = denomInfo(types.DefaultParams().DenomCreationFee[0].Denom)
+	suite.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+	_, err = denomInfo(fmt.Sprintf("factory/%s/dogecoin", creator))
+	suite.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+	createRes, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator, "litecoin"))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RetireDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRetireDenom(creator, createRes.GetNewTokenDenom()))
+	suite.Require().NoError(err)
+	_, err = denomInfo(createRes.GetNewTokenDenom())
+	suite.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
+
+// TestDenomStats ensures that the stats of a denom record when it was created, and accumulate the
+// amounts minted, burned and force transferred regardless of the supply.
+func (suite *KeeperTestSuite) TestDenomStats() {
+	admin, holder := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	keeper := suite.App.TokenFactoryKeeper
+
+	creationTime := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockHeight(42).WithBlockTime(creationTime)
+	suite.CreateDefaultDenom()
+	denom := suite.defaultDenom
+
+	for i := 0; i < 2; i++ {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), holder))
+		suite.Require().NoError(err)
+		_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 30), holder))
+		suite.Require().NoError(err)
+		_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 20), holder, admin))
+		suite.Require().NoError(err)
+	}
+	// failed actions are not counted
+	_, err := suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(denom, 1000), holder))
+	suite.Require().Error(err)
+
+	// queried on the keeper, as the query client is bound to the initial block
+	res, err := keeper.DenomStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomStatsRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryDenomStatsResponse{
+		CreationHeight: 42,
+		CreationTime:   creationTime,
+		Stats: types.DenomStats{
+			Minted:           sdk.NewInt(200),
+			Burned:           sdk.NewInt(60),
+			ForceTransferred: sdk.NewInt(40),
+		},
+	}, res)
+	suite.Require().Equal(int64(140), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64())
+
+	_, err = keeper.DenomStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomStatsRequest{Denom: fmt.Sprintf("factory/%s/litecoin", admin)})
+	suite.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
+
+// TestModuleSummary ensures that the module summary counts the denoms that haven't been retired,
+// and the creation fees collected, which exclude the fees held as deposits.
+func (suite *KeeperTestSuite) TestModuleSummary() {
+	creator := suite.TestAccs[0].String()
+	keeper := suite.App.TokenFactoryKeeper
+	fee := types.DefaultParams().DenomCreationFee
+
+	moduleSummary := func() types.ModuleSummary {
+		// queried on the keeper, as the query client is bound to the initial block
+		res, err := keeper.ModuleSummary(sdk.WrapSDKContext(suite.Ctx), &types.QueryModuleSummaryRequest{})
+		suite.Require().NoError(err)
+		return res.Summary
+	}
+	createDenom := func(subdenom string) string {
+		res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator, subdenom))
+		suite.Require().NoError(err)
+		return res.GetNewTokenDenom()
+	}
+
+	suite.Require().Equal(types.ModuleSummary{}, moduleSummary())
+	createDenom("bitcoin")
+	createDenom("litecoin")
+	suite.Require().Equal(types.ModuleSummary{TotalDenoms: 2, TotalFeesCollected: fee.Add(fee...)}, moduleSummary())
+
+	// deposits are not collected fees
+	params := keeper.GetParams(suite.Ctx)
+	params.DenomCreationFeeAsDeposit = true
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+	dogecoin := createDenom("dogecoin")
+	suite.Require().Equal(types.ModuleSummary{TotalDenoms: 3, TotalFeesCollected: fee.Add(fee...)}, moduleSummary())
+
+	// retired denoms are no longer counted
+	_, err := suite.msgServer.RetireDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRetireDenom(creator, dogecoin))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ModuleSummary{TotalDenoms: 2, TotalFeesCollected: fee.Add(fee...)}, moduleSummary())
+}
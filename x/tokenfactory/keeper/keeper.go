@@ -8,47 +8,67 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
 	Keeper struct {
 		storeKey storetypes.StoreKey
+		// the store of the bank module, only used to delete the metadata of retired denoms, which
+		// the bank keeper can't do
+		bankStoreKey storetypes.StoreKey
 
-		paramSpace paramtypes.Subspace
+		// the address allowed to update the params, usually the gov module account
+		authority string
 
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		communityPoolKeeper types.CommunityPoolKeeper
+		channelKeeper       types.ChannelKeeper
+		contractKeeper      types.ContractKeeper
+		contractInfoKeeper  types.ContractInfoKeeper
 	}
 )
 
 // NewKeeper returns a new instance of the x/tokenfactory keeper
 func NewKeeper(
 	storeKey storetypes.StoreKey,
-	paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	bankStoreKey storetypes.StoreKey,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	channelKeeper types.ChannelKeeper,
+	authority string,
 ) Keeper {
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return Keeper{
-		storeKey:   storeKey,
-		paramSpace: paramSpace,
+		storeKey:     storeKey,
+		bankStoreKey: bankStoreKey,
+		authority:    authority,
 
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		channelKeeper:       channelKeeper,
 	}
 }
 
+// SetContractKeeper sets the keepers used to call the before send hooks of denoms, and to look
+// up the code of the contracts creating denoms. As the wasm keeper depends on the tokenfactory
+// keeper, they can't be given to NewKeeper.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper, contractInfoKeeper types.ContractInfoKeeper) {
+	k.contractKeeper = contractKeeper
+	k.contractInfoKeeper = contractInfoKeeper
+}
+
+// GetAuthority returns the address allowed to update the params of the x/tokenfactory module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -66,15 +86,28 @@ func (k Keeper) GetCreatorPrefixStore(ctx sdk.Context, creator string) sdk.KVSto
 	return prefix.NewStore(store, types.GetCreatorPrefix(creator))
 }
 
+// GetAdminPrefixStore returns the substore for a specific admin address
+func (k Keeper) GetAdminPrefixStore(ctx sdk.Context, admin string) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.GetAdminPrefix(admin))
+}
+
 // GetCreatorsPrefixStore returns the substore that contains a list of creators
 func (k Keeper) GetCreatorsPrefixStore(ctx sdk.Context) sdk.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.GetCreatorsPrefix())
 }
 
+// mustUnmarshal decodes a value read from the store, which is expected to always be valid
+func (k Keeper) mustUnmarshal(bz []byte, msg proto.Message) {
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		panic(err)
+	}
+}
+
 // CreateModuleAccount creates a module account with minting and burning capabilities
-// This account isn't intended to store any coins,
-// it purely mints and burns them on behalf of the admin of respective denoms,
+// This account only holds the creation deposits of the denoms,
+// it otherwise purely mints and burns coins on behalf of the admin of respective denoms,
 // and sends to the relevant address.
 func (k Keeper) CreateModuleAccount(ctx sdk.Context) {
 	moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter, authtypes.Burner)
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/exported"
+	v2 "github.com/noria-net/token-factory/x/tokenfactory/migrations/v2"
+	v3 "github.com/noria-net/token-factory/x/tokenfactory/migrations/v3"
+	v4 "github.com/noria-net/token-factory/x/tokenfactory/migrations/v4"
+	v5 "github.com/noria-net/token-factory/x/tokenfactory/migrations/v5"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
+}
+
+// Migrate1to2 migrates the params of the x/tokenfactory module from the x/params module to
+// its own store.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace)
+}
+
+// Migrate2to3 migrates the x/tokenfactory module state to index the denoms under their admin.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate3to4 migrates the x/tokenfactory module state to count the denoms in the module summary.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate4to5 migrates the x/tokenfactory module state to enable the audit log of the denoms.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey)
+}
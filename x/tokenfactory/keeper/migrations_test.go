@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/keeper"
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestMigrate1to2 ensures the denom creation fee managed by the x/params module is moved to the
+// module store, and the params that were never managed by it are set to their default
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	// a version 1 subspace only holds the denom creation fee
+	legacyFee := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100))
+	legacySubspace := suite.App.GetSubspace(types.ModuleName)
+	legacySubspace.Set(suite.Ctx, types.KeyDenomCreationFee, legacyFee)
+	suite.Require().NotEqual(legacyFee, suite.App.TokenFactoryKeeper.GetParams(suite.Ctx).DenomCreationFee)
+
+	migrator := keeper.NewMigrator(suite.App.TokenFactoryKeeper, legacySubspace)
+	suite.Require().NoError(migrator.Migrate1to2(suite.Ctx))
+
+	expectedParams := types.DefaultParams()
+	expectedParams.DenomCreationFee = legacyFee
+	suite.Require().Equal(expectedParams, suite.App.TokenFactoryKeeper.GetParams(suite.Ctx))
+	suite.Require().Equal(types.DefaultMintRateLimitIncreaseDelay, suite.App.TokenFactoryKeeper.GetParams(suite.Ctx).MintRateLimitIncreaseDelay)
+}
+
+// TestMigrate2to3 ensures the denoms created before the admin index are indexed under their
+// admin, unless they have none
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	creator := suite.TestAccs[0].String()
+
+	denoms := []string{}
+	for _, subdenom := range []string{"bitcoin", "litecoin"} {
+		res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator, subdenom))
+		suite.Require().NoError(err)
+		denoms = append(denoms, res.GetNewTokenDenom())
+	}
+	_, err := suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(creator, denoms[1], "", true))
+	suite.Require().NoError(err)
+
+	// clear the index, as it was before version 3
+	store := suite.App.TokenFactoryKeeper.GetAdminPrefixStore(suite.Ctx, creator)
+	for _, denom := range denoms {
+		store.Delete([]byte(denom))
+	}
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetDenomsFromAdmin(suite.Ctx, creator))
+
+	migrator := keeper.NewMigrator(suite.App.TokenFactoryKeeper, suite.App.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate2to3(suite.Ctx))
+	suite.Require().Equal([]string{denoms[0]}, suite.App.TokenFactoryKeeper.GetDenomsFromAdmin(suite.Ctx, creator))
+}
+
+// TestMigrate3to4 ensures the denoms created before the module summary are counted in it
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	creator := suite.TestAccs[0].String()
+	for _, subdenom := range []string{"bitcoin", "litecoin"} {
+		_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator, subdenom))
+		suite.Require().NoError(err)
+	}
+
+	// clear the summary, as it was before version 4
+	suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)).Delete([]byte(types.ModuleSummaryKey))
+	suite.Require().Equal(uint64(0), suite.App.TokenFactoryKeeper.GetModuleSummary(suite.Ctx).TotalDenoms)
+
+	migrator := keeper.NewMigrator(suite.App.TokenFactoryKeeper, suite.App.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate3to4(suite.Ctx))
+	suite.Require().Equal(uint64(2), suite.App.TokenFactoryKeeper.GetModuleSummary(suite.Ctx).TotalDenoms)
+	suite.Require().True(suite.App.TokenFactoryKeeper.GetModuleSummary(suite.Ctx).TotalFeesCollected.IsZero())
+}
+
+// TestMigrate4to5 ensures the audit log is enabled with the default maximum number of entries
+func (suite *KeeperTestSuite) TestMigrate4to5() {
+	// clear the audit log param, as it was before version 5
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.AuditLogMaxEntries = 0
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	migrator := keeper.NewMigrator(suite.App.TokenFactoryKeeper, suite.App.GetSubspace(types.ModuleName))
+	suite.Require().NoError(migrator.Migrate4to5(suite.Ctx))
+	params.AuditLogMaxEntries = types.DefaultAuditLogMaxEntries
+	suite.Require().Equal(params, suite.App.TokenFactoryKeeper.GetParams(suite.Ctx))
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetMintRateLimit returns the mint rate limit in effect for a specific denom, with a zero
+// limit if its mints are not rate limited
+func (k Keeper) GetMintRateLimit(ctx sdk.Context, denom string) types.MintRateLimit {
+	pending, found := k.getPendingMintRateLimit(ctx, denom)
+	if found && !ctx.BlockTime().Before(pending.EffectiveTime) {
+		return pending.RateLimit
+	}
+
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMintRateLimitKey))
+	if bz == nil {
+		return types.MintRateLimit{Limit: math.ZeroInt()}
+	}
+
+	rateLimit := types.MintRateLimit{}
+	k.mustUnmarshal(bz, &rateLimit)
+	return rateLimit
+}
+
+// GetPendingMintRateLimit returns the loosened mint rate limit of a specific denom that has
+// not yet taken effect, if any
+func (k Keeper) GetPendingMintRateLimit(ctx sdk.Context, denom string) (types.PendingMintRateLimit, bool) {
+	pending, found := k.getPendingMintRateLimit(ctx, denom)
+	if !found || !ctx.BlockTime().Before(pending.EffectiveTime) {
+		return types.PendingMintRateLimit{}, false
+	}
+	return pending, true
+}
+
+func (k Keeper) getPendingMintRateLimit(ctx sdk.Context, denom string) (types.PendingMintRateLimit, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomPendingMintRateLimitKey))
+	if bz == nil {
+		return types.PendingMintRateLimit{}, false
+	}
+
+	pending := types.PendingMintRateLimit{}
+	k.mustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// setMintRateLimit stores the mint rate limit of a specific denom, replacing any pending one.
+// A zero limit removes it.
+func (k Keeper) setMintRateLimit(ctx sdk.Context, denom string, rateLimit types.MintRateLimit) error {
+	err := rateLimit.Validate()
+	if err != nil {
+		return types.ErrInvalidMintRateLimit.Wrapf("mint rate limit of %s: %s", denom, err)
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	store.Delete([]byte(types.DenomPendingMintRateLimitKey))
+	if !rateLimit.IsEnabled() {
+		store.Delete([]byte(types.DenomMintRateLimitKey))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&rateLimit)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomMintRateLimitKey), bz)
+	return nil
+}
+
+// setPendingMintRateLimit stores a loosened mint rate limit of a specific denom, taking effect
+// at its effective time
+func (k Keeper) setPendingMintRateLimit(ctx sdk.Context, denom string, pending types.PendingMintRateLimit) error {
+	err := pending.RateLimit.Validate()
+	if err != nil {
+		return types.ErrInvalidMintRateLimit.Wrapf("pending mint rate limit of %s: %s", denom, err)
+	}
+
+	bz, err := proto.Marshal(&pending)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomPendingMintRateLimitKey), bz)
+	return nil
+}
+
+// updateMintRateLimit changes the mint rate limit of a specific denom. A stricter limit takes
+// effect right away, while a looser one only takes effect after the increase delay. It returns
+// the time at which the new limit takes effect.
+func (k Keeper) updateMintRateLimit(ctx sdk.Context, denom string, rateLimit types.MintRateLimit) (time.Time, error) {
+	current := k.GetMintRateLimit(ctx, denom)
+	delay := k.GetParams(ctx).MintRateLimitIncreaseDelay
+	if !current.Loosens(rateLimit) || delay == 0 {
+		return ctx.BlockTime(), k.setMintRateLimit(ctx, denom, rateLimit)
+	}
+
+	// the limit in effect is kept until the loosened one replaces it
+	err := k.setMintRateLimit(ctx, denom, current)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	pending := types.PendingMintRateLimit{
+		RateLimit:     rateLimit,
+		EffectiveTime: ctx.BlockTime().Add(delay),
+	}
+	return pending.EffectiveTime, k.setPendingMintRateLimit(ctx, denom, pending)
+}
+
+// GetMintRecords returns the amounts of a specific denom minted at every height, as last stored.
+// Records outside of the window of the rate limit are only pruned on the next mint.
+func (k Keeper) GetMintRecords(ctx sdk.Context, denom string) []types.MintRecord {
+	store := k.GetMintRecordsPrefixStore(ctx, denom)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.MintRecord
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MintRecord{}
+		k.mustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetMintRecordsPrefixStore returns the substore that contains the amounts of a specific denom
+// minted within the window of its rate limit
+func (k Keeper) GetMintRecordsPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetMintRecordsPrefix())
+}
+
+// setMintRecord stores the amount of a specific denom minted at a height
+func (k Keeper) setMintRecord(ctx sdk.Context, denom string, record types.MintRecord) error {
+	err := record.Validate()
+	if err != nil {
+		return types.ErrInvalidMintRateLimit.Wrapf("mint record of %s: %s", denom, err)
+	}
+
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetMintRecordKey(record.Height), bz)
+	return nil
+}
+
+// GetMintedInWindow returns the amount of a specific denom minted within the current window of
+// its rate limit, or zero if it is not rate limited
+func (k Keeper) GetMintedInWindow(ctx sdk.Context, denom string) math.Int {
+	rateLimit := k.GetMintRateLimit(ctx, denom)
+	minted := math.ZeroInt()
+	if !rateLimit.IsEnabled() {
+		return minted
+	}
+
+	for _, record := range k.GetMintRecords(ctx, denom) {
+		if rateLimit.InWindow(record, ctx.BlockHeight(), ctx.BlockTime()) {
+			minted = minted.Add(record.Amount)
+		}
+	}
+	return minted
+}
+
+// useMintRateLimit returns an error if minting the given amount would exceed the rate limit of
+// its denom, and records the mint otherwise. Records that fell out of the window are pruned.
+func (k Keeper) useMintRateLimit(ctx sdk.Context, amount sdk.Coin) error {
+	// a pending rate limit that took effect replaces the stored one
+	if pending, found := k.getPendingMintRateLimit(ctx, amount.Denom); found && !ctx.BlockTime().Before(pending.EffectiveTime) {
+		err := k.setMintRateLimit(ctx, amount.Denom, pending.RateLimit)
+		if err != nil {
+			return err
+		}
+	}
+
+	rateLimit := k.GetMintRateLimit(ctx, amount.Denom)
+	if !rateLimit.IsEnabled() {
+		return nil
+	}
+
+	store := k.GetDenomPrefixStore(ctx, amount.Denom)
+	minted := math.ZeroInt()
+	current := types.MintRecord{Height: ctx.BlockHeight(), Time: ctx.BlockTime(), Amount: math.ZeroInt()}
+	for _, record := range k.GetMintRecords(ctx, amount.Denom) {
+		if !rateLimit.InWindow(record, ctx.BlockHeight(), ctx.BlockTime()) {
+			store.Delete(types.GetMintRecordKey(record.Height))
+			continue
+		}
+		minted = minted.Add(record.Amount)
+		if record.Height == ctx.BlockHeight() {
+			current.Amount = record.Amount
+		}
+	}
+
+	if minted.Add(amount.Amount).GT(rateLimit.Limit) {
+		return types.ErrMintRateLimitExceeded.Wrapf("limit: %s, minted in window: %s, requested: %s", rateLimit.Limit, minted, amount.Amount)
+	}
+
+	current.Amount = current.Amount.Add(amount.Amount)
+	return k.setMintRecord(ctx, amount.Denom, current)
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestMintRateLimit ensures the following properties of mint rate limits:
+// * Only the admin can set the rate limit of a denom
+// * Mints by minters and delegated minters can't exceed the limit within a rolling window,
+// measured in blocks or in time
+// * A stricter limit takes effect right away, while a looser one takes effect after a delay
+func (suite *KeeperTestSuite) TestMintRateLimit() {
+	suite.CreateDefaultDenom()
+	admin, minter := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	denom := suite.defaultDenom
+	delay := types.DefaultParams().MintRateLimitIncreaseDelay
+
+	mint := func(sender string, amount int64) error {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(sender, sdk.NewInt64Coin(denom, amount)))
+		return err
+	}
+	setRateLimit := func(sender string, rateLimit types.MintRateLimit) error {
+		_, err := suite.msgServer.SetMintRateLimit(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMintRateLimit(sender, denom, rateLimit))
+		return err
+	}
+	queryRateLimit := func() *types.QueryMintRateLimitResponse {
+		// queried on the keeper, as the query client is bound to the initial block
+		res, err := suite.App.TokenFactoryKeeper.MintRateLimit(sdk.WrapSDKContext(suite.Ctx), &types.QueryMintRateLimitRequest{Denom: denom})
+		suite.Require().NoError(err)
+		return res
+	}
+	advanceBlocks := func(blocks int64) {
+		suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + blocks)
+	}
+	advanceTime := func(duration time.Duration) {
+		suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(duration))
+	}
+
+	_, err := suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(admin, denom, minter, sdk.NewInt(10_000), sdk.ZeroInt(), 0))
+	suite.Require().NoError(err)
+
+	// without a rate limit, there is no headroom to report
+	res := queryRateLimit()
+	suite.Require().False(res.RateLimit.IsEnabled())
+	suite.Require().Equal(sdk.ZeroInt(), res.Remaining)
+
+	// only the admin can set the rate limit, which applies right away when there is none
+	suite.Require().ErrorIs(setRateLimit(minter, types.NewBlockMintRateLimit(sdk.NewInt(1000), 10)), types.ErrUnauthorized)
+	suite.Require().NoError(setRateLimit(admin, types.NewBlockMintRateLimit(sdk.NewInt(1000), 10)))
+
+	// minters and delegated minters share the limit
+	suite.Require().NoError(mint(admin, 600))
+	advanceBlocks(1)
+	suite.Require().ErrorIs(mint(minter, 500), types.ErrMintRateLimitExceeded)
+	suite.Require().NoError(mint(minter, 400))
+
+	res = queryRateLimit()
+	suite.Require().Equal(sdk.NewInt(1000), res.Minted)
+	suite.Require().Equal(sdk.ZeroInt(), res.Remaining)
+
+	// mints leave the window once it has rolled past their block
+	advanceBlocks(9)
+	res = queryRateLimit()
+	suite.Require().Equal(sdk.NewInt(400), res.Minted)
+	suite.Require().Equal(sdk.NewInt(600), res.Remaining)
+	suite.Require().ErrorIs(mint(admin, 601), types.ErrMintRateLimitExceeded)
+	suite.Require().NoError(mint(admin, 600))
+
+	// a lower limit applies right away
+	advanceBlocks(10)
+	suite.Require().NoError(setRateLimit(admin, types.NewBlockMintRateLimit(sdk.NewInt(500), 10)))
+	suite.Require().Nil(queryRateLimit().PendingRateLimit)
+	suite.Require().ErrorIs(mint(admin, 501), types.ErrMintRateLimitExceeded)
+
+	// a higher limit, a shorter window or no limit at all only applies after the delay
+	for _, looser := range []types.MintRateLimit{
+		types.NewBlockMintRateLimit(sdk.NewInt(600), 10),
+		types.NewBlockMintRateLimit(sdk.NewInt(500), 5),
+		types.NewTimeMintRateLimit(sdk.NewInt(500), time.Hour),
+		types.NewBlockMintRateLimit(sdk.ZeroInt(), 0),
+	} {
+		suite.Require().NoError(setRateLimit(admin, looser))
+		res = queryRateLimit()
+		suite.Require().Equal(types.NewBlockMintRateLimit(sdk.NewInt(500), 10), res.RateLimit)
+		suite.Require().NotNil(res.PendingRateLimit)
+		suite.Require().Equal(looser, res.PendingRateLimit.RateLimit)
+		suite.Require().Equal(suite.Ctx.BlockTime().Add(delay), res.PendingRateLimit.EffectiveTime)
+	}
+
+	suite.Require().NoError(setRateLimit(admin, types.NewTimeMintRateLimit(sdk.NewInt(2000), time.Hour)))
+	advanceTime(delay - time.Second)
+	suite.Require().ErrorIs(mint(admin, 501), types.ErrMintRateLimitExceeded)
+
+	// once effective, a time window only counts the mints of the last hour
+	advanceTime(time.Second)
+	res = queryRateLimit()
+	suite.Require().Equal(types.NewTimeMintRateLimit(sdk.NewInt(2000), time.Hour), res.RateLimit)
+	suite.Require().Nil(res.PendingRateLimit)
+
+	suite.Require().NoError(mint(admin, 1500))
+	advanceBlocks(1)
+	advanceTime(30 * time.Minute)
+	suite.Require().ErrorIs(mint(admin, 501), types.ErrMintRateLimitExceeded)
+	suite.Require().NoError(mint(admin, 500))
+	advanceBlocks(1)
+	advanceTime(30 * time.Minute)
+	suite.Require().Equal(sdk.NewInt(1500), queryRateLimit().Remaining)
+
+	// the pruned mints are no longer stored
+	suite.Require().NoError(mint(admin, 100))
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetMintRecords(suite.Ctx, denom), 2)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetMinterAllowance returns the allowance of a delegated minter of a specific denom, as last
+// stored. Use Replenish to account for the replenishment due at the current block time.
+func (k Keeper) GetMinterAllowance(ctx sdk.Context, denom string, minter string) (types.MinterAllowance, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetMinterAllowanceKey(minter))
+	if bz == nil {
+		return types.MinterAllowance{}, false
+	}
+
+	allowance := types.MinterAllowance{}
+	k.mustUnmarshal(bz, &allowance)
+	return allowance, true
+}
+
+// GetAllMinterAllowances returns the allowances of all the delegated minters of a specific denom
+func (k Keeper) GetAllMinterAllowances(ctx sdk.Context, denom string) []types.MinterAllowance {
+	store := k.GetMinterAllowancesPrefixStore(ctx, denom)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var allowances []types.MinterAllowance
+	for ; iterator.Valid(); iterator.Next() {
+		allowance := types.MinterAllowance{}
+		k.mustUnmarshal(iterator.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+	return allowances
+}
+
+// GetMinterAllowancesPrefixStore returns the substore that contains the allowances of the
+// delegated minters of a specific denom
+func (k Keeper) GetMinterAllowancesPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetMinterAllowancesPrefix())
+}
+
+// setMinterAllowance stores the allowance of a delegated minter of a specific denom
+func (k Keeper) setMinterAllowance(ctx sdk.Context, denom string, allowance types.MinterAllowance) error {
+	err := allowance.Validate()
+	if err != nil {
+		return types.ErrInvalidMinterAllowance.Wrap(err.Error())
+	}
+
+	bz, err := proto.Marshal(&allowance)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetMinterAllowanceKey(allowance.Minter), bz)
+	return nil
+}
+
+// deleteMinterAllowance removes the allowance of a delegated minter of a specific denom
+func (k Keeper) deleteMinterAllowance(ctx sdk.Context, denom string, minter string) {
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.GetMinterAllowanceKey(minter))
+}
+
+// mintWithAllowance mints on behalf of a delegated minter, and decrements its remaining
+// allowance by the minted amount. Nothing is written if the allowance is exceeded or the
+// mint fails.
+func (k Keeper) mintWithAllowance(ctx sdk.Context, minter string, amount sdk.Coin, mintTo string) error {
+	allowance, found := k.GetMinterAllowance(ctx, amount.Denom, minter)
+	if !found {
+		return types.ErrMinterNotFound.Wrapf("minter %s of %s", minter, amount.Denom)
+	}
+
+	allowance = allowance.Replenish(ctx.BlockTime())
+	if amount.Amount.GT(allowance.Remaining) {
+		return types.ErrMinterAllowanceExceeded.Wrapf("remaining: %s, requested: %s", allowance.Remaining, amount.Amount)
+	}
+
+	err := k.mintTo(ctx, amount, mintTo)
+	if err != nil {
+		return err
+	}
+
+	allowance.Remaining = allowance.Remaining.Sub(amount.Amount)
+	return k.setMinterAllowance(ctx, amount.Denom, allowance)
+}
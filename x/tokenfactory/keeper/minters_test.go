@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestMinterAllowance ensures the following properties of delegated minters:
+// * Only the admin can configure, raise, lower and remove minter allowances
+// * A minter can mint up to its remaining allowance, which is decremented on mint
+// * The remaining allowance is replenished every period, up to the allowance
+func (suite *KeeperTestSuite) TestMinterAllowance() {
+	suite.CreateDefaultDenom()
+	admin, minter, other := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+	suite.Ctx = suite.Ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	mint := func(ctx sdk.Context, amount int64) error {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMintTo(minter, sdk.NewInt64Coin(suite.defaultDenom, amount), other))
+		return err
+	}
+	remaining := func(ctx sdk.Context) int64 {
+		res, err := suite.App.TokenFactoryKeeper.MinterAllowance(sdk.WrapSDKContext(ctx), &types.QueryMinterAllowanceRequest{
+			Denom:  suite.defaultDenom,
+			Minter: minter,
+		})
+		suite.Require().NoError(err)
+		return res.MinterAllowance.Remaining.Int64()
+	}
+
+	// minters can't mint before being configured
+	suite.Require().ErrorIs(mint(suite.Ctx, 10), types.ErrUnauthorized)
+	_, err := suite.App.TokenFactoryKeeper.MinterAllowance(sdk.WrapSDKContext(suite.Ctx), &types.QueryMinterAllowanceRequest{
+		Denom:  suite.defaultDenom,
+		Minter: minter,
+	})
+	suite.Require().ErrorIs(err, types.ErrMinterNotFound)
+
+	// non-admins can't configure minters
+	_, err = suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(other, suite.defaultDenom, minter, sdk.NewInt(100), sdk.NewInt(10), time.Hour))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(admin, suite.defaultDenom, minter, sdk.NewInt(100), sdk.NewInt(10), time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), remaining(suite.Ctx))
+
+	// mint within the allowance decrements it
+	suite.Require().NoError(mint(suite.Ctx, 60))
+	suite.Require().Equal(int64(40), remaining(suite.Ctx))
+	suite.Require().Equal(int64(60), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], suite.defaultDenom).Amount.Int64())
+
+	// minting above the remaining allowance fails and leaves it untouched
+	suite.Require().ErrorIs(mint(suite.Ctx, 41), types.ErrMinterAllowanceExceeded)
+	suite.Require().Equal(int64(40), remaining(suite.Ctx))
+	suite.Require().NoError(mint(suite.Ctx, 40))
+	suite.Require().Equal(int64(0), remaining(suite.Ctx))
+	suite.Require().ErrorIs(mint(suite.Ctx, 1), types.ErrMinterAllowanceExceeded)
+
+	// minter allowances don't grant other privileges
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(minter, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the allowance is replenished for every full period elapsed, up to the allowance
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour - time.Second))
+	suite.Require().Equal(int64(0), remaining(ctx))
+	ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(3*time.Hour + time.Minute))
+	suite.Require().Equal(int64(30), remaining(ctx))
+	suite.Require().NoError(mint(ctx, 25))
+	suite.Require().Equal(int64(5), remaining(ctx))
+	// the partial period is carried over
+	ctx = ctx.WithBlockTime(suite.Ctx.BlockTime().Add(4 * time.Hour))
+	suite.Require().Equal(int64(15), remaining(ctx))
+	ctx = ctx.WithBlockTime(suite.Ctx.BlockTime().Add(100 * time.Hour))
+	suite.Require().Equal(int64(100), remaining(ctx))
+
+	// raise and lower the allowance
+	_, err = suite.msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseMinterAllowance(other, suite.defaultDenom, minter, sdk.NewInt(50)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseMinterAllowance(admin, suite.defaultDenom, other, sdk.NewInt(50)))
+	suite.Require().ErrorIs(err, types.ErrMinterNotFound)
+	_, err = suite.msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseMinterAllowance(admin, suite.defaultDenom, minter, sdk.NewInt(50)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(150), remaining(ctx))
+
+	suite.Require().NoError(mint(ctx, 120))
+	_, err = suite.msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), types.NewMsgDecreaseMinterAllowance(admin, suite.defaultDenom, minter, sdk.NewInt(151)))
+	suite.Require().ErrorIs(err, types.ErrInvalidMinterAllowance)
+	_, err = suite.msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), types.NewMsgDecreaseMinterAllowance(admin, suite.defaultDenom, minter, sdk.NewInt(50)))
+	suite.Require().NoError(err)
+	allowance, found := suite.App.TokenFactoryKeeper.GetMinterAllowance(ctx, suite.defaultDenom, minter)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(100), allowance.Allowance.Int64())
+	suite.Require().Equal(int64(0), allowance.Remaining.Int64())
+
+	// removed minters can no longer mint
+	_, err = suite.msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), types.NewMsgRemoveMinter(other, suite.defaultDenom, minter))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), types.NewMsgRemoveMinter(admin, suite.defaultDenom, minter))
+	suite.Require().NoError(err)
+	_, found = suite.App.TokenFactoryKeeper.GetMinterAllowance(ctx, suite.defaultDenom, minter)
+	suite.Require().False(found)
+	suite.Require().ErrorIs(mint(ctx.WithBlockTime(ctx.BlockTime().Add(100*time.Hour)), 1), types.ErrUnauthorized)
+	_, err = suite.msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), types.NewMsgRemoveMinter(admin, suite.defaultDenom, minter))
+	suite.Require().ErrorIs(err, types.ErrMinterNotFound)
+}
+
+// TestMinterAllowanceWithRole ensures minters holding the minter role are not bound by an allowance
+func (suite *KeeperTestSuite) TestMinterAllowanceWithRole() {
+	suite.CreateDefaultDenom()
+	admin, minter := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	_, err := suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(admin, suite.defaultDenom, minter, sdk.NewInt(10), sdk.ZeroInt(), 0))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+
+	allowance, found := suite.App.TokenFactoryKeeper.GetMinterAllowance(suite.Ctx, suite.defaultDenom, minter)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(10), allowance.Remaining.Int64())
+}
+
+func (suite *KeeperTestSuite) TestMinterAllowancesQuery() {
+	suite.CreateDefaultDenom()
+
+	for _, acc := range suite.TestAccs[1:] {
+		_, err := suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(suite.TestAccs[0].String(), suite.defaultDenom, acc.String(), sdk.NewInt(10), sdk.ZeroInt(), 0))
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryClient.MinterAllowances(suite.Ctx.Context(), &types.QueryMinterAllowancesRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.MinterAllowances, 1)
+	suite.Require().Equal(uint64(len(suite.TestAccs)-1), res.Pagination.Total)
+
+	res, err = suite.queryClient.MinterAllowances(suite.Ctx.Context(), &types.QueryMinterAllowancesRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.MinterAllowances, len(suite.TestAccs)-1)
+}
+
+func (suite *KeeperTestSuite) TestMinterAllowanceEvents() {
+	suite.CreateDefaultDenom()
+	admin, minter := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	for _, tc := range []struct {
+		desc      string
+		msg       func(ctx sdk.Context) error
+		eventType string
+	}{
+		{
+			desc: "configure minter",
+			msg: func(ctx sdk.Context) error {
+				_, err := suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), types.NewMsgConfigureMinter(admin, suite.defaultDenom, minter, sdk.NewInt(10), sdk.ZeroInt(), 0))
+				return err
+			},
+			eventType: types.TypeMsgConfigureMinter,
+		},
+		{
+			desc: "increase minter allowance",
+			msg: func(ctx sdk.Context) error {
+				_, err := suite.msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseMinterAllowance(admin, suite.defaultDenom, minter, sdk.NewInt(10)))
+				return err
+			},
+			eventType: types.TypeMsgIncreaseMinterAllowance,
+		},
+		{
+			desc: "decrease minter allowance",
+			msg: func(ctx sdk.Context) error {
+				_, err := suite.msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(ctx), types.NewMsgDecreaseMinterAllowance(admin, suite.defaultDenom, minter, sdk.NewInt(10)))
+				return err
+			},
+			eventType: types.TypeMsgDecreaseMinterAllowance,
+		},
+		{
+			desc: "remove minter",
+			msg: func(ctx sdk.Context) error {
+				_, err := suite.msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), types.NewMsgRemoveMinter(admin, suite.defaultDenom, minter))
+				return err
+			},
+			eventType: types.TypeMsgRemoveMinter,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			suite.Require().NoError(tc.msg(ctx))
+			suite.AssertEventEmitted(ctx, tc.eventType, 1)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -10,6 +12,10 @@ import (
 
 type msgServer struct {
 	Keeper
+
+	// timelockElapsed is set when executing the actions whose timelock has elapsed, so that
+	// they are not queued again
+	timelockElapsed bool
 }
 
 // NewMsgServerImpl returns an implementation of the MsgServer interface
@@ -23,17 +29,45 @@ var _ types.MsgServer = msgServer{}
 func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgTokenFactoryCreateDenom) (*types.MsgTokenFactoryCreateDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	denom, err := server.Keeper.CreateDenom(ctx, msg.Sender, msg.Subdenom)
+	denom, err := server.Keeper.CreateDenom(ctx, msg.Sender, msg.Subdenom, msg.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeCreator, msg.Sender),
+		sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
+	}
+
+	if !msg.MaxSupply.IsNil() && msg.MaxSupply.IsPositive() {
+		err = server.Keeper.setMaxSupply(ctx, denom, msg.MaxSupply)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()))
+	}
+
+	if msg.AllowlistEnabled {
+		server.Keeper.setAllowlistEnabled(ctx, denom, true)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeAllowlistEnabled, "true"))
+	}
+
+	if len(msg.RenouncedCapabilities) > 0 {
+		renounced := make([]string, 0, len(msg.RenouncedCapabilities))
+		for _, capability := range msg.RenouncedCapabilities {
+			server.Keeper.renounceCapability(ctx, denom, capability)
+			renounced = append(renounced, capability.ShortName())
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeRenounced, strings.Join(renounced, ",")))
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, denom, types.TypeMsgCreateDenom, msg.Sender, nil, sdk.ZeroInt())
 	if err != nil {
 		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.TypeMsgCreateDenom,
-			sdk.NewAttribute(types.AttributeCreator, msg.Sender),
-			sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
-		),
+		sdk.NewEvent(types.TypeMsgCreateDenom, attributes...),
 	})
 
 	return &types.MsgTokenFactoryCreateDenomResponse{
@@ -55,7 +89,15 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgTokenFactoryMi
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	err = server.Keeper.checkCapability(ctx, msg.Amount.Denom, types.CapabilityMintable)
+	if err != nil {
+		return nil, err
+	}
+
+	// minters holding the role mint without limit, while delegated minters
+	// are bound by their allowance
+	isMinter := authorityMetadata.HasRole(types.RoleMinter, msg.Sender)
+	if _, found := server.Keeper.GetMinterAllowance(ctx, msg.Amount.Denom, msg.Sender); !isMinter && !found {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -63,17 +105,47 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgTokenFactoryMi
 		msg.MintToAddress = msg.Sender
 	}
 
-	err = server.Keeper.mintTo(ctx, msg.Amount, msg.MintToAddress)
+	// the reference id of a timelocked mint is used when it is queued
+	if msg.ReferenceId != "" && !server.timelockElapsed {
+		err = server.Keeper.useReferenceID(ctx, msg.Amount.Denom, msg.ReferenceId, types.TypeMsgMint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Amount.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.LocksMint(msg.Amount.Amount))
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryMintResponse{}, nil
+	}
+
+	if isMinter {
+		err = server.Keeper.mintTo(ctx, msg.Amount, msg.MintToAddress)
+	} else {
+		err = server.Keeper.mintWithAllowance(ctx, msg.Sender, msg.Amount, msg.MintToAddress)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Amount.Denom, types.TypeMsgMint, msg.Sender, []string{msg.MintToAddress}, msg.Amount.Amount)
 	if err != nil {
 		return nil, err
 	}
 
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeMintToAddress, msg.Sender),
+		sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+	}
+	if msg.ReferenceId != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeReferenceID, msg.ReferenceId))
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.TypeMsgMint,
-			sdk.NewAttribute(types.AttributeMintToAddress, msg.Sender),
-			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
-		),
+		sdk.NewEvent(types.TypeMsgMint, attributes...),
 	})
 
 	return &types.MsgTokenFactoryMintResponse{}, nil
@@ -87,7 +159,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgTokenFactoryBu
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleBurner, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -95,17 +167,40 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgTokenFactoryBu
 		msg.BurnFromAddress = msg.Sender
 	}
 
-	err = server.Keeper.burnFrom(ctx, msg.Amount, msg.BurnFromAddress)
+	if msg.BurnFromAddress != msg.Sender {
+		err = server.Keeper.checkCapability(ctx, msg.Amount.Denom, types.CapabilityBurnableFromOthers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.ReferenceId != "" {
+		err = server.Keeper.useReferenceID(ctx, msg.Amount.Denom, msg.ReferenceId, types.TypeMsgBurn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = server.Keeper.burnFrom(ctx, msg.Amount, msg.BurnFromAddress, server.Keeper.getProtectedAddresses(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Amount.Denom, types.TypeMsgBurn, msg.Sender, []string{msg.BurnFromAddress}, msg.Amount.Amount)
 	if err != nil {
 		return nil, err
 	}
 
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeBurnFromAddress, msg.Sender),
+		sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+	}
+	if msg.ReferenceId != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeReferenceID, msg.ReferenceId))
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.TypeMsgBurn,
-			sdk.NewAttribute(types.AttributeBurnFromAddress, msg.Sender),
-			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
-		),
+		sdk.NewEvent(types.TypeMsgBurn, attributes...),
 	})
 
 	return &types.MsgTokenFactoryBurnResponse{}, nil
@@ -119,11 +214,30 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgToken
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleForceTransferrer, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
-	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
+	err = server.Keeper.checkCapability(ctx, msg.Amount.Denom, types.CapabilityForceTransferable)
+	if err != nil {
+		return nil, err
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Amount.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryForceTransferResponse{}, nil
+	}
+
+	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress, server.Keeper.getProtectedAddresses(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Amount.Denom, types.TypeMsgForceTransfer, msg.Sender, []string{msg.TransferFromAddress, msg.TransferToAddress}, msg.Amount.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -152,10 +266,34 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgTokenFa
 		return nil, types.ErrUnauthorized
 	}
 
+	// adminship can only be handed over to another account through a proposal,
+	// so the only change allowed here is renouncing it.
+	if msg.NewAdmin != "" {
+		return nil, types.ErrAdminChangeNotProposed
+	}
+	if !msg.ConfirmRenounce {
+		return nil, types.ErrRenounceNotConfirmed
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryChangeAdminResponse{}, nil
+	}
+
 	err = server.Keeper.setAdmin(ctx, msg.Denom, msg.NewAdmin)
 	if err != nil {
 		return nil, err
 	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Denom, types.TypeMsgChangeAdmin, msg.Sender, nil, sdk.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgChangeAdmin,
@@ -167,6 +305,117 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgTokenFa
 	return &types.MsgTokenFactoryChangeAdminResponse{}, nil
 }
 
+func (server msgServer) ProposeAdmin(goCtx context.Context, msg *types.MsgTokenFactoryProposeAdmin) (*types.MsgTokenFactoryProposeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryProposeAdminResponse{}, nil
+	}
+
+	err = server.Keeper.setPendingAdmin(ctx, msg.Denom, msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Denom, types.TypeMsgProposeAdmin, msg.Sender, []string{msg.NewAdmin}, sdk.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgProposeAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePendingAdmin, msg.NewAdmin),
+		),
+	})
+
+	return &types.MsgTokenFactoryProposeAdminResponse{}, nil
+}
+
+func (server msgServer) AcceptAdmin(goCtx context.Context, msg *types.MsgTokenFactoryAcceptAdmin) (*types.MsgTokenFactoryAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingAdmin := server.Keeper.GetPendingAdmin(ctx, msg.Denom)
+	if pendingAdmin == "" {
+		return nil, types.ErrNoPendingAdmin.Wrapf("denom: %s", msg.Denom)
+	}
+
+	if msg.Sender != pendingAdmin {
+		return nil, types.ErrUnauthorized
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setAdmin(ctx, msg.Denom, pendingAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Denom, types.TypeMsgAcceptAdmin, msg.Sender, []string{authorityMetadata.GetAdmin()}, sdk.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgAcceptAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeNewAdmin, pendingAdmin),
+		),
+	})
+
+	return &types.MsgTokenFactoryAcceptAdminResponse{}, nil
+}
+
+func (server msgServer) CancelAdminProposal(goCtx context.Context, msg *types.MsgTokenFactoryCancelAdminProposal) (*types.MsgTokenFactoryCancelAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingAdmin := server.Keeper.GetPendingAdmin(ctx, msg.Denom)
+	if pendingAdmin == "" {
+		return nil, types.ErrNoPendingAdmin.Wrapf("denom: %s", msg.Denom)
+	}
+
+	// the proposed admin can decline the proposal as well
+	if msg.Sender != authorityMetadata.GetAdmin() && msg.Sender != pendingAdmin {
+		return nil, types.ErrUnauthorized
+	}
+
+	server.Keeper.deletePendingAdmin(ctx, msg.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgCancelAdminProposal,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePendingAdmin, pendingAdmin),
+		),
+	})
+
+	return &types.MsgTokenFactoryCancelAdminProposalResponse{}, nil
+}
+
 func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgTokenFactorySetDenomMetadata) (*types.MsgTokenFactorySetDenomMetadataResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -181,12 +430,31 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgTo
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleMetadataManager, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
+	err = server.Keeper.checkCapability(ctx, msg.Metadata.Base, types.CapabilityMetadataMutable)
+	if err != nil {
+		return nil, err
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Metadata.Base)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactorySetDenomMetadataResponse{}, nil
+	}
+
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
+	err = server.Keeper.appendAuditLog(ctx, msg.Metadata.Base, types.TypeMsgSetDenomMetadata, msg.Sender, nil, sdk.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgSetDenomMetadata,
@@ -197,3 +465,853 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgTo
 
 	return &types.MsgTokenFactorySetDenomMetadataResponse{}, nil
 }
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgTokenFactoryGrantRole) (*types.MsgTokenFactoryGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryGrantRoleResponse{}, nil
+	}
+
+	err = server.Keeper.grantRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.ShortName()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgTokenFactoryGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgTokenFactoryRevokeRole) (*types.MsgTokenFactoryRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.revokeRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.ShortName()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgTokenFactoryRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgTokenFactoryConfigureMinter) (*types.MsgTokenFactoryConfigureMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryConfigureMinterResponse{}, nil
+	}
+
+	allowance := types.NewMinterAllowance(msg.Minter, msg.Allowance, msg.ReplenishAmount, msg.ReplenishPeriod, ctx.BlockTime())
+	err = server.Keeper.setMinterAllowance(ctx, msg.Denom, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgConfigureMinter,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMinter, msg.Minter),
+			sdk.NewAttribute(types.AttributeAllowance, allowance.Allowance.String()),
+			sdk.NewAttribute(types.AttributeRemaining, allowance.Remaining.String()),
+		),
+	})
+
+	return &types.MsgTokenFactoryConfigureMinterResponse{}, nil
+}
+
+func (server msgServer) IncreaseMinterAllowance(goCtx context.Context, msg *types.MsgTokenFactoryIncreaseMinterAllowance) (*types.MsgTokenFactoryIncreaseMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	allowance, err := server.getMinterAllowanceAsAdmin(ctx, msg.Sender, msg.Denom, msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryIncreaseMinterAllowanceResponse{}, nil
+	}
+
+	allowance.Allowance = allowance.Allowance.Add(msg.Amount)
+	allowance.Remaining = allowance.Remaining.Add(msg.Amount)
+	err = server.Keeper.setMinterAllowance(ctx, msg.Denom, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgIncreaseMinterAllowance,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMinter, msg.Minter),
+			sdk.NewAttribute(types.AttributeAllowance, allowance.Allowance.String()),
+			sdk.NewAttribute(types.AttributeRemaining, allowance.Remaining.String()),
+		),
+	})
+
+	return &types.MsgTokenFactoryIncreaseMinterAllowanceResponse{}, nil
+}
+
+func (server msgServer) DecreaseMinterAllowance(goCtx context.Context, msg *types.MsgTokenFactoryDecreaseMinterAllowance) (*types.MsgTokenFactoryDecreaseMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	allowance, err := server.getMinterAllowanceAsAdmin(ctx, msg.Sender, msg.Denom, msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.GT(allowance.Allowance) {
+		return nil, types.ErrInvalidMinterAllowance.Wrapf("cannot decrease allowance %s by %s", allowance.Allowance, msg.Amount)
+	}
+
+	// the remaining allowance is lowered by the same amount, but can't go below zero
+	allowance.Allowance = allowance.Allowance.Sub(msg.Amount)
+	allowance.Remaining = sdk.MaxInt(allowance.Remaining.Sub(msg.Amount), sdk.ZeroInt())
+	err = server.Keeper.setMinterAllowance(ctx, msg.Denom, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgDecreaseMinterAllowance,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMinter, msg.Minter),
+			sdk.NewAttribute(types.AttributeAllowance, allowance.Allowance.String()),
+			sdk.NewAttribute(types.AttributeRemaining, allowance.Remaining.String()),
+		),
+	})
+
+	return &types.MsgTokenFactoryDecreaseMinterAllowanceResponse{}, nil
+}
+
+func (server msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgTokenFactoryRemoveMinter) (*types.MsgTokenFactoryRemoveMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := server.getMinterAllowanceAsAdmin(ctx, msg.Sender, msg.Denom, msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	server.Keeper.deleteMinterAllowance(ctx, msg.Denom, msg.Minter)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRemoveMinter,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMinter, msg.Minter),
+		),
+	})
+
+	return &types.MsgTokenFactoryRemoveMinterResponse{}, nil
+}
+
+func (server msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgTokenFactorySetMaxSupply) (*types.MsgTokenFactorySetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.lowerMaxSupply(ctx, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMaxSupply,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		),
+	})
+
+	return &types.MsgTokenFactorySetMaxSupplyResponse{}, nil
+}
+
+func (server msgServer) Pause(goCtx context.Context, msg *types.MsgTokenFactoryPause) (*types.MsgTokenFactoryPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RolePauser, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if server.Keeper.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrDenomPaused.Wrapf("denom: %s", msg.Denom)
+	}
+
+	server.Keeper.setPaused(ctx, msg.Denom, true)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgPause,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgTokenFactoryPauseResponse{}, nil
+}
+
+func (server msgServer) Unpause(goCtx context.Context, msg *types.MsgTokenFactoryUnpause) (*types.MsgTokenFactoryUnpauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RolePauser, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if !server.Keeper.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrDenomNotPaused.Wrapf("denom: %s", msg.Denom)
+	}
+
+	server.Keeper.setPaused(ctx, msg.Denom, false)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgUnpause,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgTokenFactoryUnpauseResponse{}, nil
+}
+
+func (server msgServer) Freeze(goCtx context.Context, msg *types.MsgTokenFactoryFreeze) (*types.MsgTokenFactoryFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RoleFreezer, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	for _, address := range msg.Addresses {
+		if server.Keeper.IsAddressFrozen(ctx, msg.Denom, address) {
+			return nil, types.ErrAddressFrozen.Wrapf("%s is already frozen for %s", address, msg.Denom)
+		}
+	}
+	for _, address := range msg.Addresses {
+		server.Keeper.setFrozen(ctx, msg.Denom, address, true)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgFreeze,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddresses, strings.Join(msg.Addresses, ",")),
+		),
+	})
+
+	return &types.MsgTokenFactoryFreezeResponse{}, nil
+}
+
+func (server msgServer) Unfreeze(goCtx context.Context, msg *types.MsgTokenFactoryUnfreeze) (*types.MsgTokenFactoryUnfreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RoleFreezer, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	for _, address := range msg.Addresses {
+		if !server.Keeper.IsAddressFrozen(ctx, msg.Denom, address) {
+			return nil, types.ErrAddressNotFrozen.Wrapf("%s is not frozen for %s", address, msg.Denom)
+		}
+	}
+	for _, address := range msg.Addresses {
+		server.Keeper.setFrozen(ctx, msg.Denom, address, false)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgUnfreeze,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddresses, strings.Join(msg.Addresses, ",")),
+		),
+	})
+
+	return &types.MsgTokenFactoryUnfreezeResponse{}, nil
+}
+
+func (server msgServer) AddToAllowlist(goCtx context.Context, msg *types.MsgTokenFactoryAddToAllowlist) (*types.MsgTokenFactoryAddToAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RoleComplianceManager, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if !server.Keeper.IsAllowlistEnabled(ctx, msg.Denom) {
+		return nil, types.ErrAllowlistDisabled.Wrapf("denom: %s", msg.Denom)
+	}
+
+	for _, address := range msg.Addresses {
+		if server.Keeper.IsAddressAllowed(ctx, msg.Denom, address) {
+			return nil, types.ErrAddressAlreadyAllowed.Wrapf("%s is already allowed to receive %s", address, msg.Denom)
+		}
+	}
+	for _, address := range msg.Addresses {
+		server.Keeper.setAllowed(ctx, msg.Denom, address, true)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgAddToAllowlist,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddresses, strings.Join(msg.Addresses, ",")),
+		),
+	})
+
+	return &types.MsgTokenFactoryAddToAllowlistResponse{}, nil
+}
+
+func (server msgServer) RemoveFromAllowlist(goCtx context.Context, msg *types.MsgTokenFactoryRemoveFromAllowlist) (*types.MsgTokenFactoryRemoveFromAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RoleComplianceManager, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if !server.Keeper.IsAllowlistEnabled(ctx, msg.Denom) {
+		return nil, types.ErrAllowlistDisabled.Wrapf("denom: %s", msg.Denom)
+	}
+
+	for _, address := range msg.Addresses {
+		if !server.Keeper.IsAddressAllowed(ctx, msg.Denom, address) {
+			return nil, types.ErrAddressNotAllowed.Wrapf("%s is not on the allowlist of %s", address, msg.Denom)
+		}
+	}
+	for _, address := range msg.Addresses {
+		server.Keeper.setAllowed(ctx, msg.Denom, address, false)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRemoveFromAllowlist,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddresses, strings.Join(msg.Addresses, ",")),
+		),
+	})
+
+	return &types.MsgTokenFactoryRemoveFromAllowlistResponse{}, nil
+}
+
+func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgTokenFactorySetBeforeSendHook) (*types.MsgTokenFactorySetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.validateBeforeSendHook(ctx, msg.CosmwasmAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactorySetBeforeSendHookResponse{}, nil
+	}
+
+	server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeBeforeSendHook, msg.GetCosmwasmAddress()),
+		),
+	})
+
+	return &types.MsgTokenFactorySetBeforeSendHookResponse{}, nil
+}
+
+func (server msgServer) RenounceCapability(goCtx context.Context, msg *types.MsgTokenFactoryRenounceCapability) (*types.MsgTokenFactoryRenounceCapabilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.checkCapability(ctx, msg.Denom, msg.Capability)
+	if err != nil {
+		return nil, err
+	}
+
+	server.Keeper.renounceCapability(ctx, msg.Denom, msg.Capability)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceCapability,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeCapability, msg.Capability.ShortName()),
+		),
+	})
+
+	return &types.MsgTokenFactoryRenounceCapabilityResponse{}, nil
+}
+
+func (server msgServer) SetTimelock(goCtx context.Context, msg *types.MsgTokenFactorySetTimelock) (*types.MsgTokenFactorySetTimelockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	// once set, the timelock can only be changed or removed after its own delay
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactorySetTimelockResponse{}, nil
+	}
+
+	err = server.Keeper.setTimelock(ctx, msg.Denom, types.NewDenomTimelock(msg.Delay, msg.MintThreshold))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetTimelock,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeDelay, msg.Delay.String()),
+			sdk.NewAttribute(types.AttributeMintThreshold, msg.MintThreshold.String()),
+		),
+	})
+
+	return &types.MsgTokenFactorySetTimelockResponse{}, nil
+}
+
+func (server msgServer) CancelPendingAction(goCtx context.Context, msg *types.MsgTokenFactoryCancelPendingAction) (*types.MsgTokenFactoryCancelPendingActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	action, found := server.Keeper.GetPendingAction(ctx, msg.Denom, msg.Id)
+	if !found {
+		return nil, types.ErrPendingActionNotFound.Wrapf("pending action %d of %s", msg.Id, msg.Denom)
+	}
+
+	server.Keeper.deletePendingAction(ctx, action)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgCancelPendingAction,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePendingActionID, fmt.Sprint(msg.Id)),
+		),
+	})
+
+	return &types.MsgTokenFactoryCancelPendingActionResponse{}, nil
+}
+
+func (server msgServer) SetMintRateLimit(goCtx context.Context, msg *types.MsgTokenFactorySetMintRateLimit) (*types.MsgTokenFactorySetMintRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	effectiveTime, err := server.Keeper.updateMintRateLimit(ctx, msg.Denom, msg.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMintRateLimit,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeLimit, msg.RateLimit.Limit.String()),
+			sdk.NewAttribute(types.AttributeWindowBlocks, fmt.Sprint(msg.RateLimit.WindowBlocks)),
+			sdk.NewAttribute(types.AttributeWindowDuration, msg.RateLimit.WindowDuration.String()),
+			sdk.NewAttribute(types.AttributeEffectiveTime, effectiveTime.String()),
+		),
+	})
+
+	return &types.MsgTokenFactorySetMintRateLimitResponse{}, nil
+}
+
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgTokenFactoryUpdateParams) (*types.MsgTokenFactoryUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != server.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", server.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	err := server.Keeper.SetParams(ctx, msg.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgUpdateParams,
+			sdk.NewAttribute(types.AttributeAuthority, msg.Authority),
+		),
+	})
+
+	return &types.MsgTokenFactoryUpdateParamsResponse{}, nil
+}
+
+func (server msgServer) RetireDenom(goCtx context.Context, msg *types.MsgTokenFactoryRetireDenom) (*types.MsgTokenFactoryRetireDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.retireDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Denom, types.TypeMsgRetireDenom, msg.Sender, nil, sdk.ZeroInt())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRetireDenom,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgTokenFactoryRetireDenomResponse{}, nil
+}
+
+func (server msgServer) BatchMint(goCtx context.Context, msg *types.MsgTokenFactoryBatchMint) (*types.MsgTokenFactoryBatchMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.checkCapability(ctx, msg.Denom, types.CapabilityMintable)
+	if err != nil {
+		return nil, err
+	}
+
+	// the whole batch counts as a single mint of the total amount, both against the allowance
+	// of a delegated minter and the mint threshold of the timelock
+	isMinter := authorityMetadata.HasRole(types.RoleMinter, msg.Sender)
+	allowance, found := server.Keeper.GetMinterAllowance(ctx, msg.Denom, msg.Sender)
+	if !isMinter && !found {
+		return nil, types.ErrUnauthorized
+	}
+
+	total := types.BatchEntriesTotal(msg.Entries)
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.LocksMint(total))
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryBatchMintResponse{}, nil
+	}
+
+	if !isMinter {
+		allowance = allowance.Replenish(ctx.BlockTime())
+		if total.GT(allowance.Remaining) {
+			return nil, types.ErrMinterAllowanceExceeded.Wrapf("remaining: %s, requested: %s", allowance.Remaining, total)
+		}
+	}
+
+	for _, entry := range msg.Entries {
+		err = server.Keeper.mintTo(ctx, sdk.NewCoin(msg.Denom, entry.Amount), entry.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !isMinter {
+		allowance.Remaining = allowance.Remaining.Sub(total)
+		err = server.Keeper.setMinterAllowance(ctx, msg.Denom, allowance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Denom, types.TypeMsgBatchMint, msg.Sender, types.BatchEntriesAddresses(msg.Entries), total)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgBatchMint,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAmount, sdk.NewCoin(msg.Denom, total).String()),
+			sdk.NewAttribute(types.AttributeEntries, fmt.Sprint(len(msg.Entries))),
+		),
+	})
+
+	return &types.MsgTokenFactoryBatchMintResponse{}, nil
+}
+
+func (server msgServer) BatchBurn(goCtx context.Context, msg *types.MsgTokenFactoryBatchBurn) (*types.MsgTokenFactoryBatchBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RoleBurner, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	for _, entry := range msg.Entries {
+		if entry.Address != msg.Sender {
+			err = server.Keeper.checkCapability(ctx, msg.Denom, types.CapabilityBurnableFromOthers)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	protected := server.Keeper.getProtectedAddresses(ctx)
+	for _, entry := range msg.Entries {
+		err = server.Keeper.burnFrom(ctx, sdk.NewCoin(msg.Denom, entry.Amount), entry.Address, protected)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = server.Keeper.appendAuditLog(ctx, msg.Denom, types.TypeMsgBatchBurn, msg.Sender, types.BatchEntriesAddresses(msg.Entries), types.BatchEntriesTotal(msg.Entries))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgBatchBurn,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAmount, sdk.NewCoin(msg.Denom, types.BatchEntriesTotal(msg.Entries)).String()),
+			sdk.NewAttribute(types.AttributeEntries, fmt.Sprint(len(msg.Entries))),
+		),
+	})
+
+	return &types.MsgTokenFactoryBatchBurnResponse{}, nil
+}
+
+func (server msgServer) BatchForceTransfer(goCtx context.Context, msg *types.MsgTokenFactoryBatchForceTransfer) (*types.MsgTokenFactoryBatchForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RoleForceTransferrer, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.checkCapability(ctx, msg.Denom, types.CapabilityForceTransferable)
+	if err != nil {
+		return nil, err
+	}
+
+	timelock := server.Keeper.GetTimelock(ctx, msg.Denom)
+	queued, err := server.queueTimelocked(ctx, msg, timelock, timelock.IsEnabled())
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return &types.MsgTokenFactoryBatchForceTransferResponse{}, nil
+	}
+
+	protected := server.Keeper.getProtectedAddresses(ctx)
+	for _, entry := range msg.Entries {
+		err = server.Keeper.forceTransfer(ctx, sdk.NewCoin(msg.Denom, entry.Amount), entry.Address, msg.TransferToAddress, protected)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	counterparties := append(types.BatchEntriesAddresses(msg.Entries), msg.TransferToAddress)
+	err = server.Keeper.appendAuditLog(ctx, msg.Denom, types.TypeMsgBatchForceTransfer, msg.Sender, counterparties, types.BatchEntriesTotal(msg.Entries))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgBatchForceTransfer,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeTransferToAddress, msg.TransferToAddress),
+			sdk.NewAttribute(types.AttributeAmount, sdk.NewCoin(msg.Denom, types.BatchEntriesTotal(msg.Entries)).String()),
+			sdk.NewAttribute(types.AttributeEntries, fmt.Sprint(len(msg.Entries))),
+		),
+	})
+
+	return &types.MsgTokenFactoryBatchForceTransferResponse{}, nil
+}
+
+// queueTimelocked queues the message as a pending action if it is locked by the timelock of its
+// denom, unless it is executed after the timelock elapsed. It returns true if it was queued.
+func (server msgServer) queueTimelocked(ctx sdk.Context, msg sdk.Msg, timelock types.DenomTimelock, locked bool) (bool, error) {
+	if !locked || server.timelockElapsed {
+		return false, nil
+	}
+
+	_, err := server.Keeper.queuePendingAction(ctx, msg, timelock)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// getMinterAllowanceAsAdmin checks that the sender is the admin of the denom, and returns the
+// allowance of the minter replenished up to the current block time
+func (server msgServer) getMinterAllowanceAsAdmin(ctx sdk.Context, sender string, denom string, minter string) (types.MinterAllowance, error) {
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.MinterAllowance{}, err
+	}
+
+	if sender != authorityMetadata.GetAdmin() {
+		return types.MinterAllowance{}, types.ErrUnauthorized
+	}
+
+	allowance, found := server.Keeper.GetMinterAllowance(ctx, denom, minter)
+	if !found {
+		return types.MinterAllowance{}, types.ErrMinterNotFound.Wrapf("minter %s of %s", minter, denom)
+	}
+
+	return allowance.Replenish(ctx.BlockTime()), nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/noria-net/token-factory/x/tokenfactory/types"
 
@@ -142,23 +143,23 @@ func (suite *KeeperTestSuite) TestChangeAdminDenomMsg() {
 		{
 			desc: "non-admins can't change the existing admin",
 			msgChangeAdmin: func(denom string) *types.MsgTokenFactoryChangeAdmin {
-				return types.NewMsgChangeAdmin(suite.TestAccs[1].String(), denom, suite.TestAccs[2].String())
+				return types.NewMsgChangeAdmin(suite.TestAccs[1].String(), denom, "", true)
 			},
 			expectedChangeAdminPass: false,
 			expectedAdminIndex:      0,
 		},
 		{
-			desc: "success change admin",
+			desc: "success renounce admin",
 			msgChangeAdmin: func(denom string) *types.MsgTokenFactoryChangeAdmin {
-				return types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, suite.TestAccs[1].String())
+				return types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, "", true)
 			},
-			expectedAdminIndex:      1,
+			expectedAdminIndex:      -1,
 			expectedChangeAdminPass: true,
 			expectedMessageEvents:   1,
 			msgMint: func(denom string) *types.MsgTokenFactoryMint {
-				return types.NewMsgMint(suite.TestAccs[1].String(), sdk.NewInt64Coin(denom, 5))
+				return types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 5))
 			},
-			expectedMintPass: true,
+			expectedMintPass: false,
 		},
 	} {
 		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
@@ -179,6 +180,27 @@ func (suite *KeeperTestSuite) TestChangeAdminDenomMsg() {
 	}
 }
 
+// TestAdminProposalMsgs tests TypeMsgProposeAdmin, TypeMsgAcceptAdmin and TypeMsgCancelAdminProposal
+// messages are emitted on a successful admin handover
+func (suite *KeeperTestSuite) TestAdminProposalMsgs() {
+	suite.CreateDefaultDenom()
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	_, err := suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgProposeAdmin(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgProposeAdmin, 1)
+
+	_, err = suite.msgServer.CancelAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelAdminProposal(suite.TestAccs[0].String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgCancelAdminProposal, 1)
+
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgProposeAdmin(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), types.NewMsgAcceptAdmin(suite.TestAccs[1].String(), suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgAcceptAdmin, 1)
+}
+
 // TestSetDenomMetaDataMsg tests TypeMsgSetDenomMetadata message is emitted on a successful denom metadata change
 func (suite *KeeperTestSuite) TestSetDenomMetaDataMsg() {
 	// setup test
@@ -245,3 +267,51 @@ func (suite *KeeperTestSuite) TestSetDenomMetaDataMsg() {
 		})
 	}
 }
+
+// TestUpdateParamsMsg tests TypeMsgUpdateParams message is emitted on a successful params update
+// by the module authority
+func (suite *KeeperTestSuite) TestUpdateParamsMsg() {
+	// setup test
+	suite.SetupTest()
+	authority := suite.App.TokenFactoryKeeper.GetAuthority()
+	newParams := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)), time.Hour)
+
+	for _, tc := range []struct {
+		desc                  string
+		msgUpdateParams       types.MsgTokenFactoryUpdateParams
+		expectedPass          bool
+		expectedMessageEvents int
+	}{
+		{
+			desc:                  "update by the authority",
+			msgUpdateParams:       *types.NewMsgUpdateParams(authority, newParams),
+			expectedPass:          true,
+			expectedMessageEvents: 1,
+		},
+		{
+			desc:            "update by another account",
+			msgUpdateParams: *types.NewMsgUpdateParams(suite.TestAccs[0].String(), newParams),
+			expectedPass:    false,
+		},
+		{
+			desc:            "invalid params",
+			msgUpdateParams: *types.NewMsgUpdateParams(authority, types.NewParams(sdk.NewCoins(), -time.Hour)),
+			expectedPass:    false,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			suite.Require().Equal(0, len(ctx.EventManager().Events()))
+			// Test update params message
+			_, err := suite.msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &tc.msgUpdateParams)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(newParams, suite.App.TokenFactoryKeeper.GetParams(ctx))
+			} else {
+				suite.Require().Error(err)
+			}
+			// Ensure current number and type of event is emitted
+			suite.AssertEventEmitted(ctx, types.TypeMsgUpdateParams, tc.expectedMessageEvents)
+		})
+	}
+}
@@ -8,11 +8,27 @@ import (
 
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.ParamsKey))
+	if bz == nil {
+		return params
+	}
+
+	k.mustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams sets the total set of params.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	err := params.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := params.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set([]byte(types.ParamsKey), bz)
+	return nil
 }
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// IsPaused returns true if the transfers of a specific denom are paused
+func (k Keeper) IsPaused(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomPausedKey))
+}
+
+// setPaused pauses or resumes the transfers of a specific denom
+func (k Keeper) setPaused(ctx sdk.Context, denom string, paused bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if paused {
+		store.Set([]byte(types.DenomPausedKey), []byte{1})
+	} else {
+		store.Delete([]byte(types.DenomPausedKey))
+	}
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestPause ensures the following properties of paused denoms:
+// * Only the admin and pausers can pause and unpause a denom
+// * Bank sends and multi-sends of a paused denom fail, as do vesting account creations and
+// community pool fundings, other denoms are unaffected
+// * The admin can still mint and burn a paused denom
+func (suite *KeeperTestSuite) TestPause() {
+	suite.CreateDefaultDenom()
+	admin, pauser, other := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+
+	// bank messages are routed like transactions and contract messages are
+	bankSend := func(msg sdk.Msg) error {
+		_, err := suite.App.MsgServiceRouter().Handler(msg)(suite.Ctx, msg)
+		return err
+	}
+	send := func(coins sdk.Coins) error {
+		return bankSend(banktypes.NewMsgSend(suite.TestAccs[0], suite.TestAccs[2], coins))
+	}
+	multiSend := func(coins sdk.Coins) error {
+		return bankSend(banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(suite.TestAccs[0], coins.Add(coins...))},
+			[]banktypes.Output{banktypes.NewOutput(suite.TestAccs[1], coins), banktypes.NewOutput(suite.TestAccs[2], coins)},
+		))
+	}
+	vestingAccounts := 0
+	createVestingAccount := func(coins sdk.Coins) error {
+		vestingAccounts++
+		to := sdk.AccAddress(fmt.Sprintf("vesting_account_%04d", vestingAccounts))
+		return bankSend(vestingtypes.NewMsgCreateVestingAccount(suite.TestAccs[0], to, coins, suite.Ctx.BlockTime().Unix()+3600, false))
+	}
+	fundCommunityPool := func(coins sdk.Coins) error {
+		return bankSend(distrtypes.NewMsgFundCommunityPool(coins, suite.TestAccs[0]))
+	}
+	paused := func() bool {
+		res, err := suite.queryClient.Paused(suite.Ctx.Context(), &types.QueryPausedRequest{Denom: suite.defaultDenom})
+		suite.Require().NoError(err)
+		return res.Paused
+	}
+	factoryCoins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	suite.Require().False(paused())
+	suite.Require().NoError(send(factoryCoins))
+	suite.Require().NoError(multiSend(factoryCoins))
+	suite.Require().NoError(createVestingAccount(factoryCoins))
+	suite.Require().NoError(fundCommunityPool(factoryCoins))
+
+	// only the admin and pausers can pause
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(pauser, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, types.RolePauser, pauser))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(pauser, suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.Require().True(paused())
+	_, err = suite.msgServer.Pause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgPause(admin, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// transfers of the paused denom fail, including alongside other denoms
+	suite.Require().ErrorIs(send(factoryCoins), types.ErrDenomPaused)
+	suite.Require().ErrorIs(send(factoryCoins.Add(otherCoins...)), types.ErrDenomPaused)
+	suite.Require().ErrorIs(multiSend(factoryCoins), types.ErrDenomPaused)
+	suite.Require().ErrorIs(createVestingAccount(factoryCoins), types.ErrDenomPaused)
+	suite.Require().ErrorIs(fundCommunityPool(factoryCoins), types.ErrDenomPaused)
+	suite.Require().NoError(send(otherCoins))
+	suite.Require().NoError(multiSend(otherCoins))
+	suite.Require().NoError(createVestingAccount(otherCoins))
+	suite.Require().NoError(fundCommunityPool(otherCoins))
+
+	// the admin can still mint and burn
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().NoError(err)
+
+	// only the admin and pausers can unpause
+	_, err = suite.msgServer.Unpause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnpause(other, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.Unpause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnpause(admin, suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.Require().False(paused())
+	_, err = suite.msgServer.Unpause(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnpause(pauser, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrDenomNotPaused)
+
+	suite.Require().NoError(send(factoryCoins))
+	suite.Require().NoError(multiSend(factoryCoins))
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// getProtectedAddresses returns the addresses whose balances can't be force transferred or burned
+// by the admins of denoms, other than module accounts, along with the reason they are protected:
+// the ICS-20 escrow addresses and the protected addresses of the params. Computing the escrow
+// addresses iterates over all the channels, so it is done once per message, and shared by the
+// entries of the batch messages.
+func (k Keeper) getProtectedAddresses(ctx sdk.Context) map[string]string {
+	protected := map[string]string{}
+	for address := range k.getTransferEscrowAddresses(ctx) {
+		protected[address] = "an ICS-20 escrow address"
+	}
+	for _, address := range k.GetParams(ctx).ProtectedAddresses {
+		protected[address] = "on the protected addresses"
+	}
+	return protected
+}
+
+// checkNotProtected returns an error if the balance of an address can't be force transferred or
+// burned by the admins of denoms. Module accounts, ICS-20 escrow addresses and the protected
+// addresses of the params are protected, as moving their balances would break the accounting of
+// the modules holding them, such as the supply of a denom on the counterparty of a channel.
+func (k Keeper) checkNotProtected(ctx sdk.Context, protected map[string]string, addr sdk.AccAddress) error {
+	if k.isModuleAccount(ctx, addr) {
+		return types.ErrProtectedAddress.Wrapf("%s is a module account", addr)
+	}
+
+	if reason, found := protected[addr.String()]; found {
+		return types.ErrProtectedAddress.Wrapf("%s is %s", addr, reason)
+	}
+
+	return nil
+}
+
+// isModuleAccount returns true if an address is the account of a module
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
+// getTransferEscrowAddresses returns the ICS-20 escrow addresses of all the channels. Channels in
+// any state are included, as escrowed funds stay in place once a channel is closed.
+func (k Keeper) getTransferEscrowAddresses(ctx sdk.Context) map[string]bool {
+	escrows := map[string]bool{}
+	if k.channelKeeper == nil {
+		return escrows
+	}
+
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		escrows[transfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId).String()] = true
+	}
+	return escrows
+}
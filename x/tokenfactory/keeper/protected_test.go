@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/noria-net/token-factory/app"
+	"github.com/noria-net/token-factory/x/tokenfactory/keeper"
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+type ProtectedAddressTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestProtectedAddressTestSuite(t *testing.T) {
+	suite.Run(t, new(ProtectedAddressTestSuite))
+}
+
+func (suite *ProtectedAddressTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp(suite.T())
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+// TestProtectedAddresses ensures that the balances of module accounts, ICS-20 escrow addresses
+// and the protected addresses of the params can't be force transferred or burned by the admin
+// of a denom
+func (suite *ProtectedAddressTestSuite) TestProtectedAddresses() {
+	tokenApp := suite.chainA.App.(*app.TokenApp)
+	admin := suite.chainA.SenderAccount.GetAddress()
+	holder, protected := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+	// create a denom and send some of it over the transfer channel, escrowing it on chain A
+	_, err := suite.chainA.SendMsgs(types.NewMsgCreateDenom(admin.String(), "bitcoin"))
+	suite.Require().NoError(err)
+	denom, err := types.GetTokenDenom(admin.String(), "bitcoin")
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID,
+		suite.path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(denom, 400),
+		admin.String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110),
+		0,
+		"",
+	))
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	escrow := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().Equal(int64(400), tokenApp.BankKeeper.GetBalance(ctx, escrow, denom).Amount.Int64())
+
+	// fund a module account that is not blocked by the bank, and two regular accounts with the denom
+	suite.Require().NoError(tokenApp.BankKeeper.SendCoinsFromAccountToModule(ctx, admin, govtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	suite.Require().NoError(tokenApp.BankKeeper.SendCoins(ctx, admin, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	suite.Require().NoError(tokenApp.BankKeeper.SendCoins(ctx, admin, protected, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	params := tokenApp.TokenFactoryKeeper.GetParams(ctx)
+	params.ProtectedAddresses = []string{protected.String()}
+	suite.Require().NoError(tokenApp.TokenFactoryKeeper.SetParams(ctx, params))
+
+	msgServer := keeper.NewMsgServerImpl(tokenApp.TokenFactoryKeeper)
+	forceTransfer := func(from sdk.AccAddress) error {
+		_, err := msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(denom, 10), from.String(), admin.String()))
+		return err
+	}
+	burnFrom := func(from sdk.AccAddress) error {
+		_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(denom, 10), from.String()))
+		return err
+	}
+
+	for _, address := range []sdk.AccAddress{
+		escrow,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		protected,
+	} {
+		suite.Require().ErrorIs(forceTransfer(address), types.ErrProtectedAddress)
+		suite.Require().ErrorIs(burnFrom(address), types.ErrProtectedAddress)
+	}
+	suite.Require().Equal(int64(400), tokenApp.BankKeeper.GetBalance(ctx, escrow, denom).Amount.Int64())
+
+	// the entries of the batch messages are checked against the same protected addresses
+	entries := []types.BatchEntry{
+		types.NewBatchEntry(escrow.String(), sdk.NewInt(10)),
+		types.NewBatchEntry(holder.String(), sdk.NewInt(10)),
+	}
+	_, err = msgServer.BatchBurn(sdk.WrapSDKContext(ctx), types.NewMsgBatchBurn(admin.String(), denom, entries))
+	suite.Require().ErrorIs(err, types.ErrProtectedAddress)
+	_, err = msgServer.BatchForceTransfer(sdk.WrapSDKContext(ctx), types.NewMsgBatchForceTransfer(admin.String(), denom, entries, admin.String()))
+	suite.Require().ErrorIs(err, types.ErrProtectedAddress)
+
+	// the balances of other addresses can still be moved
+	suite.Require().NoError(forceTransfer(holder))
+	suite.Require().NoError(burnFrom(holder))
+	suite.Require().Equal(int64(80), tokenApp.BankKeeper.GetBalance(ctx, holder, denom).Amount.Int64())
+}
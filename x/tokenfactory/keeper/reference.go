@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetReference returns the record of a reference id used to mint or burn a specific denom, if it
+// has not expired yet
+func (k Keeper) GetReference(ctx sdk.Context, denom, referenceID string) (types.ReferenceRecord, bool) {
+	record, found := k.getReference(ctx, denom, referenceID)
+	if !found || record.IsExpired(ctx.BlockTime()) {
+		return types.ReferenceRecord{}, false
+	}
+	return record, true
+}
+
+func (k Keeper) getReference(ctx sdk.Context, denom, referenceID string) (types.ReferenceRecord, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetReferenceKey(referenceID))
+	if bz == nil {
+		return types.ReferenceRecord{}, false
+	}
+
+	record := types.ReferenceRecord{}
+	k.mustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetReferences returns the records of the reference ids used to mint or burn a specific denom,
+// as last stored. Expired records are only pruned at the end of the block.
+func (k Keeper) GetReferences(ctx sdk.Context, denom string) []types.ReferenceRecord {
+	iterator := k.GetReferencesPrefixStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.ReferenceRecord
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ReferenceRecord{}
+		k.mustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetReferencesPrefixStore returns the substore that contains the reference ids used to mint or
+// burn a specific denom
+func (k Keeper) GetReferencesPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetReferencesPrefix())
+}
+
+// setReference stores the record of a reference id of a specific denom, and indexes it in the
+// reference queue until it expires
+func (k Keeper) setReference(ctx sdk.Context, denom string, record types.ReferenceRecord) error {
+	err := record.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetReferenceKey(record.ReferenceId), bz)
+	k.getReferenceQueuePrefixStore(ctx).Set(types.GetReferenceQueueKey(record.ExpireTime, denom, record.ReferenceId), []byte{})
+	return nil
+}
+
+// deleteReference removes the record of a reference id of a specific denom and its index in the
+// reference queue
+func (k Keeper) deleteReference(ctx sdk.Context, denom string, record types.ReferenceRecord) {
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.GetReferenceKey(record.ReferenceId))
+	k.getReferenceQueuePrefixStore(ctx).Delete(types.GetReferenceQueueKey(record.ExpireTime, denom, record.ReferenceId))
+}
+
+// useReferenceID records that the current transaction used a reference id to mint or burn a
+// specific denom. It returns an error if the reference id was already used for the denom within
+// the retention window.
+func (k Keeper) useReferenceID(ctx sdk.Context, denom, referenceID, action string) error {
+	retention := k.GetParams(ctx).ReferenceIdRetention
+	if retention == 0 {
+		return types.ErrReferenceIDsDisabled
+	}
+
+	if record, found := k.getReference(ctx, denom, referenceID); found {
+		if !record.IsExpired(ctx.BlockTime()) {
+			return types.ErrDuplicateReferenceID.Wrapf("%s was used for %s at height %d in tx %s", referenceID, denom, record.Height, record.TxHash)
+		}
+		k.deleteReference(ctx, denom, record)
+	}
+
+	return k.setReference(ctx, denom, types.ReferenceRecord{
+		ReferenceId: referenceID,
+		Action:      action,
+		TxHash:      fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())),
+		Height:      ctx.BlockHeight(),
+		ExpireTime:  ctx.BlockTime().Add(retention),
+	})
+}
+
+// PruneExpiredReferences deletes the records of the reference ids of all the denoms that expired
+func (k Keeper) PruneExpiredReferences(ctx sdk.Context) {
+	store := k.getReferenceQueuePrefixStore(ctx)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		denom, referenceID, err := types.ParseReferenceQueueKey(key)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(key)
+		k.GetDenomPrefixStore(ctx, denom).Delete(types.GetReferenceKey(referenceID))
+	}
+}
+
+func (k Keeper) getReferenceQueuePrefixStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetReferenceQueuePrefix())
+}
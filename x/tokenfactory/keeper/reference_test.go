@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestReferenceIDs ensures the following properties of the reference ids of mints and burns:
+// * A reference id can't be used twice for the same denom within the retention window
+// * The transaction and height that used a reference id can be queried until it expires
+// * Expired reference ids are pruned, after which they can be used again
+// * The reference id of a timelocked mint is used when the mint is queued
+func (suite *KeeperTestSuite) TestReferenceIDs() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	denom := suite.defaultDenom
+	keeper := suite.App.TokenFactoryKeeper
+	retention := keeper.GetParams(suite.Ctx).ReferenceIdRetention
+
+	mint := func(referenceID string) error {
+		msg := types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100))
+		msg.ReferenceId = referenceID
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), msg)
+		return err
+	}
+	burn := func(referenceID string) error {
+		msg := types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 100))
+		msg.ReferenceId = referenceID
+		_, err := suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), msg)
+		return err
+	}
+	queryReference := func(referenceID string) (types.ReferenceRecord, error) {
+		// queried on the keeper, as the query client is bound to the initial block
+		res, err := keeper.Reference(sdk.WrapSDKContext(suite.Ctx), &types.QueryReferenceRequest{Denom: denom, ReferenceId: referenceID})
+		if err != nil {
+			return types.ReferenceRecord{}, err
+		}
+		return res.Reference, nil
+	}
+
+	// a reference id can only be used once, whether to mint or burn
+	txBytes := []byte("payroll")
+	suite.Ctx = suite.Ctx.WithTxBytes(txBytes)
+	suite.Require().NoError(mint("payroll-1"))
+	suite.Require().ErrorIs(mint("payroll-1"), types.ErrDuplicateReferenceID)
+	suite.Require().ErrorIs(burn("payroll-1"), types.ErrDuplicateReferenceID)
+	suite.Require().NoError(burn("payroll-2"))
+	suite.Require().Equal(int64(0), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64())
+
+	// mints without a reference id are not deduplicated
+	suite.Require().NoError(mint(""))
+	suite.Require().NoError(mint(""))
+
+	// the transaction and height that used the reference id can be looked up
+	record, err := queryReference("payroll-1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ReferenceRecord{
+		ReferenceId: "payroll-1",
+		Action:      types.TypeMsgMint,
+		TxHash:      fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		Height:      suite.Ctx.BlockHeight(),
+		ExpireTime:  suite.Ctx.BlockTime().Add(retention),
+	}, record)
+	_, err = queryReference("payroll-3")
+	suite.Require().ErrorIs(err, types.ErrReferenceIDNotFound)
+
+	// expired reference ids are pruned and can be used again
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(retention - time.Second))
+	keeper.PruneExpiredReferences(suite.Ctx)
+	suite.Require().ErrorIs(mint("payroll-1"), types.ErrDuplicateReferenceID)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	_, err = queryReference("payroll-1")
+	suite.Require().ErrorIs(err, types.ErrReferenceIDNotFound)
+	keeper.PruneExpiredReferences(suite.Ctx)
+	suite.Require().Empty(keeper.GetReferences(suite.Ctx, denom))
+	suite.Require().NoError(mint("payroll-1"))
+
+	// the reference id of a timelocked mint is used when it is queued, and the mint is executed
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, time.Hour, sdk.NewInt(50)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(mint("payroll-4"))
+	suite.Require().ErrorIs(mint("payroll-4"), types.ErrDuplicateReferenceID)
+	suite.Require().Len(keeper.GetAllPendingActions(suite.Ctx), 1)
+	supply := suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64()
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	keeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Empty(keeper.GetAllPendingActions(suite.Ctx))
+	suite.Require().Equal(supply+100, suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64())
+
+	// reference ids are rejected when the retention is zero
+	params := keeper.GetParams(suite.Ctx)
+	params.ReferenceIdRetention = 0
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+	suite.Require().ErrorIs(burn("payroll-5"), types.ErrReferenceIDsDisabled)
+}
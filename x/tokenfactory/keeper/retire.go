@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// IsRetired returns whether a specific denom was retired
+func (k Keeper) IsRetired(ctx sdk.Context, denom string) bool {
+	return k.getRetiredDenomsPrefixStore(ctx).Has([]byte(denom))
+}
+
+// GetAllRetiredDenoms returns the denoms that were retired
+func (k Keeper) GetAllRetiredDenoms(ctx sdk.Context) []string {
+	iterator := k.getRetiredDenomsPrefixStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// setRetired marks a specific denom as retired, or removes the mark
+func (k Keeper) setRetired(ctx sdk.Context, denom string, retired bool) {
+	store := k.getRetiredDenomsPrefixStore(ctx)
+	if !retired {
+		store.Delete([]byte(denom))
+		return
+	}
+	store.Set([]byte(denom), []byte{})
+}
+
+func (k Keeper) getRetiredDenomsPrefixStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRetiredDenomsPrefix())
+}
+
+// retireDenom deletes a denom with no supply along with its state, after refunding its creation
+// deposit to the admin. The denom no longer counts towards the denoms of its creator. Its history,
+// made of its creation height and time, its lifetime stats and its audit log, is kept.
+//
+// The bank keeper can't delete denom metadata, so the bank metadata of the denom is deleted from
+// the bank store directly.
+func (k Keeper) retireDenom(ctx sdk.Context, denom string) error {
+	creator, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if !supply.IsZero() {
+		return types.ErrDenomHasSupply.Wrapf("supply of %s: %s", denom, supply.Amount)
+	}
+
+	err = k.refundCreationDeposit(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	// pending actions are also indexed in the timelock queue, outside of the denom prefix store
+	actionsStore := k.GetPendingActionsPrefixStore(ctx, denom)
+	for _, action := range k.getPendingActions(actionsStore) {
+		k.deletePendingAction(ctx, action)
+	}
+	// and so are reference ids in the reference queue
+	for _, record := range k.GetReferences(ctx, denom) {
+		k.deleteReference(ctx, denom, record)
+	}
+	// and the denom is indexed under its admin
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	k.removeDenomFromAdmin(ctx, authorityMetadata.GetAdmin(), denom)
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if !isDenomHistoryKey(iterator.Key()) {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.GetCreatorPrefixStore(ctx, creator).Delete([]byte(denom))
+	err = k.deleteBankDenomMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if count := k.GetCreatorDenomCount(ctx, creator); count > 0 {
+		k.setCreatorDenomCount(ctx, creator, count-1)
+	}
+	k.setRetired(ctx, denom, true)
+	return k.trackDenomRetired(ctx)
+}
+
+// deleteBankDenomMetadata deletes the bank metadata of a denom. The bank keeper can't delete
+// metadata, so this writes to the bank store directly and relies on its key layout, the denom
+// under DenomMetadataPrefix. It fails rather than leaving the metadata behind if that layout
+// changes.
+func (k Keeper) deleteBankDenomMetadata(ctx sdk.Context, denom string) error {
+	prefix.NewStore(ctx.KVStore(k.bankStoreKey), banktypes.DenomMetadataPrefix).Delete([]byte(denom))
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return fmt.Errorf("failed to delete the bank metadata of %s", denom)
+	}
+	return nil
+}
+
+// isDenomHistoryKey returns whether a key of the denom prefix store holds the history of the
+// denom, which is kept when it is retired
+func isDenomHistoryKey(key []byte) bool {
+	switch string(key) {
+	case types.DenomCreationHeightKey, types.DenomCreationTimeKey, types.DenomStatsKey, types.DenomNextAuditLogSequenceKey:
+		return true
+	}
+	return bytes.HasPrefix(key, types.GetAuditLogPrefix())
+}
+
+// GetAllRetiredDenomHistories returns the history of the denoms that were retired
+func (k Keeper) GetAllRetiredDenomHistories(ctx sdk.Context) []types.RetiredDenomHistory {
+	histories := []types.RetiredDenomHistory{}
+	for _, denom := range k.GetAllRetiredDenoms(ctx) {
+		histories = append(histories, types.RetiredDenomHistory{
+			Denom:          denom,
+			CreationHeight: k.GetCreationHeight(ctx, denom),
+			CreationTime:   k.GetCreationTime(ctx, denom),
+			Stats:          k.GetDenomStats(ctx, denom),
+			AuditLog:       k.GetAuditLog(ctx, denom),
+		})
+	}
+	return histories
+}
+
+// getPendingActions returns the timelocked actions stored in the pending actions substore of a
+// denom
+func (k Keeper) getPendingActions(store sdk.KVStore) []types.PendingAction {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var actions []types.PendingAction
+	for ; iterator.Valid(); iterator.Next() {
+		action := types.PendingAction{}
+		k.mustUnmarshal(iterator.Value(), &action)
+		actions = append(actions, action)
+	}
+	return actions
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestRetireDenom ensures the following properties of denom retirement:
+// * Only the admin can retire a denom, and only once its supply is zero
+// * The state of the denom is deleted, and its creation deposit is refunded to the admin
+// * The stats and the audit log of the denom are kept, and the retirement is recorded in the log
+// * The subdenom can't be reused unless the params allow it, and its stats start over if it is
+func (suite *KeeperTestSuite) TestRetireDenom() {
+	admin, other := suite.TestAccs[0], suite.TestAccs[1]
+	feeDenom := types.DefaultParams().DenomCreationFee[0].Denom
+	keeper := suite.App.TokenFactoryKeeper
+
+	params := keeper.GetParams(suite.Ctx)
+	params.DenomCreationFeeAsDeposit = true
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+
+	adminBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, admin, feeDenom).Amount
+	suite.CreateDefaultDenom()
+	denom := suite.defaultDenom
+	suite.Require().Equal(adminBalance.Sub(params.DenomCreationFee[0].Amount), suite.App.BankKeeper.GetBalance(suite.Ctx, admin, feeDenom).Amount)
+
+	retire := func(sender sdk.AccAddress) error {
+		_, err := suite.msgServer.RetireDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRetireDenom(sender.String(), denom))
+		return err
+	}
+	createDenom := func() error {
+		_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(admin.String(), "bitcoin"))
+		return err
+	}
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin.String(), denom, time.Hour, sdk.ZeroInt()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin.String(), denom, other.String()))
+	suite.Require().NoError(err)
+	suite.Require().Len(keeper.GetAllPendingActions(suite.Ctx), 1)
+
+	// only the admin can retire the denom, once it has no supply
+	suite.Require().ErrorIs(retire(other), types.ErrUnauthorized)
+	suite.Require().ErrorIs(retire(admin), types.ErrDenomHasSupply)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(retire(admin))
+
+	// the state of the denom is deleted and the deposit is refunded
+	suite.Require().Equal(adminBalance, suite.App.BankKeeper.GetBalance(suite.Ctx, admin, feeDenom).Amount)
+	suite.Require().Empty(keeper.GetCreationDeposit(suite.Ctx, denom))
+	authorityMetadata, err := keeper.GetAuthorityMetadata(suite.Ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomAuthorityMetadata{}, authorityMetadata)
+	suite.Require().False(keeper.GetTimelock(suite.Ctx, denom).IsEnabled())
+	suite.Require().Empty(keeper.GetAllPendingActions(suite.Ctx))
+	suite.Require().Empty(keeper.GetDenomsFromCreator(suite.Ctx, admin.String()))
+	suite.Require().Equal(uint64(0), keeper.GetCreatorDenomCount(suite.Ctx, admin.String()))
+	suite.Require().True(keeper.IsRetired(suite.Ctx, denom))
+
+	// the history of the denom can still be queried
+	statsRes, err := keeper.DenomStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomStatsRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), statsRes.Stats.Minted)
+	suite.Require().Equal(sdk.NewInt(100), statsRes.Stats.Burned)
+	auditLogRes, err := keeper.DenomAuditLog(sdk.WrapSDKContext(suite.Ctx), &types.QueryDenomAuditLogRequest{Denom: denom})
+	suite.Require().NoError(err)
+	var actions []string
+	for _, entry := range auditLogRes.Entries {
+		actions = append(actions, entry.Action)
+	}
+	suite.Require().Equal([]string{types.TypeMsgCreateDenom, types.TypeMsgMint, types.TypeMsgBurn, types.TypeMsgRetireDenom}, actions)
+	suite.Require().Equal(admin.String(), auditLogRes.Entries[3].Actor)
+
+	// a retired denom can't be retired again, as it has no admin
+	suite.Require().ErrorIs(retire(admin), types.ErrUnauthorized)
+
+	// the subdenom can only be reused if the params allow it
+	suite.Require().ErrorIs(createDenom(), types.ErrDenomRetired)
+	params.AllowSubdenomReuse = true
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+	suite.Require().NoError(createDenom())
+	suite.Require().False(keeper.IsRetired(suite.Ctx, denom))
+	suite.Require().Equal([]string{denom}, keeper.GetDenomsFromCreator(suite.Ctx, admin.String()))
+	suite.Require().True(keeper.GetDenomStats(suite.Ctx, denom).Minted.IsZero())
+	auditLog := keeper.GetAuditLog(suite.Ctx, denom)
+	suite.Require().Len(auditLog, 5)
+	suite.Require().Equal(types.TypeMsgCreateDenom, auditLog[4].Action)
+}
+
+// TestRetireDenomDeletesBankMetadata ensures the bank metadata of a retired denom is deleted, as
+// the keeper deletes it from the bank store directly
+func (suite *KeeperTestSuite) TestRetireDenomDeletesBankMetadata() {
+	admin := suite.TestAccs[0].String()
+	suite.CreateDefaultDenom()
+	denom := suite.defaultDenom
+
+	metadata, found := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, denom)
+	suite.Require().True(found)
+	metadata.Name = "Retired soon"
+	metadata.Symbol = "RETIRED"
+	metadata.Display = denom
+	_, err := suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.RetireDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRetireDenom(admin, denom))
+	suite.Require().NoError(err)
+
+	_, found = suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, denom)
+	suite.Require().False(found)
+	suite.Require().False(suite.App.BankKeeper.HasDenomMetaData(suite.Ctx, denom))
+	for _, metadata := range suite.App.BankKeeper.GetAllDenomMetaData(suite.Ctx) {
+		suite.Require().NotEqual(denom, metadata.Base)
+	}
+}
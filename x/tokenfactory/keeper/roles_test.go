@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestRoleMsgs ensures the following properties of denom roles:
+// * Only the admin can grant and revoke roles
+// * A role holder can only perform the actions of its role
+// * Revoked role holders lose their authority
+func (suite *KeeperTestSuite) TestRoleMsgs() {
+	suite.CreateDefaultDenom()
+	admin, holder, other := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 1000), other))
+	suite.Require().NoError(err)
+
+	// non-admins can't grant roles
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(holder, suite.defaultDenom, types.RoleMinter, holder))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// holder can't mint before being granted the role
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(holder, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// grant minter role
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, holder))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, holder))
+	suite.Require().ErrorIs(err, types.ErrRoleAlreadyGranted)
+
+	queryRes, err := suite.queryClient.DenomAuthorityMetadata(suite.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{holder}, queryRes.AuthorityMetadata.Minters)
+
+	// minters can mint, but can't burn, force transfer or set metadata
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(holder, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(holder, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(holder, sdk.NewInt64Coin(suite.defaultDenom, 10), other, holder))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// grant the remaining roles and make sure each works
+	for _, role := range []types.DenomRole{types.RoleBurner, types.RoleForceTransferrer, types.RoleMetadataManager} {
+		_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, role, holder))
+		suite.Require().NoError(err)
+	}
+
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(holder, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(holder, sdk.NewInt64Coin(suite.defaultDenom, 10), other, holder))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(holder, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.defaultDenom, Exponent: 0}},
+		Base:       suite.defaultDenom,
+		Display:    suite.defaultDenom,
+		Name:       "BTC",
+		Symbol:     "BTC",
+	}))
+	suite.Require().NoError(err)
+
+	// role holders can't grant roles themselves
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(holder, suite.defaultDenom, types.RoleMinter, other))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// revoke minter role
+	_, err = suite.msgServer.RevokeRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, holder))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RevokeRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, holder))
+	suite.Require().ErrorIs(err, types.ErrRoleNotGranted)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(holder, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the other roles are untouched
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(holder, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRoleEvents() {
+	suite.CreateDefaultDenom()
+
+	for _, tc := range []struct {
+		desc      string
+		msg       func(ctx sdk.Context) error
+		eventType string
+	}{
+		{
+			desc: "grant role",
+			msg: func(ctx sdk.Context) error {
+				_, err := suite.msgServer.GrantRole(sdk.WrapSDKContext(ctx), types.NewMsgGrantRole(suite.TestAccs[0].String(), suite.defaultDenom, types.RoleBurner, suite.TestAccs[1].String()))
+				return err
+			},
+			eventType: types.TypeMsgGrantRole,
+		},
+		{
+			desc: "revoke role",
+			msg: func(ctx sdk.Context) error {
+				_, err := suite.msgServer.RevokeRole(sdk.WrapSDKContext(ctx), types.NewMsgRevokeRole(suite.TestAccs[0].String(), suite.defaultDenom, types.RoleBurner, suite.TestAccs[1].String()))
+				return err
+			},
+			eventType: types.TypeMsgRevokeRole,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			suite.Require().NoError(tc.msg(ctx))
+			suite.AssertEventEmitted(ctx, tc.eventType, 1)
+		})
+	}
+}
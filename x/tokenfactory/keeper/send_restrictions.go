@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// CheckSendRestrictions returns an error if any of the factory denoms in amt can't be sent
+// from one address to the other
+func (k Keeper) CheckSendRestrictions(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if k.IsPaused(ctx, coin.Denom) {
+			return types.ErrDenomPaused.Wrapf("transfers of %s are paused", coin.Denom)
+		}
+
+		if k.IsAddressFrozen(ctx, coin.Denom, from.String()) {
+			return types.ErrAddressFrozen.Wrapf("%s can't send %s", from, coin.Denom)
+		}
+
+		if k.IsAddressFrozen(ctx, coin.Denom, to.String()) {
+			return types.ErrAddressFrozen.Wrapf("%s can't receive %s", to, coin.Denom)
+		}
+
+		err := k.checkCanReceiveSend(ctx, coin.Denom, to)
+		if err != nil {
+			return err
+		}
+
+		err = k.blockBeforeSend(ctx, from, to, coin)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCanReceiveSend returns an error if the denom is in allowlist mode and the recipient of a
+// send is not on its allowlist. Module accounts and ICS-20 escrow addresses can receive any send,
+// so that the allowed holders can pay fees, deposit into modules and transfer over IBC.
+func (k Keeper) checkCanReceiveSend(ctx sdk.Context, denom string, to sdk.AccAddress) error {
+	err := k.checkCanReceive(ctx, denom, to.String())
+	if err == nil || k.isModuleAccount(ctx, to) || k.getTransferEscrowAddresses(ctx)[to.String()] {
+		return nil
+	}
+	return err
+}
+
+// SendRestrictedBankKeeper wraps a bank keeper so that the transfers between accounts are
+// subject to the send restrictions and before send hooks of the token factory. It is meant to be
+// given to the bank module, and to the modules moving coins on behalf of users such as IBC
+// transfer and wasm.
+// The tokenfactory keeper itself uses the unrestricted bank keeper, so that mints and burns
+// are not affected.
+type SendRestrictedBankKeeper struct {
+	bankkeeper.Keeper
+
+	tokenFactoryKeeper *Keeper
+}
+
+var _ bankkeeper.Keeper = SendRestrictedBankKeeper{}
+
+// NewSendRestrictedBankKeeper returns a bank keeper enforcing the send restrictions of the
+// token factory
+func NewSendRestrictedBankKeeper(bankKeeper bankkeeper.Keeper, tokenFactoryKeeper *Keeper) SendRestrictedBankKeeper {
+	return SendRestrictedBankKeeper{
+		Keeper:             bankKeeper,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+	}
+}
+
+// SendCoins checks the send restrictions before transferring coins between accounts
+func (k SendRestrictedBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.tokenFactoryKeeper.CheckSendRestrictions(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	k.tokenFactoryKeeper.trackBeforeSend(ctx, fromAddr, toAddr, amt)
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins checks the send restrictions of every output before performing a multi-send.
+// As a multi-send has a single input, every output is checked against it.
+func (k SendRestrictedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, input := range inputs {
+		fromAddr, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+		for _, output := range outputs {
+			toAddr, err := sdk.AccAddressFromBech32(output.Address)
+			if err != nil {
+				return err
+			}
+			if err := k.tokenFactoryKeeper.CheckSendRestrictions(ctx, fromAddr, toAddr, output.Coins); err != nil {
+				return err
+			}
+			k.tokenFactoryKeeper.trackBeforeSend(ctx, fromAddr, toAddr, output.Coins)
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromAccountToModule checks the send restrictions before transferring coins from an
+// account to a module account, e.g. to an escrow
+func (k SendRestrictedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	// the module account is created before checking the send restrictions, as the bank keeper
+	// would before sending the coins, so that it is recognized as a module account
+	k.tokenFactoryKeeper.accountKeeper.GetModuleAccount(ctx, recipientModule)
+	recipientAddr := authtypes.NewModuleAddress(recipientModule)
+	if err := k.tokenFactoryKeeper.CheckSendRestrictions(ctx, senderAddr, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.tokenFactoryKeeper.trackBeforeSend(ctx, senderAddr, recipientAddr, amt)
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount checks the send restrictions before transferring coins from a
+// module account to an account, e.g. out of an escrow
+func (k SendRestrictedBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	senderAddr := authtypes.NewModuleAddress(senderModule)
+	if err := k.tokenFactoryKeeper.CheckSendRestrictions(ctx, senderAddr, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.tokenFactoryKeeper.trackBeforeSend(ctx, senderAddr, recipientAddr, amt)
+	return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
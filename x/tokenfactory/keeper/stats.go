@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetDenomStats returns the amounts of a specific denom ever minted, burned and force
+// transferred
+func (k Keeper) GetDenomStats(ctx sdk.Context, denom string) types.DenomStats {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomStatsKey))
+	if bz == nil {
+		return types.NewDenomStats()
+	}
+
+	stats := types.DenomStats{}
+	k.mustUnmarshal(bz, &stats)
+	return stats
+}
+
+// setDenomStats stores the amounts of a specific denom ever minted, burned and force transferred
+func (k Keeper) setDenomStats(ctx sdk.Context, denom string, stats types.DenomStats) error {
+	err := stats.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&stats)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomStatsKey), bz)
+	return nil
+}
+
+// trackMinted adds a minted amount to the stats of its denom
+func (k Keeper) trackMinted(ctx sdk.Context, amount sdk.Coin) error {
+	stats := k.GetDenomStats(ctx, amount.Denom)
+	stats.Minted = stats.Minted.Add(amount.Amount)
+	return k.setDenomStats(ctx, amount.Denom, stats)
+}
+
+// trackBurned adds a burned amount to the stats of its denom
+func (k Keeper) trackBurned(ctx sdk.Context, amount sdk.Coin) error {
+	stats := k.GetDenomStats(ctx, amount.Denom)
+	stats.Burned = stats.Burned.Add(amount.Amount)
+	return k.setDenomStats(ctx, amount.Denom, stats)
+}
+
+// trackForceTransferred adds a force transferred amount to the stats of its denom
+func (k Keeper) trackForceTransferred(ctx sdk.Context, amount sdk.Coin) error {
+	stats := k.GetDenomStats(ctx, amount.Denom)
+	stats.ForceTransferred = stats.ForceTransferred.Add(amount.Amount)
+	return k.setDenomStats(ctx, amount.Denom, stats)
+}
+
+// GetModuleSummary returns the number of denoms created through the module that haven't been
+// retired, and the creation fees collected so far
+func (k Keeper) GetModuleSummary(ctx sdk.Context) types.ModuleSummary {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.ModuleSummaryKey))
+	if bz == nil {
+		return types.ModuleSummary{}
+	}
+
+	summary := types.ModuleSummary{}
+	k.mustUnmarshal(bz, &summary)
+	return summary
+}
+
+func (k Keeper) setModuleSummary(ctx sdk.Context, summary types.ModuleSummary) error {
+	err := summary.TotalFeesCollected.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&summary)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set([]byte(types.ModuleSummaryKey), bz)
+	return nil
+}
+
+// trackDenomCreated counts a denom created through the module
+func (k Keeper) trackDenomCreated(ctx sdk.Context) error {
+	summary := k.GetModuleSummary(ctx)
+	summary.TotalDenoms++
+	return k.setModuleSummary(ctx, summary)
+}
+
+// trackDenomRetired no longer counts a retired denom
+func (k Keeper) trackDenomRetired(ctx sdk.Context) error {
+	summary := k.GetModuleSummary(ctx)
+	if summary.TotalDenoms > 0 {
+		summary.TotalDenoms--
+	}
+	return k.setModuleSummary(ctx, summary)
+}
+
+// trackFeesCollected adds creation fees to the fees collected so far
+func (k Keeper) trackFeesCollected(ctx sdk.Context, fees sdk.Coins) error {
+	summary := k.GetModuleSummary(ctx)
+	summary.TotalFeesCollected = summary.TotalFeesCollected.Add(fees...)
+	return k.setModuleSummary(ctx, summary)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetMaxSupply returns the supply cap of a specific denom, or zero if its supply is not capped
+func (k Keeper) GetMaxSupply(ctx sdk.Context, denom string) math.Int {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMaxSupplyKey))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var maxSupply math.Int
+	if err := maxSupply.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return maxSupply
+}
+
+// setMaxSupply stores the supply cap of a specific denom. A zero cap removes it.
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply math.Int) error {
+	if maxSupply.IsNil() || maxSupply.IsNegative() {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply of %s: %s", denom, maxSupply)
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if maxSupply.IsZero() {
+		store.Delete([]byte(types.DenomMaxSupplyKey))
+		return nil
+	}
+
+	bz, err := maxSupply.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomMaxSupplyKey), bz)
+	return nil
+}
+
+// lowerMaxSupply caps the supply of a specific denom. An existing cap can only be lowered,
+// and the cap can never be set below the current supply.
+func (k Keeper) lowerMaxSupply(ctx sdk.Context, denom string, maxSupply math.Int) error {
+	if !maxSupply.IsPositive() {
+		return types.ErrInvalidMaxSupply.Wrap("max supply must be positive")
+	}
+
+	current := k.GetMaxSupply(ctx, denom)
+	if current.IsPositive() && maxSupply.GT(current) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply can only be lowered, current: %s, requested: %s", current, maxSupply)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if maxSupply.LT(supply) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply cannot be lower than the current supply, supply: %s, requested: %s", supply, maxSupply)
+	}
+
+	return k.setMaxSupply(ctx, denom, maxSupply)
+}
+
+// checkMaxSupply returns an error if minting the given amount would push the supply of its
+// denom above its cap
+func (k Keeper) checkMaxSupply(ctx sdk.Context, amount sdk.Coin) error {
+	maxSupply := k.GetMaxSupply(ctx, amount.Denom)
+	if !maxSupply.IsPositive() {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+	if supply.Add(amount.Amount).GT(maxSupply) {
+		return types.ErrMaxSupplyExceeded.Wrapf("max supply: %s, supply: %s, requested: %s", maxSupply, supply, amount.Amount)
+	}
+	return nil
+}
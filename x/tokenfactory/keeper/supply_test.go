@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestMaxSupply ensures the following properties of supply caps:
+// * A cap can be set at denom creation, or later by the admin only
+// * An existing cap can only be lowered, and never below the current supply
+// * Mints that would push the supply above the cap fail
+func (suite *KeeperTestSuite) TestMaxSupply() {
+	admin, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	suite.CreateDefaultDenom()
+
+	maxSupply := func(denom string) sdk.Int {
+		res, err := suite.queryClient.MaxSupply(suite.Ctx.Context(), &types.QueryMaxSupplyRequest{Denom: denom})
+		suite.Require().NoError(err)
+		return res.MaxSupply
+	}
+	mint := func(denom string, amount int64) error {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, amount)))
+		return err
+	}
+
+	// denoms are not capped by default
+	suite.Require().True(maxSupply(suite.defaultDenom).IsZero())
+	suite.Require().NoError(mint(suite.defaultDenom, 1_000))
+
+	// set a cap at creation
+	msg := types.NewMsgCreateDenom(admin, "capped")
+	msg.MaxSupply = sdk.NewInt(100)
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	capped := res.GetNewTokenDenom()
+	suite.Require().Equal(sdk.NewInt(100), maxSupply(capped))
+
+	suite.Require().NoError(mint(capped, 60))
+	suite.Require().ErrorIs(mint(capped, 41), types.ErrMaxSupplyExceeded)
+	suite.Require().Equal(int64(60), suite.App.BankKeeper.GetSupply(suite.Ctx, capped).Amount.Int64())
+	suite.Require().NoError(mint(capped, 40))
+	suite.Require().ErrorIs(mint(capped, 1), types.ErrMaxSupplyExceeded)
+
+	// burning frees up room under the cap
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(capped, 30)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(mint(capped, 10))
+
+	for _, tc := range []struct {
+		desc      string
+		sender    string
+		maxSupply int64
+		expErr    error
+	}{
+		{desc: "non-admin can't set the cap", sender: other, maxSupply: 90, expErr: types.ErrUnauthorized},
+		{desc: "cap can't be raised", sender: admin, maxSupply: 101, expErr: types.ErrInvalidMaxSupply},
+		{desc: "cap can't be lowered below the supply", sender: admin, maxSupply: 79, expErr: types.ErrInvalidMaxSupply},
+		{desc: "lower the cap", sender: admin, maxSupply: 90},
+		{desc: "lower the cap down to the supply", sender: admin, maxSupply: 80},
+		{desc: "cap can't be raised back", sender: admin, maxSupply: 90, expErr: types.ErrInvalidMaxSupply},
+	} {
+		suite.Run(tc.desc, func() {
+			_, err := suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(tc.sender, capped, sdk.NewInt(tc.maxSupply)))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt(tc.maxSupply), maxSupply(capped))
+			}
+		})
+	}
+	suite.Require().ErrorIs(mint(capped, 1), types.ErrMaxSupplyExceeded)
+
+	// an uncapped denom can be capped later on
+	_, err = suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(admin, suite.defaultDenom, sdk.NewInt(1_500)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(mint(suite.defaultDenom, 500))
+	suite.Require().ErrorIs(mint(suite.defaultDenom, 1), types.ErrMaxSupplyExceeded)
+}
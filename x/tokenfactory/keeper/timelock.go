@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// GetTimelock returns the timelock of a specific denom, with a zero delay if it has none
+func (k Keeper) GetTimelock(ctx sdk.Context, denom string) types.DenomTimelock {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomTimelockKey))
+	if bz == nil {
+		return types.NewDenomTimelock(0, sdk.ZeroInt())
+	}
+
+	timelock := types.DenomTimelock{}
+	k.mustUnmarshal(bz, &timelock)
+	return timelock
+}
+
+// setTimelock stores the timelock of a specific denom. A zero delay removes it.
+func (k Keeper) setTimelock(ctx sdk.Context, denom string, timelock types.DenomTimelock) error {
+	err := timelock.Validate()
+	if err != nil {
+		return types.ErrInvalidTimelock.Wrapf("timelock of %s: %s", denom, err)
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if !timelock.IsEnabled() {
+		store.Delete([]byte(types.DenomTimelockKey))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&timelock)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomTimelockKey), bz)
+	return nil
+}
+
+// GetNextPendingActionID returns the id given to the next timelocked action
+func (k Keeper) GetNextPendingActionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.NextPendingActionIDKey))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextPendingActionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.NextPendingActionIDKey), sdk.Uint64ToBigEndian(id))
+}
+
+// GetPendingAction returns a timelocked action of a specific denom
+func (k Keeper) GetPendingAction(ctx sdk.Context, denom string, id uint64) (types.PendingAction, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetPendingActionKey(id))
+	if bz == nil {
+		return types.PendingAction{}, false
+	}
+
+	action := types.PendingAction{}
+	k.mustUnmarshal(bz, &action)
+	return action, true
+}
+
+// GetAllPendingActions returns the timelocked actions of all the denoms, in execution order
+func (k Keeper) GetAllPendingActions(ctx sdk.Context) []types.PendingAction {
+	var actions []types.PendingAction
+	k.iterateTimelockQueue(ctx, func(action types.PendingAction) bool {
+		actions = append(actions, action)
+		return false
+	})
+	return actions
+}
+
+// GetPendingActionsPrefixStore returns the substore that contains the timelocked actions of a
+// specific denom
+func (k Keeper) GetPendingActionsPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetPendingActionsPrefix())
+}
+
+// setPendingAction stores a timelocked action, and indexes it in the timelock queue
+func (k Keeper) setPendingAction(ctx sdk.Context, action types.PendingAction) error {
+	err := action.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&action)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, action.Denom).Set(types.GetPendingActionKey(action.Id), bz)
+	k.getTimelockQueuePrefixStore(ctx).Set(types.GetTimelockQueueKey(action.ReadyTime, action.Id), []byte(action.Denom))
+	return nil
+}
+
+// deletePendingAction removes a timelocked action and its index in the timelock queue
+func (k Keeper) deletePendingAction(ctx sdk.Context, action types.PendingAction) {
+	k.GetDenomPrefixStore(ctx, action.Denom).Delete(types.GetPendingActionKey(action.Id))
+	k.getTimelockQueuePrefixStore(ctx).Delete(types.GetTimelockQueueKey(action.ReadyTime, action.Id))
+}
+
+// queuePendingAction delays a message by the timelock of its denom, and returns the id of the
+// pending action
+func (k Keeper) queuePendingAction(ctx sdk.Context, msg sdk.Msg, timelock types.DenomTimelock) (uint64, error) {
+	id := k.GetNextPendingActionID(ctx)
+	action, err := types.NewPendingAction(id, ctx.BlockTime().Add(timelock.Delay), msg)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.setPendingAction(ctx, action)
+	if err != nil {
+		return 0, err
+	}
+	k.setNextPendingActionID(ctx, id+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePendingActionQueued,
+			sdk.NewAttribute(types.AttributeDenom, action.Denom),
+			sdk.NewAttribute(types.AttributePendingActionID, fmt.Sprint(action.Id)),
+			sdk.NewAttribute(types.AttributeAction, sdk.MsgTypeURL(msg)),
+			sdk.NewAttribute(types.AttributeReadyTime, action.ReadyTime.String()),
+		),
+	})
+
+	return id, nil
+}
+
+// ExecutePendingActions executes the timelocked actions whose ready time has passed, up to
+// MaxPendingActionsPerBlock of them, leaving the rest queued for the following blocks. Each
+// action is executed as if its message was submitted at the current block, so it fails if the
+// sender is no longer authorized. A failed action, including one that panics, is dropped and its
+// changes are discarded.
+func (k Keeper) ExecutePendingActions(ctx sdk.Context) {
+	var ready []types.PendingAction
+	k.iterateTimelockQueue(ctx, func(action types.PendingAction) bool {
+		if action.ReadyTime.After(ctx.BlockTime()) {
+			return true
+		}
+		ready = append(ready, action)
+		return len(ready) >= types.MaxPendingActionsPerBlock
+	})
+
+	for _, action := range ready {
+		k.deletePendingAction(ctx, action)
+
+		cacheCtx, write := ctx.CacheContext()
+		err := k.executePendingActionRecovered(cacheCtx, action)
+		if err != nil {
+			k.Logger(ctx).Error("failed to execute pending action", "denom", action.Denom, "id", action.Id, "error", err)
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypePendingActionFailed,
+					sdk.NewAttribute(types.AttributeDenom, action.Denom),
+					sdk.NewAttribute(types.AttributePendingActionID, fmt.Sprint(action.Id)),
+					sdk.NewAttribute(types.AttributeError, err.Error()),
+				),
+			})
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePendingActionExecuted,
+				sdk.NewAttribute(types.AttributeDenom, action.Denom),
+				sdk.NewAttribute(types.AttributePendingActionID, fmt.Sprint(action.Id)),
+			),
+		})
+	}
+}
+
+// executePendingActionRecovered executes a timelocked action with at most PendingActionGasLimit
+// gas, returning an error instead of panicking, as a panic at the end of a block would halt the
+// chain
+func (k Keeper) executePendingActionRecovered(ctx sdk.Context, action types.PendingAction) (err error) {
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(types.PendingActionGasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.ErrOutOfGas.Wrapf("pending action out of gas in location: %s", outOfGas.Descriptor)
+				return
+			}
+			err = types.ErrPendingActionPanicked.Wrapf("%v", r)
+		}
+	}()
+	return k.executePendingAction(ctx, action)
+}
+
+// executePendingAction runs a timelocked message through the msg server, bypassing the timelock
+func (k Keeper) executePendingAction(ctx sdk.Context, action types.PendingAction) error {
+	server := msgServer{Keeper: k, timelockElapsed: true}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var err error
+	switch action := action.Action.(type) {
+	case *types.PendingAction_ChangeAdmin:
+		_, err = server.ChangeAdmin(goCtx, action.ChangeAdmin)
+	case *types.PendingAction_ProposeAdmin:
+		_, err = server.ProposeAdmin(goCtx, action.ProposeAdmin)
+	case *types.PendingAction_Mint:
+		_, err = server.Mint(goCtx, action.Mint)
+	case *types.PendingAction_ForceTransfer:
+		_, err = server.ForceTransfer(goCtx, action.ForceTransfer)
+	case *types.PendingAction_SetDenomMetadata:
+		_, err = server.SetDenomMetadata(goCtx, action.SetDenomMetadata)
+	case *types.PendingAction_SetTimelock:
+		_, err = server.SetTimelock(goCtx, action.SetTimelock)
+	case *types.PendingAction_BatchMint:
+		_, err = server.BatchMint(goCtx, action.BatchMint)
+	case *types.PendingAction_BatchForceTransfer:
+		_, err = server.BatchForceTransfer(goCtx, action.BatchForceTransfer)
+	case *types.PendingAction_GrantRole:
+		_, err = server.GrantRole(goCtx, action.GrantRole)
+	case *types.PendingAction_ConfigureMinter:
+		_, err = server.ConfigureMinter(goCtx, action.ConfigureMinter)
+	case *types.PendingAction_IncreaseMinterAllowance:
+		_, err = server.IncreaseMinterAllowance(goCtx, action.IncreaseMinterAllowance)
+	case *types.PendingAction_SetBeforeSendHook:
+		_, err = server.SetBeforeSendHook(goCtx, action.SetBeforeSendHook)
+	default:
+		err = fmt.Errorf("unknown pending action %T", action)
+	}
+	return err
+}
+
+// iterateTimelockQueue calls cb on the timelocked actions of all the denoms in execution order,
+// until it returns true
+func (k Keeper) iterateTimelockQueue(ctx sdk.Context, cb func(action types.PendingAction) (stop bool)) {
+	iterator := k.getTimelockQueuePrefixStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+		action, found := k.GetPendingAction(ctx, string(iterator.Value()), id)
+		if !found {
+			panic(fmt.Sprintf("pending action %d of %s is queued but not stored", id, iterator.Value()))
+		}
+		if cb(action) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getTimelockQueuePrefixStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTimelockQueuePrefix())
+}
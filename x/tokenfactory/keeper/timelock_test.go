@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// TestTimelock ensures the following properties of denom timelocks:
+// * Admin changes, force transfers, metadata changes and mints above the threshold are queued
+// while the other actions are executed right away
+// * Only authorized senders can queue actions, and only the admin can cancel them
+// * Queued actions are executed once their delay has elapsed, and dropped if they fail
+// * Changing the timelock is itself timelocked
+func (suite *KeeperTestSuite) TestTimelock() {
+	suite.CreateDefaultDenom()
+	admin, holder, newAdmin := suite.TestAccs[0].String(), suite.TestAccs[1].String(), suite.TestAccs[2].String()
+	denom := suite.defaultDenom
+	delay := 48 * time.Hour
+
+	mint := func(sender string, amount int64) error {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(sender, sdk.NewInt64Coin(denom, amount), holder))
+		return err
+	}
+	pendingActions := func() []types.PendingAction {
+		res, err := suite.queryClient.PendingActions(suite.Ctx.Context(), &types.QueryPendingActionsRequest{Denom: denom})
+		suite.Require().NoError(err)
+		return res.PendingActions
+	}
+	balance := func() int64 {
+		return suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], denom).Amount.Int64()
+	}
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       "BITCOIN",
+		Symbol:     "BTC",
+	}
+
+	// only the admin can set the timelock, which applies right away when there is none
+	_, err := suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(holder, denom, delay, sdk.NewInt(1000)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, delay, sdk.NewInt(1000)))
+	suite.Require().NoError(err)
+
+	timelock, err := suite.queryClient.Timelock(suite.Ctx.Context(), &types.QueryTimelockRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewDenomTimelock(delay, sdk.NewInt(1000)), timelock.Timelock)
+
+	// mints up to the threshold are not delayed
+	suite.Require().NoError(mint(admin, 1000))
+	suite.Require().Equal(int64(1000), balance())
+	suite.Require().Empty(pendingActions())
+
+	// unauthorized senders can't queue actions
+	suite.Require().ErrorIs(mint(holder, 5000), types.ErrUnauthorized)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(holder, sdk.NewInt64Coin(denom, 100), holder, admin))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().Empty(pendingActions())
+
+	// sensitive actions are queued instead of executed
+	startTime := suite.Ctx.BlockTime()
+	suite.Require().NoError(mint(admin, 5000))
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 100), holder, admin))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ProposeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgProposeAdmin(admin, denom, newAdmin))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(int64(1000), balance())
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetPendingAdmin(suite.Ctx, denom))
+	actions := pendingActions()
+	suite.Require().Len(actions, 4)
+	for _, action := range actions {
+		suite.Require().Equal(denom, action.Denom)
+		suite.Require().Equal(startTime.Add(delay), action.ReadyTime)
+	}
+	suite.Require().NotNil(actions[0].GetMint())
+	suite.Require().NotNil(actions[1].GetForceTransfer())
+	suite.Require().NotNil(actions[2].GetSetDenomMetadata())
+	suite.Require().NotNil(actions[3].GetProposeAdmin())
+
+	// only the admin can cancel a pending action
+	cancel := func(sender string, id uint64) error {
+		_, err := suite.msgServer.CancelPendingAction(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelPendingAction(sender, denom, id))
+		return err
+	}
+	suite.Require().ErrorIs(cancel(holder, actions[1].Id), types.ErrUnauthorized)
+	suite.Require().NoError(cancel(admin, actions[1].Id))
+	suite.Require().ErrorIs(cancel(admin, actions[1].Id), types.ErrPendingActionNotFound)
+	suite.Require().Len(pendingActions(), 3)
+
+	// nothing is executed before the delay has elapsed
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(delay - time.Second))
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Equal(int64(1000), balance())
+	suite.Require().Len(pendingActions(), 3)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(delay))
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Equal(int64(6000), balance())
+	suite.Require().Equal(newAdmin, suite.App.TokenFactoryKeeper.GetPendingAdmin(suite.Ctx, denom))
+	storedMetadata, _ := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, denom)
+	suite.Require().Equal("BTC", storedMetadata.Symbol)
+	suite.Require().Empty(pendingActions())
+
+	// an action failing at execution is dropped
+	suite.Require().NoError(mint(admin, 5000))
+	_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(admin, denom, types.CapabilityMintable))
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(delay))
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Equal(int64(6000), balance())
+	suite.Require().Empty(pendingActions())
+
+	// removing the timelock is delayed as well
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, 0, sdk.ZeroInt()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.TokenFactoryKeeper.GetTimelock(suite.Ctx, denom).IsEnabled())
+	suite.Require().Len(pendingActions(), 1)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(delay))
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().False(suite.App.TokenFactoryKeeper.GetTimelock(suite.Ctx, denom).IsEnabled())
+	suite.Require().Empty(pendingActions())
+}
+
+// TestTimelockPermissions ensures role grants, minter allowance increases and before send hook
+// changes are queued while a denom is timelocked, while revocations and allowance decreases are
+// executed right away
+func (suite *KeeperTestSuite) TestTimelockPermissions() {
+	suite.CreateDefaultDenom()
+	admin, minter := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	denom := suite.defaultDenom
+	delay := 48 * time.Hour
+
+	// a minter configured before the timelock can still have its allowance decreased
+	_, err := suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(admin, denom, admin, sdk.NewInt(1000), sdk.ZeroInt(), 0))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, delay, sdk.ZeroInt()))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, denom, types.RoleMinter, minter))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ConfigureMinter(sdk.WrapSDKContext(suite.Ctx), types.NewMsgConfigureMinter(admin, denom, minter, sdk.NewInt(1000), sdk.ZeroInt(), 0))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.IncreaseMinterAllowance(sdk.WrapSDKContext(suite.Ctx), types.NewMsgIncreaseMinterAllowance(admin, denom, admin, sdk.NewInt(500)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, ""))
+	suite.Require().NoError(err)
+
+	authorityMetadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().False(authorityMetadata.HasRole(types.RoleMinter, minter))
+	_, found := suite.App.TokenFactoryKeeper.GetMinterAllowance(suite.Ctx, denom, minter)
+	suite.Require().False(found)
+	allowance, _ := suite.App.TokenFactoryKeeper.GetMinterAllowance(suite.Ctx, denom, admin)
+	suite.Require().Equal(sdk.NewInt(1000), allowance.Allowance)
+
+	actions := suite.App.TokenFactoryKeeper.GetAllPendingActions(suite.Ctx)
+	suite.Require().Len(actions, 4)
+	suite.Require().NotNil(actions[0].GetGrantRole())
+	suite.Require().NotNil(actions[1].GetConfigureMinter())
+	suite.Require().NotNil(actions[2].GetIncreaseMinterAllowance())
+	suite.Require().NotNil(actions[3].GetSetBeforeSendHook())
+
+	// decreasing an allowance applies right away
+	_, err = suite.msgServer.DecreaseMinterAllowance(sdk.WrapSDKContext(suite.Ctx), types.NewMsgDecreaseMinterAllowance(admin, denom, admin, sdk.NewInt(400)))
+	suite.Require().NoError(err)
+	allowance, _ = suite.App.TokenFactoryKeeper.GetMinterAllowance(suite.Ctx, denom, admin)
+	suite.Require().Equal(sdk.NewInt(600), allowance.Allowance)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(delay))
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetAllPendingActions(suite.Ctx))
+
+	authorityMetadata, err = suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().True(authorityMetadata.HasRole(types.RoleMinter, minter))
+	allowance, found = suite.App.TokenFactoryKeeper.GetMinterAllowance(suite.Ctx, denom, minter)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000), allowance.Allowance)
+	allowance, _ = suite.App.TokenFactoryKeeper.GetMinterAllowance(suite.Ctx, denom, admin)
+	suite.Require().Equal(sdk.NewInt(1100), allowance.Allowance)
+
+	// revoking a role applies right away
+	_, err = suite.msgServer.RevokeRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRevokeRole(admin, denom, types.RoleMinter, minter))
+	suite.Require().NoError(err)
+	authorityMetadata, err = suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().False(authorityMetadata.HasRole(types.RoleMinter, minter))
+}
+
+// TestExecutePendingActionsLimit ensures at most MaxPendingActionsPerBlock actions are executed at
+// the end of a block, and the remaining ready actions are executed in the following blocks
+func (suite *KeeperTestSuite) TestExecutePendingActionsLimit() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	denom := suite.defaultDenom
+	delay := time.Hour
+
+	_, err := suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, delay, sdk.NewInt(1)))
+	suite.Require().NoError(err)
+	for i := 0; i < types.MaxPendingActionsPerBlock+10; i++ {
+		_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 2)))
+		suite.Require().NoError(err)
+	}
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(delay))
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Equal(int64(2*types.MaxPendingActionsPerBlock), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64())
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetAllPendingActions(suite.Ctx), 10)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Equal(int64(2*(types.MaxPendingActionsPerBlock+10)), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64())
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetAllPendingActions(suite.Ctx))
+}
+
+// TestExecutePendingActionsPanic ensures a timelocked action that panics when executed, such as a
+// mint overflowing the supply, is dropped instead of halting the chain
+func (suite *KeeperTestSuite) TestExecutePendingActionsPanic() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	denom := suite.defaultDenom
+	delay := time.Hour
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, delay, sdk.NewInt(1)))
+	suite.Require().NoError(err)
+
+	maxAmount := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewCoin(denom, maxAmount)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 5)))
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetAllPendingActions(suite.Ctx), 2)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(delay)).WithEventManager(sdk.NewEventManager())
+	suite.Require().NotPanics(func() {
+		suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	})
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetAllPendingActions(suite.Ctx))
+	suite.Require().Equal(int64(15), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64())
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypePendingActionFailed, 1)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypePendingActionExecuted, 1)
+}
+
+// TestExecutePendingActionsGasLimit ensures a timelocked action using more than
+// PendingActionGasLimit gas when executed is dropped, without preventing the other actions from
+// being executed
+func (suite *KeeperTestSuite) TestExecutePendingActionsGasLimit() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	denom := suite.defaultDenom
+	delay := time.Hour
+
+	_, err := suite.msgServer.SetTimelock(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetTimelock(admin, denom, delay, sdk.NewInt(1)))
+	suite.Require().NoError(err)
+
+	var entries []types.BatchEntry
+	for i := 0; i < 1000; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("addr%016d", i)))
+		entries = append(entries, types.NewBatchEntry(addr.String(), sdk.NewInt(2)))
+	}
+	_, err = suite.msgServer.BatchMint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBatchMint(admin, denom, entries))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 5)))
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(delay)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.ExecutePendingActions(suite.Ctx)
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetAllPendingActions(suite.Ctx))
+	suite.Require().Equal(int64(5), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount.Int64())
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypePendingActionFailed, 1)
+	suite.AssertEventEmitted(suite.Ctx, types.EventTypePendingActionExecuted, 1)
+}
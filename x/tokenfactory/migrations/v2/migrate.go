@@ -0,0 +1,36 @@
+package v2
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/exported"
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// ParamsKey is the key where the params are stored from version 2 on
+var ParamsKey = []byte("params")
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 1 to
+// version 2. Specifically, it takes the parameters that are currently stored and managed by
+// the x/params module and stores them directly into the x/tokenfactory module state. Only the
+// denom creation fee was managed by the x/params module, and the other params are set to their
+// default.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace) error {
+	currParams := types.DefaultParams()
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	err := currParams.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(ParamsKey, bz)
+	return nil
+}
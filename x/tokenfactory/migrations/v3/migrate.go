@@ -0,0 +1,35 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 2 to
+// version 3. Specifically, it indexes every denom that has an admin under the admin, as is
+// done on every admin change from version 3 on.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := prefix.NewStore(store, types.GetCreatorsPrefix()).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Value())
+
+		bz := prefix.NewStore(store, types.GetDenomPrefixStore(denom)).Get([]byte(types.DenomAuthorityMetadataKey))
+		metadata := types.DenomAuthorityMetadata{}
+		if err := proto.Unmarshal(bz, &metadata); err != nil {
+			return err
+		}
+		if metadata.Admin == "" {
+			continue
+		}
+
+		prefix.NewStore(store, types.GetAdminPrefix(metadata.Admin)).Set([]byte(denom), []byte(denom))
+	}
+	return nil
+}
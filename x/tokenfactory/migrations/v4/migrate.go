@@ -0,0 +1,31 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 3 to
+// version 4. Specifically, it counts the existing denoms in the module summary. The creation
+// fees collected before version 4 are unknown, and not counted.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	summary := types.ModuleSummary{}
+	iterator := prefix.NewStore(store, types.GetCreatorsPrefix()).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		summary.TotalDenoms++
+	}
+
+	bz, err := proto.Marshal(&summary)
+	if err != nil {
+		return err
+	}
+	store.Set([]byte(types.ModuleSummaryKey), bz)
+	return nil
+}
@@ -0,0 +1,28 @@
+package v5
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noria-net/token-factory/x/tokenfactory/types"
+)
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 4 to
+// version 5. Specifically, it enables the audit log of the denoms by setting its maximum
+// number of entries to the default. The audit logs start empty.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	params := types.Params{}
+	if err := proto.Unmarshal(store.Get([]byte(types.ParamsKey)), &params); err != nil {
+		return err
+	}
+	params.AuditLogMaxEntries = types.DefaultAuditLogMaxEntries
+	bz, err := proto.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set([]byte(types.ParamsKey), bz)
+	return nil
+}
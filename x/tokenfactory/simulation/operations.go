@@ -21,7 +21,7 @@ const (
 	OpWeightMsgCreateDenom      = "op_weight_msg_create_denom"
 	OpWeightMsgMint             = "op_weight_msg_mint"
 	OpWeightMsgBurn             = "op_weight_msg_burn"
-	OpWeightMsgChangeAdmin      = "op_weight_msg_change_admin"
+	OpWeightMsgProposeAdmin     = "op_weight_msg_propose_admin"
 	OpWeightMsgSetDenomMetadata = "op_weight_msg_set_denom_metadata"
 	OpWeightMsgForceTransfer    = "op_weight_msg_force_transfer"
 )
@@ -49,7 +49,7 @@ func WeightedOperations(
 		weightMsgCreateDenom      int
 		weightMsgMint             int
 		weightMsgBurn             int
-		weightMsgChangeAdmin      int
+		weightMsgProposeAdmin     int
 		weightMsgSetDenomMetadata int
 		weightMsgForceTransfer    int
 	)
@@ -69,9 +69,9 @@ func WeightedOperations(
 			weightMsgBurn = params.DefaultWeightMsgBurn
 		},
 	)
-	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgChangeAdmin, &weightMsgChangeAdmin, nil,
+	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgProposeAdmin, &weightMsgProposeAdmin, nil,
 		func(_ *rand.Rand) {
-			weightMsgChangeAdmin = params.DefaultWeightMsgChangeAdmin
+			weightMsgProposeAdmin = params.DefaultWeightMsgProposeAdmin
 		},
 	)
 	simstate.AppParams.GetOrGenerate(simstate.Cdc, OpWeightMsgSetDenomMetadata, &weightMsgSetDenomMetadata, nil,
@@ -113,8 +113,8 @@ func WeightedOperations(
 			),
 		),
 		simulation.NewWeightedOperation(
-			weightMsgChangeAdmin,
-			SimulateMsgChangeAdmin(
+			weightMsgProposeAdmin,
+			SimulateMsgProposeAdmin(
 				tfKeeper,
 				ak,
 				bk,
@@ -199,7 +199,7 @@ func SimulateMsgSetDenomMetadata(
 	}
 }
 
-func SimulateMsgChangeAdmin(
+func SimulateMsgProposeAdmin(
 	tfKeeper TokenfactoryKeeper,
 	ak types.AccountKeeper,
 	bk BankKeeper,
@@ -218,27 +218,27 @@ func SimulateMsgChangeAdmin(
 		// Get demon
 		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
 		if !hasDenom {
-			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryChangeAdmin{}.Type(), "sim account have no denom created"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryProposeAdmin{}.Type(), "sim account have no denom created"), nil, nil
 		}
 
 		// Get admin of the denom
 		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
 		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryChangeAdmin{}.Type(), "err authority metadata"), nil, err
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryProposeAdmin{}.Type(), "err authority metadata"), nil, err
 		}
 		curAdminAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authData.Admin))
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryChangeAdmin{}.Type(), "admin account not found"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryProposeAdmin{}.Type(), "admin account not found"), nil, nil
 		}
 
 		// Rand new admin account
 		newAdmin, _ := simtypes.RandomAcc(r, accs)
 		if newAdmin.Address.String() == curAdminAccount.Address.String() {
-			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryChangeAdmin{}.Type(), "new admin cannot be the same as current admin"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTokenFactoryProposeAdmin{}.Type(), "new admin cannot be the same as current admin"), nil, nil
 		}
 
 		// Create msg
-		msg := types.MsgTokenFactoryChangeAdmin{
+		msg := types.MsgTokenFactoryProposeAdmin{
 			Sender:   curAdminAccount.Address.String(),
 			Denom:    denom,
 			NewAdmin: newAdmin.Address.String(),
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (e AuditLogEntry) Validate() error {
+	if e.Action == "" {
+		return fmt.Errorf("audit log entry %d has no action", e.Sequence)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(e.Actor); err != nil {
+		return fmt.Errorf("invalid actor of audit log entry %d: %w", e.Sequence, err)
+	}
+
+	for _, counterparty := range e.Counterparties {
+		if _, err := sdk.AccAddressFromBech32(counterparty); err != nil {
+			return fmt.Errorf("invalid counterparty of audit log entry %d: %w", e.Sequence, err)
+		}
+	}
+
+	if e.Amount.IsNil() || e.Amount.IsNegative() {
+		return fmt.Errorf("invalid amount of audit log entry %d: %s", e.Sequence, e.Amount)
+	}
+
+	if e.Height < 0 {
+		return fmt.Errorf("invalid height of audit log entry %d: %d", e.Sequence, e.Height)
+	}
+
+	return nil
+}
@@ -0,0 +1,730 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/auditLog.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuditLogEntry records a privileged action performed on a denom. The audit
+// log of a denom only keeps its latest entries, up to the maximum set by the
+// params.
+type AuditLogEntry struct {
+	// position of the entry in the audit log of the denom, starting from 0
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty" yaml:"sequence"`
+	// type of the message that performed the action, e.g. tf_mint
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty" yaml:"action"`
+	// address that performed the action
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty" yaml:"actor"`
+	// addresses the action applied to: the recipients of a mint, the addresses
+	// burned from, the addresses force transferred from followed by the
+	// recipient, or the admin handed over to or from
+	Counterparties []string `protobuf:"bytes,4,rep,name=counterparties,proto3" json:"counterparties,omitempty" yaml:"counterparties"`
+	// amount minted, burned or force transferred, zero for the other actions
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	Height int64                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time   time.Time             `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// hash of the transaction, hex encoded. It is empty for the timelocked
+	// actions, which are executed at the end of a block.
+	TxHash string `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+}
+
+func (m *AuditLogEntry) Reset()         { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()    {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99713974f0ce4db1, []int{0}
+}
+func (m *AuditLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogEntry.Merge(m, src)
+}
+func (m *AuditLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogEntry proto.InternalMessageInfo
+
+func (m *AuditLogEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetCounterparties() []string {
+	if m != nil {
+		return m.Counterparties
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *AuditLogEntry) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AuditLogEntry)(nil), "osmosis.tokenfactory.v1beta1.AuditLogEntry")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/auditLog.proto", fileDescriptor_99713974f0ce4db1)
+}
+
+var fileDescriptor_99713974f0ce4db1 = []byte{
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x24, 0x75, 0xdb, 0x2d, 0x2d, 0x60, 0xa8, 0x70, 0x23, 0xe4, 0xb5, 0xf6, 0x80,
+	0x8c, 0xaa, 0xd8, 0x2a, 0x1c, 0x90, 0x2a, 0x2e, 0xb5, 0x84, 0xa0, 0x12, 0x17, 0xac, 0x9e, 0xb8,
+	0x54, 0x1b, 0x77, 0x6b, 0x5b, 0xad, 0xbd, 0xc1, 0x3b, 0x46, 0xc9, 0x5b, 0xf4, 0x11, 0x78, 0x08,
+	0x1e, 0xa2, 0xc7, 0x0a, 0x2e, 0x88, 0x83, 0x41, 0xc9, 0x85, 0xb3, 0x9f, 0x00, 0x79, 0x77, 0x53,
+	0xa5, 0xdc, 0xb2, 0xf3, 0x7f, 0xff, 0x64, 0x7e, 0xcf, 0xe0, 0x7d, 0x21, 0x0b, 0x21, 0x73, 0x19,
+	0x82, 0xb8, 0xe0, 0xe5, 0x39, 0x4b, 0x40, 0x54, 0xb3, 0xf0, 0xcb, 0xc1, 0x98, 0x03, 0x3b, 0x08,
+	0x59, 0x7d, 0x96, 0xc3, 0x07, 0x91, 0x06, 0x93, 0x4a, 0x80, 0xb0, 0x9f, 0x19, 0x38, 0x58, 0x85,
+	0x03, 0x03, 0x0f, 0x9f, 0xa4, 0x22, 0x15, 0x0a, 0x0c, 0xbb, 0x5f, 0xda, 0x33, 0x24, 0xa9, 0x10,
+	0xe9, 0x25, 0x0f, 0xd5, 0x6b, 0x5c, 0x9f, 0x87, 0x90, 0x17, 0x5c, 0x02, 0x2b, 0x26, 0x06, 0xd8,
+	0x4b, 0x54, 0xd7, 0x53, 0xed, 0xd4, 0x0f, 0x2d, 0xd1, 0x1f, 0x7d, 0xbc, 0x7d, 0x64, 0x46, 0x78,
+	0x5b, 0x42, 0x35, 0xb3, 0x43, 0xbc, 0x21, 0xf9, 0xe7, 0x9a, 0x97, 0x09, 0x77, 0x90, 0x87, 0xfc,
+	0x41, 0xf4, 0xb8, 0x6d, 0xc8, 0x83, 0x19, 0x2b, 0x2e, 0x0f, 0xe9, 0x52, 0xa1, 0xf1, 0x2d, 0x64,
+	0xbf, 0xc0, 0x16, 0x4b, 0x20, 0x17, 0xa5, 0x73, 0xcf, 0x43, 0xfe, 0x66, 0xf4, 0xa8, 0x6d, 0xc8,
+	0xb6, 0xc6, 0x75, 0x9d, 0xc6, 0x06, 0xb0, 0x9f, 0xe3, 0x35, 0x95, 0xc8, 0xe9, 0x2b, 0xf2, 0x61,
+	0xdb, 0x90, 0xfb, 0xb7, 0xa4, 0xa8, 0x68, 0xac, 0x65, 0xfb, 0x08, 0xef, 0x24, 0xa2, 0x2e, 0x81,
+	0x57, 0x13, 0x56, 0x41, 0xce, 0xa5, 0x33, 0xf0, 0xfa, 0xfe, 0x66, 0xb4, 0xd7, 0x36, 0x64, 0x57,
+	0x1b, 0xee, 0xea, 0x34, 0xfe, 0xcf, 0x60, 0x9f, 0x60, 0x8b, 0x15, 0x5d, 0xc9, 0x59, 0x53, 0xff,
+	0xf5, 0xe6, 0xba, 0x21, 0xbd, 0x5f, 0x0d, 0xd9, 0xd5, 0xf1, 0xe5, 0xd9, 0x45, 0x90, 0x8b, 0xb0,
+	0x60, 0x90, 0x05, 0xc7, 0x25, 0xac, 0x8c, 0xac, 0x4c, 0xf4, 0xfb, 0xb7, 0x11, 0x36, 0x1f, 0xea,
+	0xb8, 0x84, 0xd8, 0xf4, 0xea, 0xb2, 0x66, 0x3c, 0x4f, 0x33, 0x70, 0x2c, 0x0f, 0xf9, 0xfd, 0xd5,
+	0xac, 0xba, 0x4e, 0x63, 0x03, 0xd8, 0xef, 0xf0, 0xa0, 0xdb, 0x83, 0xb3, 0xee, 0x21, 0x7f, 0xeb,
+	0xe5, 0x30, 0xd0, 0x4b, 0x0a, 0x96, 0x4b, 0x0a, 0x4e, 0x96, 0x4b, 0x8a, 0x9e, 0x76, 0xa3, 0xb5,
+	0x0d, 0xd9, 0xd2, 0x8d, 0x3a, 0x17, 0xbd, 0xfa, 0x4d, 0x50, 0xac, 0x1a, 0xd8, 0xfb, 0x78, 0x1d,
+	0xa6, 0xa7, 0x19, 0x93, 0x99, 0xb3, 0xa1, 0xa2, 0xd8, 0x6d, 0x43, 0x76, 0x0c, 0xab, 0x05, 0x1a,
+	0x5b, 0x30, 0x7d, 0xcf, 0x64, 0x76, 0x38, 0xf8, 0xfb, 0x95, 0xa0, 0xe8, 0xe3, 0xf5, 0xdc, 0x45,
+	0x37, 0x73, 0x17, 0xfd, 0x99, 0xbb, 0xe8, 0x6a, 0xe1, 0xf6, 0x6e, 0x16, 0x6e, 0xef, 0xe7, 0xc2,
+	0xed, 0x7d, 0x7a, 0x9d, 0xe6, 0x90, 0xd5, 0xe3, 0x20, 0x11, 0x45, 0x58, 0x8a, 0x2a, 0x67, 0xa3,
+	0x92, 0x83, 0xbe, 0xcc, 0xd1, 0xf2, 0x34, 0xa7, 0x77, 0x2f, 0x15, 0x66, 0x13, 0x2e, 0xc7, 0x96,
+	0x1a, 0xfc, 0xd5, 0xbf, 0x01, 0x00, 0x52, 0x49, 0x46, 0xd5, 0xce, 0x02, 0x00, 0x00,
+}
+
+func (this *AuditLogEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuditLogEntry)
+	if !ok {
+		that2, ok := that.(AuditLogEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.Actor != that1.Actor {
+		return false
+	}
+	if len(this.Counterparties) != len(that1.Counterparties) {
+		return false
+	}
+	for i := range this.Counterparties {
+		if this.Counterparties[i] != that1.Counterparties[i] {
+			return false
+		}
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	return true
+}
+func (m *AuditLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuditLog(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintAuditLog(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuditLog(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Counterparties) > 0 {
+		for iNdEx := len(m.Counterparties) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Counterparties[iNdEx])
+			copy(dAtA[i:], m.Counterparties[iNdEx])
+			i = encodeVarintAuditLog(dAtA, i, uint64(len(m.Counterparties[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAuditLog(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintAuditLog(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuditLog(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuditLog(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovAuditLog(uint64(m.Sequence))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	if len(m.Counterparties) > 0 {
+		for _, s := range m.Counterparties {
+			l = len(s)
+			n += 1 + l + sovAuditLog(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuditLog(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAuditLog(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuditLog(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAuditLog(uint64(l))
+	}
+	return n
+}
+
+func sovAuditLog(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuditLog(x uint64) (n int) {
+	return sovAuditLog(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuditLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditLog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparties = append(m.Counterparties, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditLog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditLog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuditLog(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuditLog
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuditLog
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuditLog
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuditLog
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuditLog        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuditLog          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuditLog = fmt.Errorf("proto: unexpected end of group")
+)
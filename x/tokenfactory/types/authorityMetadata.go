@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +14,126 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	for _, role := range DelegableRoles() {
+		seen := map[string]bool{}
+		for _, member := range metadata.GetRoleMembers(role) {
+			if _, err := sdk.AccAddressFromBech32(member); err != nil {
+				return err
+			}
+			if seen[member] {
+				return fmt.Errorf("duplicate %s address: %s", role.ShortName(), member)
+			}
+			seen[member] = true
+		}
+	}
 	return nil
 }
+
+// HasRole returns true if the address is the admin of the denom, or has been
+// granted the given role.
+func (metadata DenomAuthorityMetadata) HasRole(role DenomRole, address string) bool {
+	if address == "" {
+		return false
+	}
+	if address == metadata.Admin {
+		return true
+	}
+	for _, member := range metadata.GetRoleMembers(role) {
+		if member == address {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRoleMembers returns the addresses that have been granted the given role.
+// The admin is not included.
+func (metadata DenomAuthorityMetadata) GetRoleMembers(role DenomRole) []string {
+	switch role {
+	case RoleMinter:
+		return metadata.Minters
+	case RoleBurner:
+		return metadata.Burners
+	case RoleForceTransferrer:
+		return metadata.ForceTransferrers
+	case RoleMetadataManager:
+		return metadata.MetadataManagers
+	case RolePauser:
+		return metadata.Pausers
+	case RoleFreezer:
+		return metadata.Freezers
+	case RoleComplianceManager:
+		return metadata.ComplianceManagers
+	default:
+		return nil
+	}
+}
+
+// SetRoleMembers replaces the addresses that have been granted the given role.
+func (metadata *DenomAuthorityMetadata) SetRoleMembers(role DenomRole, members []string) {
+	switch role {
+	case RoleMinter:
+		metadata.Minters = members
+	case RoleBurner:
+		metadata.Burners = members
+	case RoleForceTransferrer:
+		metadata.ForceTransferrers = members
+	case RoleMetadataManager:
+		metadata.MetadataManagers = members
+	case RolePauser:
+		metadata.Pausers = members
+	case RoleFreezer:
+		metadata.Freezers = members
+	case RoleComplianceManager:
+		metadata.ComplianceManagers = members
+	}
+}
+
+// DelegableRoles returns every role that the admin can grant to other addresses.
+func DelegableRoles() []DenomRole {
+	return []DenomRole{RoleMinter, RoleBurner, RoleForceTransferrer, RoleMetadataManager, RolePauser, RoleFreezer, RoleComplianceManager}
+}
+
+// ShortName returns the role name as used by the CLI, e.g. "force-transferrer".
+func (role DenomRole) ShortName() string {
+	name := strings.TrimPrefix(role.String(), "DENOM_ROLE_")
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
+
+// ParseDenomRole parses either the short name of a role ("minter") or its
+// full enum name ("DENOM_ROLE_MINTER").
+func ParseDenomRole(name string) (DenomRole, error) {
+	for _, role := range DelegableRoles() {
+		if name == role.ShortName() || name == role.String() {
+			return role, nil
+		}
+	}
+	return RoleUnspecified, fmt.Errorf("unknown denom role: %s", name)
+}
+
+// ValidateDelegable returns an error if the role cannot be granted or revoked.
+func (role DenomRole) ValidateDelegable() error {
+	for _, delegable := range DelegableRoles() {
+		if role == delegable {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid denom role: %s", role)
+}
+
+// MatchesAdminFilter returns true if the admin of the denom is the given admin, when it is
+// set, and is or isn't set as required by the admin filter.
+func (metadata DenomAuthorityMetadata) MatchesAdminFilter(admin string, filter AdminFilter) bool {
+	if admin != "" && metadata.Admin != admin {
+		return false
+	}
+	switch filter {
+	case AdminFilterWithAdmin:
+		return metadata.Admin != ""
+	case AdminFilterWithoutAdmin:
+		return metadata.Admin == ""
+	default:
+		return true
+	}
+}
@@ -24,12 +24,112 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomRole enumerates the roles that the admin of a denom can grant to other
+// addresses.
+type DenomRole int32
+
+const (
+	RoleUnspecified       DenomRole = 0
+	RoleMinter            DenomRole = 1
+	RoleBurner            DenomRole = 2
+	RoleForceTransferrer  DenomRole = 3
+	RoleMetadataManager   DenomRole = 4
+	RolePauser            DenomRole = 5
+	RoleFreezer           DenomRole = 6
+	RoleComplianceManager DenomRole = 7
+)
+
+var DenomRole_name = map[int32]string{
+	0: "DENOM_ROLE_UNSPECIFIED",
+	1: "DENOM_ROLE_MINTER",
+	2: "DENOM_ROLE_BURNER",
+	3: "DENOM_ROLE_FORCE_TRANSFERRER",
+	4: "DENOM_ROLE_METADATA_MANAGER",
+	5: "DENOM_ROLE_PAUSER",
+	6: "DENOM_ROLE_FREEZER",
+	7: "DENOM_ROLE_COMPLIANCE_MANAGER",
+}
+
+var DenomRole_value = map[string]int32{
+	"DENOM_ROLE_UNSPECIFIED":        0,
+	"DENOM_ROLE_MINTER":             1,
+	"DENOM_ROLE_BURNER":             2,
+	"DENOM_ROLE_FORCE_TRANSFERRER":  3,
+	"DENOM_ROLE_METADATA_MANAGER":   4,
+	"DENOM_ROLE_PAUSER":             5,
+	"DENOM_ROLE_FREEZER":            6,
+	"DENOM_ROLE_COMPLIANCE_MANAGER": 7,
+}
+
+func (x DenomRole) String() string {
+	return proto.EnumName(DenomRole_name, int32(x))
+}
+
+func (DenomRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{0}
+}
+
+// DenomCapability enumerates the powers over a denom that its admin and role
+// holders may exercise. Every capability is held at creation unless renounced,
+// and a renounced capability can never be held again.
+type DenomCapability int32
+
+const (
+	CapabilityUnspecified DenomCapability = 0
+	// Minting new tokens, by minters or delegated minters
+	CapabilityMintable DenomCapability = 1
+	// Burning tokens from accounts other than the burner's own
+	CapabilityBurnableFromOthers DenomCapability = 2
+	// Force transferring tokens between accounts
+	CapabilityForceTransferable DenomCapability = 3
+	// Changing the bank metadata of the denom
+	CapabilityMetadataMutable DenomCapability = 4
+)
+
+var DenomCapability_name = map[int32]string{
+	0: "DENOM_CAPABILITY_UNSPECIFIED",
+	1: "DENOM_CAPABILITY_MINTABLE",
+	2: "DENOM_CAPABILITY_BURNABLE_FROM_OTHERS",
+	3: "DENOM_CAPABILITY_FORCE_TRANSFERABLE",
+	4: "DENOM_CAPABILITY_METADATA_MUTABLE",
+}
+
+var DenomCapability_value = map[string]int32{
+	"DENOM_CAPABILITY_UNSPECIFIED":          0,
+	"DENOM_CAPABILITY_MINTABLE":             1,
+	"DENOM_CAPABILITY_BURNABLE_FROM_OTHERS": 2,
+	"DENOM_CAPABILITY_FORCE_TRANSFERABLE":   3,
+	"DENOM_CAPABILITY_METADATA_MUTABLE":     4,
+}
+
+func (x DenomCapability) String() string {
+	return proto.EnumName(DenomCapability_name, int32(x))
+}
+
+func (DenomCapability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{1}
+}
+
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin implicitly holds every
+// role, and can delegate individual roles to other addresses.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Addresses allowed to mint the denom
+	Minters []string `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters,omitempty" yaml:"minters"`
+	// Addresses allowed to burn the denom from any account
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty" yaml:"burners"`
+	// Addresses allowed to force transfer the denom between accounts
+	ForceTransferrers []string `protobuf:"bytes,4,rep,name=force_transferrers,json=forceTransferrers,proto3" json:"force_transferrers,omitempty" yaml:"force_transferrers"`
+	// Addresses allowed to set the bank metadata of the denom
+	MetadataManagers []string `protobuf:"bytes,5,rep,name=metadata_managers,json=metadataManagers,proto3" json:"metadata_managers,omitempty" yaml:"metadata_managers"`
+	// Addresses allowed to pause and unpause transfers of the denom
+	Pausers []string `protobuf:"bytes,6,rep,name=pausers,proto3" json:"pausers,omitempty" yaml:"pausers"`
+	// Addresses allowed to freeze and unfreeze accounts holding the denom
+	Freezers []string `protobuf:"bytes,7,rep,name=freezers,proto3" json:"freezers,omitempty" yaml:"freezers"`
+	// Addresses allowed to manage the allowlist of the denom
+	ComplianceManagers []string `protobuf:"bytes,8,rep,name=compliance_managers,json=complianceManagers,proto3" json:"compliance_managers,omitempty" yaml:"compliance_managers"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +172,129 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetBurners() []string {
+	if m != nil {
+		return m.Burners
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferrers() []string {
+	if m != nil {
+		return m.ForceTransferrers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataManagers() []string {
+	if m != nil {
+		return m.MetadataManagers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetPausers() []string {
+	if m != nil {
+		return m.Pausers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetFreezers() []string {
+	if m != nil {
+		return m.Freezers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetComplianceManagers() []string {
+	if m != nil {
+		return m.ComplianceManagers
+	}
+	return nil
+}
+
+// DenomCapabilities lists which capabilities are still held over a denom.
+type DenomCapabilities struct {
+	Mintable           bool `protobuf:"varint,1,opt,name=mintable,proto3" json:"mintable,omitempty" yaml:"mintable"`
+	BurnableFromOthers bool `protobuf:"varint,2,opt,name=burnable_from_others,json=burnableFromOthers,proto3" json:"burnable_from_others,omitempty" yaml:"burnable_from_others"`
+	ForceTransferable  bool `protobuf:"varint,3,opt,name=force_transferable,json=forceTransferable,proto3" json:"force_transferable,omitempty" yaml:"force_transferable"`
+	MetadataMutable    bool `protobuf:"varint,4,opt,name=metadata_mutable,json=metadataMutable,proto3" json:"metadata_mutable,omitempty" yaml:"metadata_mutable"`
+}
+
+func (m *DenomCapabilities) Reset()         { *m = DenomCapabilities{} }
+func (m *DenomCapabilities) String() string { return proto.CompactTextString(m) }
+func (*DenomCapabilities) ProtoMessage()    {}
+func (*DenomCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{1}
+}
+func (m *DenomCapabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCapabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCapabilities.Merge(m, src)
+}
+func (m *DenomCapabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCapabilities proto.InternalMessageInfo
+
+func (m *DenomCapabilities) GetMintable() bool {
+	if m != nil {
+		return m.Mintable
+	}
+	return false
+}
+
+func (m *DenomCapabilities) GetBurnableFromOthers() bool {
+	if m != nil {
+		return m.BurnableFromOthers
+	}
+	return false
+}
+
+func (m *DenomCapabilities) GetForceTransferable() bool {
+	if m != nil {
+		return m.ForceTransferable
+	}
+	return false
+}
+
+func (m *DenomCapabilities) GetMetadataMutable() bool {
+	if m != nil {
+		return m.MetadataMutable
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.DenomRole", DenomRole_name, DenomRole_value)
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.DenomCapability", DenomCapability_name, DenomCapability_value)
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomCapabilities)(nil), "osmosis.tokenfactory.v1beta1.DenomCapabilities")
 }
 
 func init() {
@@ -81,22 +302,63 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0xc9, 0x25, 0x83, 0x35, 0xe9, 0x27, 0x25, 0x16,
-	0xa7, 0xc2, 0x2d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6,
-	0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x53, 0xe0, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xe7, 0xe5, 0x17, 0x65, 0x26, 0xea, 0xe6, 0xa5, 0x96, 0x40, 0x3c,
-	0xae, 0x0b, 0xf3, 0x79, 0x05, 0x6a, 0x40, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d,
-	0x68, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x71, 0xdc, 0x7f, 0xc3, 0x2d, 0x01, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x6e, 0x3e, 0xfa, 0x35, 0xa0, 0x8d, 0x3b, 0x2d, 0xdd, 0xd6, 0x6d, 0x63, 0x63, 0xb4, 0xb0,
+	0x5a, 0xb1, 0x8d, 0x56, 0x80, 0x40, 0x85, 0x8b, 0x93, 0x4c, 0xd8, 0x88, 0x7c, 0xed, 0x34, 0x39,
+	0xb0, 0x17, 0x6b, 0x92, 0x4e, 0x5a, 0x8b, 0xd8, 0x13, 0xd9, 0x0e, 0x22, 0xfc, 0x02, 0xe4, 0x13,
+	0x07, 0xae, 0x96, 0x90, 0xf8, 0x09, 0xfc, 0x09, 0x8e, 0x7b, 0xe4, 0x14, 0xa1, 0xf6, 0xb2, 0x12,
+	0xb7, 0xfc, 0x02, 0x34, 0x63, 0x3b, 0x76, 0x9c, 0xe5, 0x66, 0xbd, 0xef, 0xf3, 0x3c, 0x7e, 0xde,
+	0x79, 0xe6, 0xd5, 0x80, 0xcf, 0x99, 0x6b, 0x31, 0xd7, 0x74, 0x2b, 0x1e, 0xfb, 0x81, 0xda, 0x63,
+	0x32, 0xf2, 0x98, 0x33, 0xaf, 0xfc, 0xf8, 0x62, 0x48, 0x3d, 0xf2, 0xa2, 0x42, 0x66, 0xde, 0x1d,
+	0x73, 0x4c, 0x6f, 0xde, 0xa6, 0x1e, 0xb9, 0x21, 0x1e, 0xb9, 0x9c, 0x3a, 0xcc, 0x63, 0xf0, 0x3c,
+	0x62, 0x5d, 0xa6, 0x59, 0x97, 0x11, 0x4b, 0x3e, 0xba, 0x65, 0xb7, 0x4c, 0x00, 0x2b, 0xfc, 0x2b,
+	0xe4, 0xc8, 0xe5, 0x91, 0x20, 0x55, 0x86, 0xc4, 0xa5, 0xab, 0x1f, 0x8c, 0x98, 0x69, 0x87, 0x7d,
+	0xed, 0xdf, 0x02, 0x38, 0xae, 0x53, 0x9b, 0x59, 0x7a, 0xf6, 0xa7, 0xf0, 0x63, 0xb0, 0x4d, 0x6e,
+	0x2c, 0xd3, 0x3e, 0xc9, 0xa9, 0xb9, 0xa7, 0xfb, 0x55, 0x69, 0xb9, 0x50, 0xde, 0x9f, 0x13, 0x6b,
+	0x72, 0xa5, 0x89, 0xb2, 0x86, 0xc3, 0x36, 0xfc, 0x14, 0xec, 0x5a, 0xa6, 0xed, 0x51, 0xc7, 0x3d,
+	0xc9, 0xab, 0x85, 0xa7, 0xfb, 0x55, 0xb8, 0x5c, 0x28, 0x8f, 0x42, 0x64, 0xd4, 0xd0, 0x70, 0x0c,
+	0xe1, 0xe8, 0xe1, 0xcc, 0xb1, 0x39, 0xba, 0x90, 0x45, 0x47, 0x0d, 0x0d, 0xc7, 0x10, 0xd8, 0x02,
+	0x70, 0xcc, 0x9c, 0x11, 0x35, 0x3c, 0x87, 0xd8, 0xee, 0x98, 0x3a, 0x0e, 0x27, 0x16, 0x05, 0xf1,
+	0x62, 0xb9, 0x50, 0x4e, 0x43, 0xe2, 0x26, 0x46, 0xc3, 0x07, 0xa2, 0xd8, 0x4f, 0xd5, 0x60, 0x13,
+	0x1c, 0x58, 0xd1, 0x74, 0x86, 0x45, 0x6c, 0x72, 0xcb, 0xc5, 0xb6, 0x85, 0xd8, 0xf9, 0x72, 0xa1,
+	0x9c, 0x44, 0x9e, 0xb3, 0x10, 0x0d, 0x4b, 0x71, 0xad, 0x1d, 0x95, 0xf8, 0x18, 0x53, 0x32, 0x73,
+	0xb9, 0xc0, 0x4e, 0x76, 0x8c, 0xa8, 0xa1, 0xe1, 0x18, 0x02, 0x2b, 0x60, 0x6f, 0xec, 0x50, 0xfa,
+	0x33, 0x87, 0xef, 0x0a, 0xf8, 0xe1, 0x72, 0xa1, 0x94, 0x22, 0xf3, 0x51, 0x47, 0xc3, 0x2b, 0x10,
+	0xec, 0x82, 0xc3, 0x11, 0xb3, 0xa6, 0x13, 0x93, 0xd8, 0x23, 0x9a, 0x78, 0xdd, 0x13, 0xdc, 0xf2,
+	0x72, 0xa1, 0xc8, 0x21, 0xf7, 0x1d, 0x20, 0x0d, 0xc3, 0xa4, 0x1a, 0xfb, 0xbd, 0x2a, 0xbe, 0xfd,
+	0x5d, 0xc9, 0x69, 0x7f, 0xe6, 0xc1, 0x81, 0x48, 0xbb, 0x46, 0xa6, 0x64, 0x68, 0x4e, 0x4c, 0xcf,
+	0xa4, 0xc2, 0x1d, 0x4f, 0x87, 0x0c, 0x27, 0x54, 0x64, 0xbd, 0x97, 0x76, 0x17, 0x77, 0x34, 0xbc,
+	0x02, 0xc1, 0x57, 0xe0, 0x88, 0x07, 0xc4, 0xbf, 0x8d, 0xb1, 0xc3, 0x2c, 0x83, 0x79, 0x77, 0x61,
+	0xfc, 0x9c, 0xac, 0x2c, 0x17, 0xca, 0x59, 0x12, 0x68, 0x16, 0xa5, 0x61, 0x18, 0x97, 0x1b, 0x0e,
+	0xb3, 0xba, 0xde, 0xdd, 0x3b, 0x83, 0x16, 0x6e, 0x0a, 0x42, 0xf0, 0x7f, 0x83, 0x0e, 0x7d, 0xad,
+	0x07, 0x2d, 0x0c, 0x36, 0x80, 0x94, 0xa4, 0x38, 0x0b, 0x27, 0x2b, 0x0a, 0xad, 0xb3, 0xe5, 0x42,
+	0x79, 0x9c, 0xcd, 0x79, 0x16, 0x4d, 0x58, 0x5a, 0xc5, 0x1c, 0x56, 0xc2, 0x53, 0x7b, 0xf6, 0x5b,
+	0x01, 0xec, 0x8b, 0x53, 0xc3, 0x6c, 0x42, 0x61, 0x05, 0x1c, 0xd7, 0x51, 0xa7, 0xdb, 0x36, 0x70,
+	0xb7, 0x85, 0x8c, 0x41, 0xe7, 0xba, 0x87, 0x6a, 0xcd, 0x46, 0x13, 0xd5, 0xa5, 0x2d, 0xf9, 0xd0,
+	0x0f, 0xd4, 0x12, 0x47, 0x0d, 0x6c, 0x77, 0x4a, 0x47, 0xe6, 0xd8, 0xa4, 0x37, 0xf0, 0x09, 0x38,
+	0x48, 0x11, 0xda, 0xcd, 0x4e, 0x1f, 0x61, 0x29, 0x27, 0x3f, 0xf2, 0x03, 0x15, 0x70, 0x6c, 0x5b,
+	0x6c, 0x46, 0x06, 0x56, 0x1d, 0xe0, 0x0e, 0xc2, 0x52, 0x3e, 0x81, 0x55, 0xc5, 0x4a, 0xc0, 0x2b,
+	0x70, 0x9e, 0x82, 0x35, 0xba, 0xb8, 0x86, 0x8c, 0x3e, 0xd6, 0x3b, 0xd7, 0x0d, 0x84, 0x31, 0xc2,
+	0x52, 0x41, 0x3e, 0xf1, 0x03, 0xf5, 0x88, 0x33, 0x1a, 0x99, 0x05, 0x80, 0x5f, 0x81, 0xb3, 0xb4,
+	0x13, 0xd4, 0xd7, 0xeb, 0x7a, 0x5f, 0x37, 0xda, 0x7a, 0x47, 0xff, 0x16, 0x61, 0xa9, 0x28, 0x3f,
+	0xf6, 0x03, 0xf5, 0x50, 0x78, 0x5a, 0xbf, 0xef, 0x19, 0x73, 0x3d, 0x7d, 0x70, 0x8d, 0xb0, 0xb4,
+	0x9d, 0x98, 0xeb, 0x89, 0x8b, 0x0e, 0x3f, 0x01, 0x30, 0x6d, 0x0e, 0x23, 0xf4, 0x1a, 0x61, 0x69,
+	0x47, 0x2e, 0xf9, 0x81, 0xfa, 0x9e, 0xb0, 0x14, 0x5e, 0x70, 0xf8, 0x0d, 0xb8, 0x48, 0x01, 0x6b,
+	0xdd, 0x76, 0xaf, 0xd5, 0xd4, 0x3b, 0x35, 0xb4, 0xf2, 0xb2, 0x2b, 0x9f, 0xfa, 0x81, 0xfa, 0x01,
+	0xe7, 0xd4, 0xb2, 0xb7, 0x59, 0x2e, 0xfe, 0xf2, 0x47, 0x79, 0xeb, 0xd9, 0xdb, 0x3c, 0x28, 0xad,
+	0x5f, 0xe6, 0x39, 0xfc, 0x3a, 0x3e, 0x9d, 0x9a, 0xde, 0xd3, 0xab, 0xcd, 0x56, 0xb3, 0xff, 0x7d,
+	0x26, 0x22, 0x21, 0x9b, 0x30, 0xd2, 0x41, 0x7d, 0x01, 0x4e, 0x37, 0xc8, 0x3c, 0x2e, 0xbd, 0xda,
+	0x42, 0x52, 0x4e, 0x3e, 0xf6, 0x03, 0x15, 0x26, 0xcc, 0x76, 0xbc, 0x0d, 0xdf, 0x81, 0x27, 0x1b,
+	0x34, 0x1e, 0x1f, 0xa7, 0x19, 0x0d, 0xdc, 0x6d, 0x1b, 0xdd, 0xfe, 0x4b, 0x84, 0xaf, 0xa5, 0xbc,
+	0xac, 0xfa, 0x81, 0x7a, 0x9e, 0x48, 0x54, 0x37, 0xf7, 0xe0, 0x25, 0xf8, 0x68, 0x43, 0x6c, 0x3d,
+	0x64, 0xe1, 0xa6, 0x20, 0x2b, 0x7e, 0xa0, 0x9e, 0x25, 0x52, 0x8d, 0x8d, 0x1d, 0xa8, 0x83, 0x0f,
+	0x37, 0xa7, 0x59, 0x45, 0x3e, 0x08, 0xa7, 0x2a, 0xca, 0x17, 0x7e, 0xa0, 0x9e, 0xa6, 0xa6, 0x5a,
+	0xdf, 0x80, 0xf0, 0xa8, 0xab, 0xaf, 0xfe, 0xba, 0x2f, 0xe7, 0xde, 0xdc, 0x97, 0x73, 0xff, 0xdc,
+	0x97, 0x73, 0xbf, 0x3e, 0x94, 0xb7, 0xde, 0x3c, 0x94, 0xb7, 0xfe, 0x7e, 0x28, 0x6f, 0xbd, 0xfe,
+	0xf2, 0xd6, 0xf4, 0xee, 0x66, 0xc3, 0xcb, 0x11, 0xb3, 0x2a, 0x36, 0x73, 0x4c, 0xf2, 0xdc, 0xa6,
+	0x5e, 0xf8, 0xac, 0x3d, 0x8f, 0xdf, 0xb5, 0x9f, 0xd6, 0x9f, 0x39, 0x6f, 0x3e, 0xa5, 0xee, 0x70,
+	0x47, 0xbc, 0x3f, 0x9f, 0xfd, 0x37, 0x00, 0xaf, 0xaa, 0x96, 0x69, 0x0b, 0x07, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +383,95 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if this.Minters[i] != that1.Minters[i] {
+			return false
+		}
+	}
+	if len(this.Burners) != len(that1.Burners) {
+		return false
+	}
+	for i := range this.Burners {
+		if this.Burners[i] != that1.Burners[i] {
+			return false
+		}
+	}
+	if len(this.ForceTransferrers) != len(that1.ForceTransferrers) {
+		return false
+	}
+	for i := range this.ForceTransferrers {
+		if this.ForceTransferrers[i] != that1.ForceTransferrers[i] {
+			return false
+		}
+	}
+	if len(this.MetadataManagers) != len(that1.MetadataManagers) {
+		return false
+	}
+	for i := range this.MetadataManagers {
+		if this.MetadataManagers[i] != that1.MetadataManagers[i] {
+			return false
+		}
+	}
+	if len(this.Pausers) != len(that1.Pausers) {
+		return false
+	}
+	for i := range this.Pausers {
+		if this.Pausers[i] != that1.Pausers[i] {
+			return false
+		}
+	}
+	if len(this.Freezers) != len(that1.Freezers) {
+		return false
+	}
+	for i := range this.Freezers {
+		if this.Freezers[i] != that1.Freezers[i] {
+			return false
+		}
+	}
+	if len(this.ComplianceManagers) != len(that1.ComplianceManagers) {
+		return false
+	}
+	for i := range this.ComplianceManagers {
+		if this.ComplianceManagers[i] != that1.ComplianceManagers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomCapabilities) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomCapabilities)
+	if !ok {
+		that2, ok := that.(DenomCapabilities)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mintable != that1.Mintable {
+		return false
+	}
+	if this.BurnableFromOthers != that1.BurnableFromOthers {
+		return false
+	}
+	if this.ForceTransferable != that1.ForceTransferable {
+		return false
+	}
+	if this.MetadataMutable != that1.MetadataMutable {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +494,69 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ComplianceManagers) > 0 {
+		for iNdEx := len(m.ComplianceManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ComplianceManagers[iNdEx])
+			copy(dAtA[i:], m.ComplianceManagers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ComplianceManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Freezers) > 0 {
+		for iNdEx := len(m.Freezers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Freezers[iNdEx])
+			copy(dAtA[i:], m.Freezers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Freezers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pausers[iNdEx])
+			copy(dAtA[i:], m.Pausers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Pausers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for iNdEx := len(m.MetadataManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataManagers[iNdEx])
+			copy(dAtA[i:], m.MetadataManagers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ForceTransferrers) > 0 {
+		for iNdEx := len(m.ForceTransferrers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceTransferrers[iNdEx])
+			copy(dAtA[i:], m.ForceTransferrers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ForceTransferrers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -153,6 +567,69 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MetadataMutable {
+		i--
+		if m.MetadataMutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ForceTransferable {
+		i--
+		if m.ForceTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BurnableFromOthers {
+		i--
+		if m.BurnableFromOthers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mintable {
+		i--
+		if m.Mintable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -174,6 +651,69 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.ForceTransferrers) > 0 {
+		for _, s := range m.ForceTransferrers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for _, s := range m.MetadataManagers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for _, s := range m.Pausers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Freezers) > 0 {
+		for _, s := range m.Freezers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.ComplianceManagers) > 0 {
+		for _, s := range m.ComplianceManagers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mintable {
+		n += 2
+	}
+	if m.BurnableFromOthers {
+		n += 2
+	}
+	if m.ForceTransferable {
+		n += 2
+	}
+	if m.MetadataMutable {
+		n += 2
+	}
 	return n
 }
 
@@ -244,6 +784,360 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferrers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceTransferrers = append(m.ForceTransferrers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataManagers = append(m.MetadataManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pausers = append(m.Pausers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freezers = append(m.Freezers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceManagers = append(m.ComplianceManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomCapabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomCapabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnableFromOthers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnableFromOthers = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceTransferable = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataMutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MetadataMutable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
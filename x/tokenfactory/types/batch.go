@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBatchEntry returns an entry of a batch message, applying amount to address
+func NewBatchEntry(address string, amount math.Int) BatchEntry {
+	return BatchEntry{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+func (e BatchEntry) Validate() error {
+	_, err := sdk.AccAddressFromBech32(e.Address)
+	if err != nil {
+		return fmt.Errorf("invalid batch entry address (%s)", err)
+	}
+
+	if e.Amount.IsNil() || !e.Amount.IsPositive() {
+		return fmt.Errorf("invalid batch entry amount %s of %s", e.Amount, e.Address)
+	}
+
+	return nil
+}
+
+// BatchEntriesTotal returns the sum of the amounts of the entries of a batch message
+func BatchEntriesTotal(entries []BatchEntry) math.Int {
+	total := math.ZeroInt()
+	for _, entry := range entries {
+		total = total.Add(entry.Amount)
+	}
+	return total
+}
+
+func validateBatchEntries(entries []BatchEntry) error {
+	if len(entries) == 0 {
+		return fmt.Errorf("batch has no entries")
+	}
+
+	for _, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BatchEntriesAddresses returns the addresses of the entries of a batch message
+func BatchEntriesAddresses(entries []BatchEntry) []string {
+	addresses := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		addresses = append(addresses, entry.Address)
+	}
+	return addresses
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// BeforeSendMsg describes a transfer of a factory denom to its before send hook
+type BeforeSendMsg struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// BlockBeforeSendSudoMsg is sent to the before send hook of a denom before a transfer. The
+// transfer fails if the contract returns an error.
+type BlockBeforeSendSudoMsg struct {
+	BlockBeforeSend BeforeSendMsg `json:"block_before_send"`
+}
+
+// TrackBeforeSendSudoMsg is sent to the before send hook of a denom before any movement of the
+// denom, including mints and burns. Errors returned by the contract are ignored.
+type TrackBeforeSendSudoMsg struct {
+	TrackBeforeSend BeforeSendMsg `json:"track_before_send"`
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AllCapabilities returns every capability that can be held over a denom.
+func AllCapabilities() []DenomCapability {
+	return []DenomCapability{CapabilityMintable, CapabilityBurnableFromOthers, CapabilityForceTransferable, CapabilityMetadataMutable}
+}
+
+// ShortName returns the capability name as used by the CLI, e.g. "force-transferable".
+func (capability DenomCapability) ShortName() string {
+	name := strings.TrimPrefix(capability.String(), "DENOM_CAPABILITY_")
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
+
+// ParseDenomCapability parses either the short name of a capability ("mintable") or
+// its full enum name ("DENOM_CAPABILITY_MINTABLE").
+func ParseDenomCapability(name string) (DenomCapability, error) {
+	for _, capability := range AllCapabilities() {
+		if name == capability.ShortName() || name == capability.String() {
+			return capability, nil
+		}
+	}
+	return CapabilityUnspecified, fmt.Errorf("unknown denom capability: %s", name)
+}
+
+// Validate returns an error if the capability is not one that can be held over a denom.
+func (capability DenomCapability) Validate() error {
+	for _, valid := range AllCapabilities() {
+		if capability == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid denom capability: %s", capability)
+}
+
+// ValidateRenouncedCapabilities returns an error if a renounced capability is invalid or
+// listed twice.
+func ValidateRenouncedCapabilities(renounced []DenomCapability) error {
+	seen := map[DenomCapability]bool{}
+	for _, capability := range renounced {
+		if err := capability.Validate(); err != nil {
+			return err
+		}
+		if seen[capability] {
+			return fmt.Errorf("duplicate renounced capability: %s", capability.ShortName())
+		}
+		seen[capability] = true
+	}
+	return nil
+}
+
+// NewDenomCapabilities returns the capabilities held over a denom, which are all the
+// capabilities except the renounced ones.
+func NewDenomCapabilities(renounced []DenomCapability) DenomCapabilities {
+	capabilities := DenomCapabilities{
+		Mintable:           true,
+		BurnableFromOthers: true,
+		ForceTransferable:  true,
+		MetadataMutable:    true,
+	}
+	for _, capability := range renounced {
+		switch capability {
+		case CapabilityMintable:
+			capabilities.Mintable = false
+		case CapabilityBurnableFromOthers:
+			capabilities.BurnableFromOthers = false
+		case CapabilityForceTransferable:
+			capabilities.ForceTransferable = false
+		case CapabilityMetadataMutable:
+			capabilities.MetadataMutable = false
+		}
+	}
+	return capabilities
+}
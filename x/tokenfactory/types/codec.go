@@ -15,6 +15,32 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTokenFactoryBurn{}, "osmosis/tokenfactory/burn", nil)
 	cdc.RegisterConcrete(&MsgTokenFactoryForceTransfer{}, "osmosis/tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgTokenFactoryChangeAdmin{}, "osmosis/tokenfactory/change-admin", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryGrantRole{}, "osmosis/tokenfactory/grant-role", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryRevokeRole{}, "osmosis/tokenfactory/revoke-role", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryProposeAdmin{}, "osmosis/tokenfactory/propose-admin", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryAcceptAdmin{}, "osmosis/tokenfactory/accept-admin", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryCancelAdminProposal{}, "osmosis/tokenfactory/cancel-admin-proposal", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryConfigureMinter{}, "osmosis/tokenfactory/configure-minter", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryIncreaseMinterAllowance{}, "osmosis/tokenfactory/increase-minter-allowance", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryDecreaseMinterAllowance{}, "osmosis/tokenfactory/decrease-minter-allowance", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryRemoveMinter{}, "osmosis/tokenfactory/remove-minter", nil)
+	cdc.RegisterConcrete(&MsgTokenFactorySetMaxSupply{}, "osmosis/tokenfactory/set-max-supply", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryPause{}, "osmosis/tokenfactory/pause", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryUnpause{}, "osmosis/tokenfactory/unpause", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryFreeze{}, "osmosis/tokenfactory/freeze", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryUnfreeze{}, "osmosis/tokenfactory/unfreeze", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryAddToAllowlist{}, "osmosis/tokenfactory/add-to-allowlist", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryRemoveFromAllowlist{}, "osmosis/tokenfactory/remove-from-allowlist", nil)
+	cdc.RegisterConcrete(&MsgTokenFactorySetBeforeSendHook{}, "osmosis/tokenfactory/set-before-send-hook", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryRenounceCapability{}, "osmosis/tokenfactory/renounce-capability", nil)
+	cdc.RegisterConcrete(&MsgTokenFactorySetTimelock{}, "osmosis/tokenfactory/set-timelock", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryCancelPendingAction{}, "osmosis/tokenfactory/cancel-pending-action", nil)
+	cdc.RegisterConcrete(&MsgTokenFactorySetMintRateLimit{}, "osmosis/tokenfactory/set-mint-rate-limit", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryUpdateParams{}, "osmosis/tokenfactory/update-params", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryRetireDenom{}, "osmosis/tokenfactory/retire-denom", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryBatchMint{}, "osmosis/tokenfactory/batch-mint", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryBatchBurn{}, "osmosis/tokenfactory/batch-burn", nil)
+	cdc.RegisterConcrete(&MsgTokenFactoryBatchForceTransfer{}, "osmosis/tokenfactory/batch-force-transfer", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +51,32 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgTokenFactoryBurn{},
 		&MsgTokenFactoryForceTransfer{},
 		&MsgTokenFactoryChangeAdmin{},
+		&MsgTokenFactoryGrantRole{},
+		&MsgTokenFactoryRevokeRole{},
+		&MsgTokenFactoryProposeAdmin{},
+		&MsgTokenFactoryAcceptAdmin{},
+		&MsgTokenFactoryCancelAdminProposal{},
+		&MsgTokenFactoryConfigureMinter{},
+		&MsgTokenFactoryIncreaseMinterAllowance{},
+		&MsgTokenFactoryDecreaseMinterAllowance{},
+		&MsgTokenFactoryRemoveMinter{},
+		&MsgTokenFactorySetMaxSupply{},
+		&MsgTokenFactoryPause{},
+		&MsgTokenFactoryUnpause{},
+		&MsgTokenFactoryFreeze{},
+		&MsgTokenFactoryUnfreeze{},
+		&MsgTokenFactoryAddToAllowlist{},
+		&MsgTokenFactoryRemoveFromAllowlist{},
+		&MsgTokenFactorySetBeforeSendHook{},
+		&MsgTokenFactoryRenounceCapability{},
+		&MsgTokenFactorySetTimelock{},
+		&MsgTokenFactoryCancelPendingAction{},
+		&MsgTokenFactorySetMintRateLimit{},
+		&MsgTokenFactoryUpdateParams{},
+		&MsgTokenFactoryRetireDenom{},
+		&MsgTokenFactoryBatchMint{},
+		&MsgTokenFactoryBatchBurn{},
+		&MsgTokenFactoryBatchForceTransfer{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
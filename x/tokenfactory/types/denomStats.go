@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewDenomStats returns the stats of a denom that was never minted, burned or force transferred
+func NewDenomStats() DenomStats {
+	return DenomStats{
+		Minted:           math.ZeroInt(),
+		Burned:           math.ZeroInt(),
+		ForceTransferred: math.ZeroInt(),
+	}
+}
+
+func (s DenomStats) Validate() error {
+	if s.Minted.IsNil() || s.Minted.IsNegative() {
+		return fmt.Errorf("invalid minted amount: %s", s.Minted)
+	}
+	if s.Burned.IsNil() || s.Burned.IsNegative() {
+		return fmt.Errorf("invalid burned amount: %s", s.Burned)
+	}
+	if s.ForceTransferred.IsNil() || s.ForceTransferred.IsNegative() {
+		return fmt.Errorf("invalid force transferred amount: %s", s.ForceTransferred)
+	}
+	return nil
+}
@@ -0,0 +1,713 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/denomStats.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomStats tracks the amounts of a denom ever minted, burned and force
+// transferred, as opposed to its net supply.
+type DenomStats struct {
+	Minted           cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted" yaml:"minted"`
+	Burned           cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned" yaml:"burned"`
+	ForceTransferred cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=force_transferred,json=forceTransferred,proto3,customtype=cosmossdk.io/math.Int" json:"force_transferred" yaml:"force_transferred"`
+}
+
+func (m *DenomStats) Reset()         { *m = DenomStats{} }
+func (m *DenomStats) String() string { return proto.CompactTextString(m) }
+func (*DenomStats) ProtoMessage()    {}
+func (*DenomStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88388ac435c4b5f8, []int{0}
+}
+func (m *DenomStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomStats.Merge(m, src)
+}
+func (m *DenomStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomStats proto.InternalMessageInfo
+
+// ModuleSummary tracks the number of denoms created through the module that
+// haven't been retired, and the creation fees collected so far. Creation fees
+// held as deposits are not counted.
+type ModuleSummary struct {
+	TotalDenoms        uint64                                   `protobuf:"varint,1,opt,name=total_denoms,json=totalDenoms,proto3" json:"total_denoms,omitempty" yaml:"total_denoms"`
+	TotalFeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees_collected,json=totalFeesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees_collected" yaml:"total_fees_collected"`
+}
+
+func (m *ModuleSummary) Reset()         { *m = ModuleSummary{} }
+func (m *ModuleSummary) String() string { return proto.CompactTextString(m) }
+func (*ModuleSummary) ProtoMessage()    {}
+func (*ModuleSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88388ac435c4b5f8, []int{1}
+}
+func (m *ModuleSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleSummary.Merge(m, src)
+}
+func (m *ModuleSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleSummary proto.InternalMessageInfo
+
+func (m *ModuleSummary) GetTotalDenoms() uint64 {
+	if m != nil {
+		return m.TotalDenoms
+	}
+	return 0
+}
+
+func (m *ModuleSummary) GetTotalFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFeesCollected
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DenomStats)(nil), "osmosis.tokenfactory.v1beta1.DenomStats")
+	proto.RegisterType((*ModuleSummary)(nil), "osmosis.tokenfactory.v1beta1.ModuleSummary")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/denomStats.proto", fileDescriptor_88388ac435c4b5f8)
+}
+
+var fileDescriptor_88388ac435c4b5f8 = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xb6, 0xaa, 0xc4, 0x95, 0x4a, 0x60, 0x8a, 0x48, 0x0b, 0xb2, 0x91, 0xa7, 0x2c,
+	0xb6, 0x55, 0x18, 0x90, 0x22, 0xa6, 0x14, 0x81, 0x3a, 0x20, 0x44, 0xda, 0x89, 0x25, 0x3a, 0xdb,
+	0x2f, 0xa9, 0x15, 0xdf, 0xbd, 0xea, 0xee, 0x05, 0x91, 0x6f, 0xc1, 0x07, 0x60, 0x60, 0x66, 0xee,
+	0x87, 0xe8, 0x58, 0x31, 0x21, 0x06, 0x83, 0x92, 0x85, 0x11, 0xe5, 0x13, 0xa0, 0xdc, 0x5d, 0x8a,
+	0x4b, 0x07, 0xd4, 0x29, 0x79, 0x7e, 0xff, 0xf7, 0xfb, 0xdf, 0xbd, 0xfb, 0xb3, 0x18, 0xb5, 0x40,
+	0x5d, 0xea, 0x94, 0x70, 0x0c, 0x72, 0xc8, 0x73, 0x42, 0x35, 0x4d, 0xdf, 0xef, 0x67, 0x40, 0x7c,
+	0x3f, 0x2d, 0x40, 0xa2, 0x38, 0x22, 0x4e, 0x3a, 0x39, 0x55, 0x48, 0xe8, 0x3f, 0x72, 0xf2, 0xa4,
+	0x29, 0x4f, 0x9c, 0x7c, 0x6f, 0x67, 0x84, 0x23, 0x34, 0xc2, 0x74, 0xf9, 0xcf, 0xce, 0xec, 0xed,
+	0xe6, 0x66, 0x68, 0x60, 0x1b, 0xb6, 0x70, 0xad, 0xc0, 0x56, 0x69, 0xc6, 0x35, 0x5c, 0x9a, 0xe6,
+	0x58, 0x4a, 0xdb, 0x8f, 0xce, 0xd6, 0x18, 0x7b, 0x71, 0x79, 0x06, 0xff, 0x98, 0x6d, 0x8a, 0x52,
+	0x12, 0x14, 0x6d, 0xef, 0xb1, 0xd7, 0xb9, 0xd5, 0x7b, 0x7e, 0x5e, 0x87, 0xad, 0xef, 0x75, 0x78,
+	0xdf, 0x62, 0x74, 0x31, 0x4e, 0x4a, 0x4c, 0x05, 0xa7, 0x93, 0xe4, 0x50, 0xd2, 0xa2, 0x0e, 0xb7,
+	0xa7, 0x5c, 0x54, 0xdd, 0xc8, 0x0e, 0x45, 0x5f, 0xcf, 0x62, 0xe6, 0xec, 0x0f, 0x25, 0xf5, 0x1d,
+	0x6b, 0x49, 0xcd, 0x26, 0x4a, 0x42, 0xd1, 0x5e, 0xbb, 0x11, 0xd5, 0x0e, 0x5d, 0xa3, 0xda, 0xcf,
+	0x3e, 0xb1, 0xbb, 0x43, 0x54, 0x39, 0x0c, 0x48, 0x71, 0xa9, 0x87, 0xa0, 0x14, 0x14, 0xed, 0x75,
+	0x63, 0xf0, 0xea, 0x7f, 0x06, 0x6d, 0x6b, 0x70, 0x6d, 0xfe, 0x5f, 0xaf, 0x3b, 0x46, 0x71, 0xfc,
+	0x57, 0xd0, 0xdd, 0xf8, 0xf5, 0x39, 0xf4, 0xa2, 0xdf, 0x1e, 0xdb, 0x7e, 0x8d, 0xc5, 0xa4, 0x82,
+	0xa3, 0x89, 0x10, 0x5c, 0x4d, 0xfd, 0x2e, 0xbb, 0x4d, 0x48, 0xbc, 0x1a, 0x98, 0x17, 0xd5, 0x66,
+	0x7f, 0x1b, 0xbd, 0x07, 0x8b, 0x3a, 0xbc, 0x67, 0xbd, 0x9a, 0xdd, 0xa8, 0xbf, 0x65, 0x4a, 0xb3,
+	0x79, 0xed, 0x7f, 0xf2, 0xd8, 0x8e, 0x6d, 0x0f, 0x01, 0xf4, 0x20, 0xc7, 0xaa, 0x82, 0x9c, 0xcc,
+	0xba, 0xd6, 0x3b, 0x5b, 0x4f, 0x76, 0x13, 0x77, 0xa2, 0xe5, 0x23, 0xae, 0xa2, 0x90, 0x1c, 0x60,
+	0x29, 0x7b, 0x6f, 0x96, 0x17, 0x5d, 0xd4, 0xe1, 0xc3, 0xa6, 0xc7, 0x55, 0x48, 0xf4, 0xe5, 0x47,
+	0xd8, 0x19, 0x95, 0x74, 0x32, 0xc9, 0x92, 0x1c, 0x85, 0x8b, 0x87, 0xfb, 0x89, 0x75, 0x31, 0x4e,
+	0x69, 0x7a, 0x0a, 0xda, 0xf0, 0x74, 0xdf, 0x37, 0x88, 0x97, 0x00, 0xfa, 0x60, 0x05, 0xb0, 0x57,
+	0xee, 0xbd, 0x3d, 0x9f, 0x05, 0xde, 0xc5, 0x2c, 0xf0, 0x7e, 0xce, 0x02, 0xef, 0xe3, 0x3c, 0x68,
+	0x5d, 0xcc, 0x83, 0xd6, 0xb7, 0x79, 0xd0, 0x7a, 0xf7, 0xac, 0x41, 0x97, 0xa8, 0x4a, 0x1e, 0x4b,
+	0x20, 0x1b, 0xf7, 0x78, 0x95, 0xf7, 0x0f, 0x57, 0xe3, 0x6f, 0x2c, 0xb3, 0x4d, 0x93, 0xc1, 0xa7,
+	0x7f, 0x06, 0x00, 0x5e, 0xcf, 0x69, 0xf3, 0x23, 0x03, 0x00, 0x00,
+}
+
+func (this *DenomStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomStats)
+	if !ok {
+		that2, ok := that.(DenomStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Minted.Equal(that1.Minted) {
+		return false
+	}
+	if !this.Burned.Equal(that1.Burned) {
+		return false
+	}
+	if !this.ForceTransferred.Equal(that1.ForceTransferred) {
+		return false
+	}
+	return true
+}
+func (this *ModuleSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleSummary)
+	if !ok {
+		that2, ok := that.(ModuleSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TotalDenoms != that1.TotalDenoms {
+		return false
+	}
+	if len(this.TotalFeesCollected) != len(that1.TotalFeesCollected) {
+		return false
+	}
+	for i := range this.TotalFeesCollected {
+		if !this.TotalFeesCollected[i].Equal(&that1.TotalFeesCollected[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *DenomStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ForceTransferred.Size()
+		i -= size
+		if _, err := m.ForceTransferred.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDenomStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDenomStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDenomStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFeesCollected) > 0 {
+		for iNdEx := len(m.TotalFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDenomStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TotalDenoms != 0 {
+		i = encodeVarintDenomStats(dAtA, i, uint64(m.TotalDenoms))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenomStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenomStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovDenomStats(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovDenomStats(uint64(l))
+	l = m.ForceTransferred.Size()
+	n += 1 + l + sovDenomStats(uint64(l))
+	return n
+}
+
+func (m *ModuleSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalDenoms != 0 {
+		n += 1 + sovDenomStats(uint64(m.TotalDenoms))
+	}
+	if len(m.TotalFeesCollected) > 0 {
+		for _, e := range m.TotalFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovDenomStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDenomStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenomStats(x uint64) (n int) {
+	return sovDenomStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferred", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForceTransferred.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDenoms", wireType)
+			}
+			m.TotalDenoms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDenoms |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFeesCollected = append(m.TotalFeesCollected, types.Coin{})
+			if err := m.TotalFeesCollected[len(m.TotalFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenomStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenomStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenomStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenomStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenomStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenomStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenomStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenomStats = fmt.Errorf("proto: unexpected end of group")
+)
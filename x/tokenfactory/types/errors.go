@@ -19,4 +19,41 @@ var (
 	ErrSubdenomTooLong          = sdkerrors.Register(ModuleName, 8, fmt.Sprintf("subdenom too long, max length is %d bytes", MaxSubdenomLength))
 	ErrCreatorTooLong           = sdkerrors.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = sdkerrors.Register(ModuleName, 10, "denom does not exist")
+	ErrInvalidRole              = sdkerrors.Register(ModuleName, 11, "invalid denom role")
+	ErrRoleAlreadyGranted       = sdkerrors.Register(ModuleName, 12, "role already granted")
+	ErrRoleNotGranted           = sdkerrors.Register(ModuleName, 13, "role not granted")
+	ErrNoPendingAdmin           = sdkerrors.Register(ModuleName, 14, "no pending admin proposal")
+	ErrRenounceNotConfirmed     = sdkerrors.Register(ModuleName, 15, "renouncing the admin must be explicitly confirmed")
+	ErrAdminChangeNotProposed   = sdkerrors.Register(ModuleName, 16, "a new admin must be proposed and accepted")
+	ErrMinterNotFound           = sdkerrors.Register(ModuleName, 17, "minter allowance not found")
+	ErrMinterAllowanceExceeded  = sdkerrors.Register(ModuleName, 18, "minter allowance exceeded")
+	ErrInvalidMinterAllowance   = sdkerrors.Register(ModuleName, 19, "invalid minter allowance")
+	ErrMaxSupplyExceeded        = sdkerrors.Register(ModuleName, 20, "max supply exceeded")
+	ErrInvalidMaxSupply         = sdkerrors.Register(ModuleName, 21, "invalid max supply")
+	ErrDenomPaused              = sdkerrors.Register(ModuleName, 22, "denom is paused")
+	ErrDenomNotPaused           = sdkerrors.Register(ModuleName, 23, "denom is not paused")
+	ErrAddressFrozen            = sdkerrors.Register(ModuleName, 24, "address is frozen")
+	ErrAddressNotFrozen         = sdkerrors.Register(ModuleName, 25, "address is not frozen")
+	ErrAllowlistDisabled        = sdkerrors.Register(ModuleName, 26, "denom is not in allowlist mode")
+	ErrAddressNotAllowed        = sdkerrors.Register(ModuleName, 27, "address is not on the allowlist")
+	ErrAddressAlreadyAllowed    = sdkerrors.Register(ModuleName, 28, "address is already on the allowlist")
+	ErrBeforeSendHookFailed     = sdkerrors.Register(ModuleName, 29, "before send hook failed")
+	ErrCapabilityRenounced      = sdkerrors.Register(ModuleName, 30, "denom capability has been renounced")
+	ErrInvalidTimelock          = sdkerrors.Register(ModuleName, 31, "invalid timelock")
+	ErrPendingActionNotFound    = sdkerrors.Register(ModuleName, 32, "pending action not found")
+	ErrInvalidMintRateLimit     = sdkerrors.Register(ModuleName, 33, "invalid mint rate limit")
+	ErrMintRateLimitExceeded    = sdkerrors.Register(ModuleName, 34, "mint rate limit exceeded")
+	ErrCreatorNotAllowed        = sdkerrors.Register(ModuleName, 35, "address is not allowed to create denoms")
+	ErrCreatorDenomLimit        = sdkerrors.Register(ModuleName, 36, "creator reached the maximum number of denoms")
+	ErrBlockCreationLimit       = sdkerrors.Register(ModuleName, 37, "maximum number of denom creations in the block reached")
+	ErrInvalidFeeDenom          = sdkerrors.Register(ModuleName, 38, "invalid denom creation fee denom")
+	ErrInvalidCreationDeposit   = sdkerrors.Register(ModuleName, 39, "invalid creation deposit")
+	ErrDenomHasSupply           = sdkerrors.Register(ModuleName, 40, "denom still has supply")
+	ErrDenomRetired             = sdkerrors.Register(ModuleName, 41, "denom was retired")
+	ErrDuplicateReferenceID     = sdkerrors.Register(ModuleName, 42, "reference id was already used")
+	ErrReferenceIDNotFound      = sdkerrors.Register(ModuleName, 43, "reference id not found")
+	ErrReferenceIDsDisabled     = sdkerrors.Register(ModuleName, 44, "reference ids are disabled")
+	ErrProtectedAddress         = sdkerrors.Register(ModuleName, 45, "address is protected from force transfers and burns")
+	ErrInvalidBeforeSendHook    = sdkerrors.Register(ModuleName, 46, "invalid before send hook")
+	ErrPendingActionPanicked    = sdkerrors.Register(ModuleName, 47, "pending action panicked")
 )
@@ -14,5 +14,37 @@ const (
 	AttributeTransferToAddress   = "transfer_to_address"
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
+	AttributePendingAdmin        = "pending_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeRole                = "role"
+	AttributeAddress             = "address"
+	AttributeMinter              = "minter"
+	AttributeAllowance           = "allowance"
+	AttributeRemaining           = "remaining"
+	AttributeMaxSupply           = "max_supply"
+	AttributeAddresses           = "addresses"
+	AttributeAllowlistEnabled    = "allowlist_enabled"
+	AttributeBeforeSendHook      = "before_send_hook_address"
+	AttributeCapability          = "capability"
+	AttributeRenounced           = "renounced_capabilities"
+	AttributeDelay               = "delay"
+	AttributeMintThreshold       = "mint_threshold"
+	AttributePendingActionID     = "pending_action_id"
+	AttributeReadyTime           = "ready_time"
+	AttributeAction              = "action"
+	AttributeError               = "error"
+	AttributeLimit               = "limit"
+	AttributeWindowBlocks        = "window_blocks"
+	AttributeWindowDuration      = "window_duration"
+	AttributeEffectiveTime       = "effective_time"
+	AttributeAuthority           = "authority"
+	AttributeEntries             = "entries"
+	AttributeReferenceID         = "reference_id"
+)
+
+// event types emitted outside of the msg handlers
+const (
+	EventTypePendingActionQueued   = "pending_action_queued"
+	EventTypePendingActionExecuted = "pending_action_executed"
+	EventTypePendingActionFailed   = "pending_action_failed"
 )
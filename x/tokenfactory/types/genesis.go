@@ -13,8 +13,9 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		FactoryDenoms: []GenesisDenom{},
+		Params:              DefaultParams(),
+		FactoryDenoms:       []GenesisDenom{},
+		NextPendingActionId: 1,
 	}
 }
 
@@ -45,7 +46,248 @@ func (gs GenesisState) Validate() error {
 				return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		err = denom.AuthorityMetadata.Validate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid authority metadata (%s)", err)
+		}
+
+		if denom.PendingAdmin != "" {
+			_, err = sdk.AccAddressFromBech32(denom.PendingAdmin)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid pending admin address (%s)", err)
+			}
+		}
+
+		if denom.BeforeSendHookAddress != "" {
+			_, err = sdk.AccAddressFromBech32(denom.BeforeSendHookAddress)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid before send hook address (%s)", err)
+			}
+		}
+
+		err = ValidateRenouncedCapabilities(denom.RenouncedCapabilities)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid renounced capabilities of %s (%s)", denom.GetDenom(), err)
+		}
+
+		if !denom.MaxSupply.IsNil() && denom.MaxSupply.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidMaxSupply, "Invalid max supply of %s (%s)", denom.GetDenom(), denom.MaxSupply)
+		}
+
+		seenFrozen := map[string]bool{}
+		for _, address := range denom.FrozenAddresses {
+			if seenFrozen[address] {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate frozen address %s of %s", address, denom.GetDenom())
+			}
+			seenFrozen[address] = true
+
+			_, err = sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid frozen address (%s)", err)
+			}
+		}
+
+		if len(denom.Allowlist) > 0 && !denom.AllowlistEnabled {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "allowlist of %s is not enabled", denom.GetDenom())
+		}
+
+		seenAllowed := map[string]bool{}
+		for _, address := range denom.Allowlist {
+			if seenAllowed[address] {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate allowed address %s of %s", address, denom.GetDenom())
+			}
+			seenAllowed[address] = true
+
+			_, err = sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid allowed address (%s)", err)
+			}
+		}
+
+		err = denom.Timelock.Validate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTimelock, "Invalid timelock of %s (%s)", denom.GetDenom(), err)
+		}
+
+		if !denom.MintRateLimit.Limit.IsNil() {
+			err = denom.MintRateLimit.Validate()
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidMintRateLimit, "Invalid mint rate limit of %s (%s)", denom.GetDenom(), err)
+			}
+		}
+
+		if denom.PendingMintRateLimit != nil {
+			err = denom.PendingMintRateLimit.RateLimit.Validate()
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidMintRateLimit, "Invalid pending mint rate limit of %s (%s)", denom.GetDenom(), err)
+			}
+		}
+
+		seenHeights := map[int64]bool{}
+		for _, record := range denom.MintRecords {
+			if seenHeights[record.Height] {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate mint record at height %d of %s", record.Height, denom.GetDenom())
+			}
+			seenHeights[record.Height] = true
+
+			err = record.Validate()
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid mint record of %s (%s)", denom.GetDenom(), err)
+			}
+		}
+
+		err = denom.CreationDeposit.Validate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidCreationDeposit, "Invalid creation deposit of %s (%s)", denom.GetDenom(), err)
+		}
+
+		if denom.CreationHeight < 0 {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid creation height %d of %s", denom.CreationHeight, denom.GetDenom())
+		}
+
+		if !denom.Stats.Minted.IsNil() {
+			err = denom.Stats.Validate()
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid stats of %s (%s)", denom.GetDenom(), err)
+			}
+		}
+
+		seenReferences := map[string]bool{}
+		for _, record := range denom.References {
+			if seenReferences[record.ReferenceId] {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate reference id %s of %s", record.ReferenceId, denom.GetDenom())
+			}
+			seenReferences[record.ReferenceId] = true
+
+			err = record.Validate()
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid reference record of %s (%s)", denom.GetDenom(), err)
+			}
+		}
+
+		err = validateAuditLog(denom.GetDenom(), denom.AuditLog)
+		if err != nil {
+			return err
+		}
+
+		seenMinters := map[string]bool{}
+		for _, allowance := range denom.MinterAllowances {
+			if seenMinters[allowance.Minter] {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate minter %s of %s", allowance.Minter, denom.GetDenom())
+			}
+			seenMinters[allowance.Minter] = true
+
+			err = allowance.Validate()
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidMinterAllowance, "Invalid minter allowance (%s)", err)
+			}
+		}
+	}
+
+	seenActions := map[uint64]bool{}
+	for _, action := range gs.GetPendingActions() {
+		if seenActions[action.Id] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate pending action: %d", action.Id)
+		}
+		seenActions[action.Id] = true
+
+		if action.Id == 0 || action.Id >= gs.NextPendingActionId {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "pending action id %d must be between 1 and %d", action.Id, gs.NextPendingActionId)
+		}
+
+		if !seenDenoms[action.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "pending action %d of unknown denom %s", action.Id, action.Denom)
+		}
+
+		err = action.Validate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid pending action (%s)", err)
+		}
+	}
+
+	seenCreators := map[string]bool{}
+	for _, count := range gs.GetCreatorDenomCounts() {
+		if seenCreators[count.Creator] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate creator denom count: %s", count.Creator)
+		}
+		seenCreators[count.Creator] = true
+
+		_, err = sdk.AccAddressFromBech32(count.Creator)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid creator address (%s)", err)
+		}
+	}
+
+	seenRetired := map[string]bool{}
+	for _, denom := range gs.GetRetiredDenoms() {
+		if seenRetired[denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate retired denom: %s", denom)
+		}
+		seenRetired[denom] = true
+
+		if seenDenoms[denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "retired denom %s is still a factory denom", denom)
+		}
+
+		_, _, err = DeconstructDenom(denom)
+		if err != nil {
+			return err
+		}
 	}
 
+	seenHistories := map[string]bool{}
+	for _, history := range gs.GetRetiredDenomHistories() {
+		if seenHistories[history.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate retired denom history: %s", history.Denom)
+		}
+		seenHistories[history.Denom] = true
+
+		if !seenRetired[history.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "history of %s which was not retired", history.Denom)
+		}
+
+		if history.CreationHeight < 0 {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid creation height %d of %s", history.CreationHeight, history.Denom)
+		}
+
+		if !history.Stats.Minted.IsNil() {
+			err = history.Stats.Validate()
+			if err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid stats of %s (%s)", history.Denom, err)
+			}
+		}
+
+		err = validateAuditLog(history.Denom, history.AuditLog)
+		if err != nil {
+			return err
+		}
+	}
+
+	if gs.BlockCreationCount.Height < 0 {
+		return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid block creation count height %d", gs.BlockCreationCount.Height)
+	}
+
+	err = gs.TotalFeesCollected.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid total fees collected (%s)", err)
+	}
+
+	return nil
+}
+
+// validateAuditLog validates the entries of the audit log of a denom, which must be ordered by
+// sequence
+func validateAuditLog(denom string, auditLog []AuditLogEntry) error {
+	for i, entry := range auditLog {
+		if i > 0 && entry.Sequence <= auditLog[i-1].Sequence {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "audit log entry %d of %s is out of order", entry.Sequence, denom)
+		}
+
+		err := entry.Validate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "Invalid audit log entry of %s (%s)", denom, err)
+		}
+	}
 	return nil
 }
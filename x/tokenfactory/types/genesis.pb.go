@@ -4,18 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +36,21 @@ type GenesisState struct {
 	// params defines the paramaters of the module.
 	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	FactoryDenoms []GenesisDenom `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	// id given to the next timelocked action
+	NextPendingActionId uint64 `protobuf:"varint,5,opt,name=next_pending_action_id,json=nextPendingActionId,proto3" json:"next_pending_action_id,omitempty" yaml:"next_pending_action_id"`
+	// timelocked actions waiting to be executed
+	PendingActions []PendingAction `protobuf:"bytes,6,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions" yaml:"pending_actions"`
+	// number of denoms created by each creator
+	CreatorDenomCounts []CreatorDenomCount `protobuf:"bytes,7,rep,name=creator_denom_counts,json=creatorDenomCounts,proto3" json:"creator_denom_counts" yaml:"creator_denom_counts"`
+	// number of denoms created in the last block with a creation
+	BlockCreationCount BlockCreationCount `protobuf:"bytes,8,opt,name=block_creation_count,json=blockCreationCount,proto3" json:"block_creation_count" yaml:"block_creation_count"`
+	// denoms that were retired, whose subdenoms can't be reused unless the
+	// allow_subdenom_reuse param is set
+	RetiredDenoms []string `protobuf:"bytes,9,rep,name=retired_denoms,json=retiredDenoms,proto3" json:"retired_denoms,omitempty" yaml:"retired_denoms"`
+	// creation fees collected so far, not counting the fees held as deposits
+	TotalFeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=total_fees_collected,json=totalFeesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees_collected" yaml:"total_fees_collected"`
+	// history of the retired denoms, kept after their retirement
+	RetiredDenomHistories []RetiredDenomHistory `protobuf:"bytes,11,rep,name=retired_denom_histories,json=retiredDenomHistories,proto3" json:"retired_denom_histories" yaml:"retired_denom_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,19 +100,189 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 	return nil
 }
 
+func (m *GenesisState) GetNextPendingActionId() uint64 {
+	if m != nil {
+		return m.NextPendingActionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingActions() []PendingAction {
+	if m != nil {
+		return m.PendingActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreatorDenomCounts() []CreatorDenomCount {
+	if m != nil {
+		return m.CreatorDenomCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockCreationCount() BlockCreationCount {
+	if m != nil {
+		return m.BlockCreationCount
+	}
+	return BlockCreationCount{}
+}
+
+func (m *GenesisState) GetRetiredDenoms() []string {
+	if m != nil {
+		return m.RetiredDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFeesCollected
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetiredDenomHistories() []RetiredDenomHistory {
+	if m != nil {
+		return m.RetiredDenomHistories
+	}
+	return nil
+}
+
+// RetiredDenomHistory is the history of a retired denom: its creation, its
+// lifetime stats and its audit log, which are kept when the rest of its state
+// is deleted.
+type RetiredDenomHistory struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// height of the block the denom was created in
+	CreationHeight int64 `protobuf:"varint,2,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// time of the block the denom was created in
+	CreationTime time.Time `protobuf:"bytes,3,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time" yaml:"creation_time"`
+	// amounts ever minted, burned and force transferred
+	Stats DenomStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats" yaml:"stats"`
+	// latest privileged actions performed on the denom, oldest first
+	AuditLog []AuditLogEntry `protobuf:"bytes,5,rep,name=audit_log,json=auditLog,proto3" json:"audit_log" yaml:"audit_log"`
+}
+
+func (m *RetiredDenomHistory) Reset()         { *m = RetiredDenomHistory{} }
+func (m *RetiredDenomHistory) String() string { return proto.CompactTextString(m) }
+func (*RetiredDenomHistory) ProtoMessage()    {}
+func (*RetiredDenomHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5749c3f71850298b, []int{1}
+}
+func (m *RetiredDenomHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetiredDenomHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetiredDenomHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetiredDenomHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetiredDenomHistory.Merge(m, src)
+}
+func (m *RetiredDenomHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetiredDenomHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetiredDenomHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetiredDenomHistory proto.InternalMessageInfo
+
+func (m *RetiredDenomHistory) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RetiredDenomHistory) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *RetiredDenomHistory) GetCreationTime() time.Time {
+	if m != nil {
+		return m.CreationTime
+	}
+	return time.Time{}
+}
+
+func (m *RetiredDenomHistory) GetStats() DenomStats {
+	if m != nil {
+		return m.Stats
+	}
+	return DenomStats{}
+}
+
+func (m *RetiredDenomHistory) GetAuditLog() []AuditLogEntry {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, and the pending admin proposal if there is one.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// proposed admin that has not yet accepted the adminship, if any
+	PendingAdmin string `protobuf:"bytes,3,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty" yaml:"pending_admin"`
+	// allowances of the delegated minters of the denom
+	MinterAllowances []MinterAllowance `protobuf:"bytes,4,rep,name=minter_allowances,json=minterAllowances,proto3" json:"minter_allowances" yaml:"minter_allowances"`
+	// supply cap of the denom, zero if the supply is not capped
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// whether the transfers of the denom are paused
+	Paused bool `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// addresses that can't send or receive the denom
+	FrozenAddresses []string `protobuf:"bytes,7,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+	// whether only the addresses on the allowlist can receive the denom
+	AllowlistEnabled bool `protobuf:"varint,8,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty" yaml:"allowlist_enabled"`
+	// addresses allowed to receive the denom in allowlist mode
+	Allowlist []string `protobuf:"bytes,9,rep,name=allowlist,proto3" json:"allowlist,omitempty" yaml:"allowlist"`
+	// CosmWasm contract called before every transfer of the denom, if any
+	BeforeSendHookAddress string `protobuf:"bytes,10,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
+	// capabilities renounced over the denom
+	RenouncedCapabilities []DenomCapability `protobuf:"varint,11,rep,packed,name=renounced_capabilities,json=renouncedCapabilities,proto3,enum=osmosis.tokenfactory.v1beta1.DenomCapability" json:"renounced_capabilities,omitempty" yaml:"renounced_capabilities"`
+	// delay of the sensitive admin actions over the denom
+	Timelock DenomTimelock `protobuf:"bytes,12,opt,name=timelock,proto3" json:"timelock" yaml:"timelock"`
+	// cap on the amount minted within a rolling window
+	MintRateLimit MintRateLimit `protobuf:"bytes,13,opt,name=mint_rate_limit,json=mintRateLimit,proto3" json:"mint_rate_limit" yaml:"mint_rate_limit"`
+	// loosened mint rate limit waiting to take effect, if any
+	PendingMintRateLimit *PendingMintRateLimit `protobuf:"bytes,14,opt,name=pending_mint_rate_limit,json=pendingMintRateLimit,proto3" json:"pending_mint_rate_limit,omitempty" yaml:"pending_mint_rate_limit"`
+	// amounts minted within the window of the mint rate limit
+	MintRecords []MintRecord `protobuf:"bytes,15,rep,name=mint_records,json=mintRecords,proto3" json:"mint_records" yaml:"mint_records"`
+	// creation fee escrowed by the module account until the denom is retired
+	CreationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=creation_deposit,json=creationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_deposit" yaml:"creation_deposit"`
+	// reference ids of mints and burns that have not expired yet
+	References []ReferenceRecord `protobuf:"bytes,17,rep,name=references,proto3" json:"references" yaml:"references"`
+	// height of the block the denom was created in
+	CreationHeight int64 `protobuf:"varint,18,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// time of the block the denom was created in
+	CreationTime time.Time `protobuf:"bytes,19,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time" yaml:"creation_time"`
+	// amounts ever minted, burned and force transferred
+	Stats DenomStats `protobuf:"bytes,20,opt,name=stats,proto3" json:"stats" yaml:"stats"`
+	// latest privileged actions performed on the denom, oldest first
+	AuditLog []AuditLogEntry `protobuf:"bytes,21,rep,name=audit_log,json=auditLog,proto3" json:"audit_log" yaml:"audit_log"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
 func (m *GenesisDenom) String() string { return proto.CompactTextString(m) }
 func (*GenesisDenom) ProtoMessage()    {}
 func (*GenesisDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5749c3f71850298b, []int{1}
+	return fileDescriptor_5749c3f71850298b, []int{2}
 }
 func (m *GenesisDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,8 +325,135 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetPendingAdmin() string {
+	if m != nil {
+		return m.PendingAdmin
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetMinterAllowances() []MinterAllowance {
+	if m != nil {
+		return m.MinterAllowances
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetRenouncedCapabilities() []DenomCapability {
+	if m != nil {
+		return m.RenouncedCapabilities
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetTimelock() DenomTimelock {
+	if m != nil {
+		return m.Timelock
+	}
+	return DenomTimelock{}
+}
+
+func (m *GenesisDenom) GetMintRateLimit() MintRateLimit {
+	if m != nil {
+		return m.MintRateLimit
+	}
+	return MintRateLimit{}
+}
+
+func (m *GenesisDenom) GetPendingMintRateLimit() *PendingMintRateLimit {
+	if m != nil {
+		return m.PendingMintRateLimit
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetMintRecords() []MintRecord {
+	if m != nil {
+		return m.MintRecords
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetCreationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationDeposit
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetReferences() []ReferenceRecord {
+	if m != nil {
+		return m.References
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *GenesisDenom) GetCreationTime() time.Time {
+	if m != nil {
+		return m.CreationTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisDenom) GetStats() DenomStats {
+	if m != nil {
+		return m.Stats
+	}
+	return DenomStats{}
+}
+
+func (m *GenesisDenom) GetAuditLog() []AuditLogEntry {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
+	proto.RegisterType((*RetiredDenomHistory)(nil), "osmosis.tokenfactory.v1beta1.RetiredDenomHistory")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
 }
 
@@ -142,32 +462,142 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9e, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x79, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x5e, 0x7e, 0x51, 0x66, 0xa2, 0x6e, 0x5e, 0x6a, 0x09, 0x24, 0x82, 0x75,
-	0x61, 0x31, 0x5c, 0x81, 0x1a, 0xe1, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x88, 0x36,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x31, 0x9b, 0x34, 0xab, 0x02, 0x00, 0x00,
+	// 1457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x4e, 0xb0, 0x27, 0xbf, 0x9c, 0x89, 0xd3, 0x6c, 0xd2, 0xd6, 0x76, 0xa7, 0xa8,
+	0x72, 0x5b, 0x62, 0xb7, 0xa1, 0x12, 0x52, 0x25, 0xa4, 0x66, 0xd3, 0x96, 0xb6, 0xb4, 0xa2, 0x4c,
+	0x2b, 0x0e, 0x08, 0x69, 0x19, 0x7b, 0x27, 0xf6, 0x28, 0xde, 0x1d, 0x6b, 0x67, 0x02, 0x31, 0xe2,
+	0x5c, 0x4e, 0x48, 0xe5, 0xce, 0x81, 0x0b, 0x1c, 0x38, 0xf3, 0x47, 0xf4, 0x58, 0x71, 0x42, 0x1c,
+	0x5c, 0xd4, 0x5e, 0x38, 0x71, 0xf0, 0x5f, 0x80, 0x76, 0x66, 0x76, 0xfd, 0x23, 0xee, 0xc6, 0x08,
+	0xf5, 0x94, 0xf8, 0xcd, 0xf7, 0xbe, 0xf7, 0xe6, 0xcd, 0x9b, 0xf7, 0xcd, 0x82, 0xcb, 0x5c, 0xf8,
+	0x5c, 0x30, 0x51, 0x93, 0xfc, 0x80, 0x06, 0xfb, 0xa4, 0x21, 0x79, 0xd8, 0xad, 0x7d, 0x75, 0xad,
+	0x4e, 0x25, 0xb9, 0x56, 0x6b, 0xd2, 0x80, 0x0a, 0x26, 0xaa, 0x9d, 0x90, 0x4b, 0x0e, 0xcf, 0x1a,
+	0x6c, 0x75, 0x18, 0x5b, 0x35, 0xd8, 0xad, 0x42, 0x93, 0x37, 0xb9, 0x02, 0xd6, 0xa2, 0xff, 0xb4,
+	0xcf, 0x56, 0xa9, 0xc9, 0x79, 0xb3, 0x4d, 0x6b, 0xea, 0x57, 0xfd, 0x70, 0xbf, 0x26, 0x99, 0x4f,
+	0x85, 0x24, 0x7e, 0xc7, 0x00, 0xae, 0xa4, 0x26, 0x40, 0x0e, 0x3d, 0x26, 0x1f, 0xf0, 0xa6, 0x01,
+	0x5f, 0x3f, 0x01, 0x2c, 0x5b, 0x3c, 0x64, 0xb2, 0xfb, 0x90, 0x4a, 0xe2, 0x11, 0x49, 0x8c, 0xd7,
+	0xd5, 0x54, 0xaf, 0x46, 0x48, 0x89, 0x64, 0x3c, 0x78, 0xc0, 0x7c, 0x26, 0x8d, 0xc7, 0x76, 0xaa,
+	0x87, 0x47, 0x03, 0xee, 0x3f, 0x96, 0x44, 0x9a, 0xc2, 0x6c, 0xed, 0xa4, 0xc2, 0x7d, 0x16, 0x48,
+	0x1a, 0xee, 0xb6, 0xdb, 0xfc, 0x6b, 0x12, 0x34, 0xe8, 0x54, 0x49, 0x45, 0x3e, 0x98, 0x48, 0x3a,
+	0x9c, 0xd4, 0xa5, 0x54, 0x8f, 0x0e, 0x09, 0x89, 0x1f, 0x27, 0xf4, 0x5e, 0x2a, 0x34, 0xa4, 0xfb,
+	0x34, 0xa4, 0x83, 0x54, 0xd2, 0x8f, 0x20, 0x3a, 0xb0, 0x36, 0x6f, 0x1c, 0x18, 0xf0, 0x66, 0x43,
+	0xa1, 0x5d, 0x7d, 0xd2, 0xfa, 0x87, 0x59, 0x2a, 0xea, 0x5f, 0xb5, 0x3a, 0x11, 0x74, 0x50, 0x5e,
+	0xce, 0x02, 0xbd, 0x8e, 0x7e, 0xc9, 0x82, 0xc5, 0x8f, 0x74, 0x47, 0x45, 0xd5, 0xa3, 0xd0, 0x01,
+	0xf3, 0x3a, 0x6d, 0xdb, 0x2a, 0x5b, 0x95, 0x85, 0x9d, 0x77, 0xab, 0x69, 0x1d, 0x56, 0x7d, 0xa4,
+	0xb0, 0x4e, 0xe6, 0x79, 0xaf, 0x34, 0x83, 0x8d, 0x27, 0xec, 0x80, 0x65, 0x83, 0x73, 0xd5, 0xb9,
+	0x08, 0xfb, 0x54, 0x79, 0xb6, 0xb2, 0xb0, 0x73, 0x39, 0x9d, 0xcb, 0xe4, 0x71, 0x2b, 0x72, 0x71,
+	0xce, 0x45, 0x8c, 0xfd, 0x5e, 0x69, 0xbd, 0x4b, 0xfc, 0xf6, 0x0d, 0x34, 0xca, 0x87, 0xf0, 0x92,
+	0x31, 0x28, 0xb0, 0x80, 0x9f, 0x81, 0xd3, 0x01, 0x3d, 0x92, 0x6e, 0x87, 0x06, 0x1e, 0x0b, 0x9a,
+	0x2e, 0x69, 0x44, 0xed, 0xe3, 0x32, 0xcf, 0x9e, 0x2b, 0x5b, 0x95, 0x8c, 0x73, 0xbe, 0xdf, 0x2b,
+	0x9d, 0xd3, 0x4c, 0x93, 0x71, 0x08, 0xaf, 0x45, 0x0b, 0x8f, 0xb4, 0x7d, 0x57, 0x99, 0xef, 0x79,
+	0x50, 0x82, 0x95, 0x51, 0xa8, 0xb0, 0xe7, 0xd5, 0x56, 0xae, 0x9c, 0x50, 0x96, 0x61, 0x1e, 0xa7,
+	0x68, 0xf6, 0x72, 0x5a, 0x67, 0x30, 0xc6, 0x88, 0xf0, 0x72, 0x67, 0x18, 0x2e, 0xe0, 0x53, 0x0b,
+	0x14, 0xd4, 0x15, 0xe0, 0xa1, 0xde, 0xb0, 0xdb, 0xe0, 0x87, 0x81, 0x14, 0xf6, 0x3b, 0x2a, 0x76,
+	0x2d, 0x3d, 0xf6, 0x9e, 0xf6, 0x54, 0x95, 0xd9, 0x8b, 0xfc, 0x9c, 0x0b, 0x26, 0xfe, 0x19, 0x1d,
+	0x7f, 0x12, 0x35, 0xc2, 0xb0, 0x31, 0xee, 0x27, 0xe0, 0x77, 0x16, 0x28, 0xd4, 0xa3, 0x46, 0x73,
+	0xe3, 0x1b, 0xa9, 0xe1, 0x76, 0x56, 0xf5, 0xc6, 0xd5, 0xf4, 0x44, 0x9c, 0xc8, 0x73, 0xcf, 0x38,
+	0x4e, 0xcc, 0x64, 0x12, 0x37, 0xc2, 0xb0, 0x7e, 0xcc, 0x11, 0xde, 0x04, 0xcb, 0x21, 0x95, 0x2c,
+	0xa4, 0x5e, 0xdc, 0x52, 0xb9, 0xf2, 0x6c, 0x25, 0xe7, 0x6c, 0x0e, 0x5a, 0x64, 0x74, 0x1d, 0xe1,
+	0x25, 0x63, 0x30, 0x2d, 0xf2, 0xa3, 0x05, 0x0a, 0x92, 0x4b, 0xd2, 0x76, 0xf7, 0x29, 0x15, 0x6e,
+	0x83, 0xb7, 0xdb, 0xb4, 0x21, 0xa9, 0x67, 0x03, 0x55, 0xd4, 0xcd, 0xaa, 0xb9, 0x37, 0xd1, 0x4d,
+	0x19, 0xd4, 0x92, 0xb3, 0xc0, 0xf9, 0x64, 0x34, 0xe9, 0x49, 0x24, 0xe8, 0xd7, 0x97, 0xa5, 0x4a,
+	0x93, 0xc9, 0xd6, 0x61, 0xbd, 0xda, 0xe0, 0xbe, 0xb9, 0x83, 0xe6, 0xcf, 0xb6, 0xf0, 0x0e, 0x6a,
+	0xb2, 0xdb, 0xa1, 0x42, 0xf1, 0x09, 0x0c, 0x15, 0xc5, 0x1d, 0x4a, 0xc5, 0x5e, 0x4c, 0x00, 0x7f,
+	0xb0, 0xc0, 0xc6, 0xc8, 0x0e, 0xdc, 0x16, 0x13, 0x92, 0x87, 0x8c, 0x0a, 0x7b, 0x41, 0x65, 0x78,
+	0x2d, 0xbd, 0xda, 0x78, 0x68, 0xb7, 0x77, 0x95, 0x6b, 0xd7, 0xb9, 0x68, 0x32, 0x2f, 0x4e, 0xa8,
+	0xd0, 0x80, 0x1f, 0xe1, 0xf5, 0xf0, 0x98, 0x33, 0xa3, 0xe2, 0x7e, 0x26, 0x3b, 0x9b, 0xcf, 0xdc,
+	0xcf, 0x64, 0x33, 0xf9, 0x39, 0xf4, 0xf3, 0x2c, 0x58, 0x9b, 0x10, 0x02, 0x5e, 0x04, 0x73, 0x8a,
+	0x4e, 0x8d, 0x8b, 0x9c, 0x93, 0xef, 0xf7, 0x4a, 0x8b, 0x3a, 0x9a, 0x32, 0x23, 0xac, 0x97, 0xe1,
+	0x1e, 0x58, 0x49, 0xce, 0xb9, 0x45, 0x59, 0xb3, 0x25, 0xed, 0x53, 0x65, 0xab, 0x32, 0xeb, 0x6c,
+	0x0d, 0x2e, 0xc6, 0x18, 0x00, 0xe1, 0xe5, 0xd8, 0x72, 0x57, 0x19, 0x20, 0x01, 0x4b, 0x09, 0x26,
+	0x9a, 0x81, 0xf6, 0xac, 0xea, 0xc3, 0xad, 0xaa, 0x56, 0xb4, 0x6a, 0xac, 0x68, 0xd5, 0x27, 0xb1,
+	0xa2, 0x39, 0x65, 0x53, 0x82, 0xc2, 0x58, 0x88, 0xc8, 0x1d, 0x3d, 0x7b, 0x59, 0xb2, 0xf0, 0x62,
+	0x6c, 0x8b, 0x9c, 0xe0, 0x13, 0x30, 0x27, 0x24, 0x91, 0xc2, 0xce, 0x28, 0xea, 0x4a, 0x7a, 0xd1,
+	0x6f, 0x25, 0xb2, 0xe3, 0x14, 0x4c, 0x20, 0xb3, 0x7b, 0x45, 0x82, 0xb0, 0x26, 0x83, 0x75, 0x90,
+	0x53, 0xb2, 0xe9, 0xb6, 0x79, 0xd3, 0x9e, 0x9b, 0x66, 0x82, 0xec, 0x1a, 0x95, 0xbd, 0x1d, 0xc8,
+	0xb0, 0xeb, 0xd8, 0x86, 0x3c, 0xaf, 0xc9, 0x13, 0x2e, 0x84, 0xb3, 0xb1, 0x1c, 0xdf, 0xc8, 0xfc,
+	0xfd, 0x53, 0xc9, 0x42, 0xff, 0xac, 0x24, 0x03, 0x5d, 0x25, 0x37, 0xf5, 0x01, 0x3d, 0xb5, 0x00,
+	0x4c, 0xd4, 0xda, 0xf5, 0x8d, 0x5c, 0xab, 0x43, 0x5a, 0xd8, 0xb9, 0x3e, 0x45, 0x19, 0x76, 0xc7,
+	0xa5, 0xde, 0x39, 0x6f, 0xb2, 0xde, 0x8c, 0xb3, 0x1e, 0x67, 0x47, 0x78, 0xf5, 0xd8, 0x03, 0x01,
+	0x7e, 0x08, 0x96, 0x92, 0x09, 0xe9, 0xf9, 0x2c, 0x50, 0x87, 0x9c, 0x73, 0xec, 0xc1, 0x21, 0x8e,
+	0x2c, 0x23, 0xbc, 0x18, 0x8f, 0xcf, 0xe8, 0x27, 0xfc, 0x16, 0xac, 0x6a, 0x75, 0x77, 0x49, 0x2c,
+	0xef, 0xd1, 0x61, 0x46, 0x25, 0xdf, 0x4e, 0xdf, 0xc5, 0xc3, 0xd1, 0x47, 0x41, 0xd2, 0x3a, 0xb6,
+	0x8e, 0x7a, 0x8c, 0x15, 0xe1, 0xfc, 0xd8, 0x3b, 0x42, 0x40, 0x17, 0x00, 0x9f, 0x1c, 0xb9, 0xe2,
+	0xb0, 0xd3, 0x69, 0x77, 0x95, 0xf8, 0xe4, 0x9c, 0x9b, 0x11, 0xcf, 0x9f, 0xbd, 0xd2, 0xba, 0x1e,
+	0x07, 0xc2, 0x3b, 0xa8, 0x32, 0x5e, 0xf3, 0x89, 0x6c, 0x55, 0xef, 0x05, 0xb2, 0xdf, 0x2b, 0xad,
+	0x9a, 0x00, 0x89, 0x23, 0xfa, 0xfd, 0xb7, 0x6d, 0xa0, 0xd1, 0x11, 0x04, 0xe7, 0x7c, 0x72, 0xf4,
+	0x58, 0xad, 0xc0, 0x4b, 0x91, 0x3e, 0x1f, 0x0a, 0xea, 0xd9, 0xf3, 0x65, 0xab, 0x92, 0x75, 0x56,
+	0xfb, 0xbd, 0xd2, 0x92, 0x29, 0x8b, 0xb2, 0x23, 0x6c, 0x00, 0xf0, 0x0e, 0xc8, 0xef, 0x87, 0xfc,
+	0x1b, 0x1a, 0xb8, 0xc4, 0xf3, 0x42, 0x2a, 0x04, 0xd5, 0x0a, 0x92, 0x73, 0xce, 0xf4, 0x7b, 0xa5,
+	0x0d, 0x23, 0xac, 0x63, 0x08, 0x84, 0x57, 0xb4, 0x69, 0x37, 0xb6, 0xc0, 0x7b, 0x60, 0x55, 0x6d,
+	0xba, 0xcd, 0x84, 0x74, 0x69, 0x40, 0xea, 0x6d, 0xea, 0x29, 0x05, 0xc8, 0x3a, 0x67, 0x07, 0xe5,
+	0x39, 0x06, 0x41, 0x38, 0x9f, 0xd8, 0x6e, 0x6b, 0x13, 0xdc, 0x01, 0xb9, 0xc4, 0x66, 0x26, 0x78,
+	0x61, 0xa8, 0xad, 0xe3, 0x25, 0x84, 0x07, 0x30, 0xf8, 0x05, 0xb0, 0xeb, 0x74, 0x9f, 0x87, 0xd4,
+	0x15, 0x34, 0xf0, 0xdc, 0x16, 0xe7, 0x07, 0x71, 0xba, 0x36, 0x50, 0x05, 0xbe, 0xd0, 0xef, 0x95,
+	0x4a, 0x46, 0x51, 0xde, 0x80, 0x44, 0x78, 0x5d, 0x2f, 0x3d, 0xa6, 0x81, 0x77, 0x97, 0xf3, 0x03,
+	0xb3, 0xbd, 0x48, 0xe2, 0x4e, 0x87, 0x34, 0xe0, 0x87, 0x41, 0x83, 0x7a, 0x6e, 0x83, 0x74, 0x48,
+	0x9d, 0xb5, 0x99, 0x8c, 0xc7, 0xee, 0xf2, 0xce, 0xf6, 0x14, 0xad, 0xbf, 0x17, 0xbb, 0x75, 0x87,
+	0x5f, 0x1a, 0x93, 0x69, 0xd5, 0xb4, 0x35, 0x0b, 0x7b, 0x43, 0x76, 0xf8, 0x25, 0xc8, 0xc6, 0xef,
+	0x3a, 0x7b, 0xb1, 0x6c, 0x9d, 0x3c, 0x22, 0x54, 0xe8, 0x27, 0xc6, 0xc5, 0xd9, 0x30, 0xdd, 0xba,
+	0xa2, 0x83, 0xc7, 0x54, 0x08, 0x27, 0xac, 0x50, 0x80, 0x95, 0xa8, 0x61, 0xdd, 0x90, 0x48, 0xea,
+	0xb6, 0x99, 0xcf, 0xa4, 0xbd, 0x34, 0x4d, 0xa0, 0x87, 0xc3, 0x2f, 0xdf, 0xf1, 0xd7, 0xcc, 0x18,
+	0x23, 0xc2, 0x4b, 0x23, 0x0f, 0x65, 0xf8, 0xbd, 0x05, 0x36, 0xe2, 0x0b, 0x3b, 0x1e, 0x7d, 0x59,
+	0x45, 0xdf, 0x99, 0xea, 0x2d, 0x35, 0x9a, 0x04, 0x1a, 0xa8, 0xda, 0x1b, 0xc8, 0x11, 0x2e, 0x74,
+	0x26, 0x78, 0xc2, 0x16, 0x58, 0xd4, 0x48, 0xda, 0xe0, 0xa1, 0x27, 0xec, 0x95, 0xf2, 0xec, 0xc9,
+	0x73, 0x5e, 0x51, 0x28, 0x07, 0xe7, 0x8c, 0xd9, 0xfe, 0xda, 0xf0, 0xf6, 0x35, 0x17, 0xc2, 0x0b,
+	0x7e, 0x02, 0x14, 0x91, 0xa4, 0xe7, 0x13, 0xbd, 0xf1, 0x68, 0x87, 0x0b, 0x26, 0xed, 0xfc, 0x49,
+	0xaf, 0x8d, 0x8f, 0x0d, 0xff, 0xc6, 0x98, 0x60, 0x19, 0x82, 0xff, 0xf6, 0xd2, 0x48, 0x34, 0xf7,
+	0x96, 0xf6, 0x86, 0x2d, 0x00, 0x92, 0x4f, 0x0d, 0x61, 0xaf, 0x4e, 0x33, 0x16, 0x71, 0x8c, 0x37,
+	0x05, 0xd8, 0x34, 0x09, 0xae, 0xc6, 0x5d, 0x1e, 0xd3, 0x21, 0x3c, 0xc4, 0x3d, 0x49, 0xf0, 0xe1,
+	0xff, 0x17, 0xfc, 0xb5, 0xb7, 0x27, 0xf8, 0x85, 0xb7, 0x26, 0xf8, 0xeb, 0x6f, 0x51, 0xf0, 0x9d,
+	0x4f, 0x9f, 0xbf, 0x2a, 0x5a, 0x2f, 0x5e, 0x15, 0xad, 0xbf, 0x5e, 0x15, 0xad, 0x67, 0xaf, 0x8b,
+	0x33, 0x2f, 0x5e, 0x17, 0x67, 0xfe, 0x78, 0x5d, 0x9c, 0xf9, 0xfc, 0x83, 0xa1, 0x36, 0x09, 0x78,
+	0xc8, 0xc8, 0x76, 0x40, 0xa5, 0xfe, 0xa0, 0xdc, 0x8e, 0xbf, 0x28, 0x8f, 0x46, 0x3f, 0x30, 0x55,
+	0xef, 0xd4, 0xe7, 0x55, 0x59, 0xdf, 0xff, 0x77, 0x00, 0xc1, 0xf6, 0x8b, 0x9b, 0x89, 0x10, 0x00,
+	0x00,
 }
 
+func (this *RetiredDenomHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RetiredDenomHistory)
+	if !ok {
+		that2, ok := that.(RetiredDenomHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CreationTime.Equal(that1.CreationTime) {
+		return false
+	}
+	if !this.Stats.Equal(&that1.Stats) {
+		return false
+	}
+	if len(this.AuditLog) != len(that1.AuditLog) {
+		return false
+	}
+	for i := range this.AuditLog {
+		if !this.AuditLog[i].Equal(&that1.AuditLog[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GenesisDenom) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -193,6 +623,103 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if this.PendingAdmin != that1.PendingAdmin {
+		return false
+	}
+	if len(this.MinterAllowances) != len(that1.MinterAllowances) {
+		return false
+	}
+	for i := range this.MinterAllowances {
+		if !this.MinterAllowances[i].Equal(&that1.MinterAllowances[i]) {
+			return false
+		}
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
+	if this.AllowlistEnabled != that1.AllowlistEnabled {
+		return false
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if this.Allowlist[i] != that1.Allowlist[i] {
+			return false
+		}
+	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
+	if len(this.RenouncedCapabilities) != len(that1.RenouncedCapabilities) {
+		return false
+	}
+	for i := range this.RenouncedCapabilities {
+		if this.RenouncedCapabilities[i] != that1.RenouncedCapabilities[i] {
+			return false
+		}
+	}
+	if !this.Timelock.Equal(&that1.Timelock) {
+		return false
+	}
+	if !this.MintRateLimit.Equal(&that1.MintRateLimit) {
+		return false
+	}
+	if !this.PendingMintRateLimit.Equal(that1.PendingMintRateLimit) {
+		return false
+	}
+	if len(this.MintRecords) != len(that1.MintRecords) {
+		return false
+	}
+	for i := range this.MintRecords {
+		if !this.MintRecords[i].Equal(&that1.MintRecords[i]) {
+			return false
+		}
+	}
+	if len(this.CreationDeposit) != len(that1.CreationDeposit) {
+		return false
+	}
+	for i := range this.CreationDeposit {
+		if !this.CreationDeposit[i].Equal(&that1.CreationDeposit[i]) {
+			return false
+		}
+	}
+	if len(this.References) != len(that1.References) {
+		return false
+	}
+	for i := range this.References {
+		if !this.References[i].Equal(&that1.References[i]) {
+			return false
+		}
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CreationTime.Equal(that1.CreationTime) {
+		return false
+	}
+	if !this.Stats.Equal(&that1.Stats) {
+		return false
+	}
+	if len(this.AuditLog) != len(that1.AuditLog) {
+		return false
+	}
+	for i := range this.AuditLog {
+		if !this.AuditLog[i].Equal(&that1.AuditLog[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -215,6 +742,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetiredDenomHistories) > 0 {
+		for iNdEx := len(m.RetiredDenomHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetiredDenomHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TotalFeesCollected) > 0 {
+		for iNdEx := len(m.TotalFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RetiredDenoms) > 0 {
+		for iNdEx := len(m.RetiredDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetiredDenoms[iNdEx])
+			copy(dAtA[i:], m.RetiredDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RetiredDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.BlockCreationCount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.CreatorDenomCounts) > 0 {
+		for iNdEx := len(m.CreatorDenomCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorDenomCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingActions) > 0 {
+		for iNdEx := len(m.PendingActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextPendingActionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPendingActionId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.FactoryDenoms) > 0 {
 		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -242,6 +849,73 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetiredDenomHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetiredDenomHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetiredDenomHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.CreationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +936,223 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.CreationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.References) > 0 {
+		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.References[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.CreationDeposit) > 0 {
+		for iNdEx := len(m.CreationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MintRecords) > 0 {
+		for iNdEx := len(m.MintRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.PendingMintRateLimit != nil {
+		{
+			size, err := m.PendingMintRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size, err := m.MintRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.Timelock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.RenouncedCapabilities) > 0 {
+		dAtA11 := make([]byte, len(m.RenouncedCapabilities)*10)
+		var j10 int
+		for _, num := range m.RenouncedCapabilities {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGenesis(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MinterAllowances) > 0 {
+		for iNdEx := len(m.MinterAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -307,10 +1198,45 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.NextPendingActionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPendingActionId))
+	}
+	if len(m.PendingActions) > 0 {
+		for _, e := range m.PendingActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreatorDenomCounts) > 0 {
+		for _, e := range m.CreatorDenomCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BlockCreationCount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RetiredDenoms) > 0 {
+		for _, s := range m.RetiredDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TotalFeesCollected) > 0 {
+		for _, e := range m.TotalFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetiredDenomHistories) > 0 {
+		for _, e := range m.RetiredDenomHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GenesisDenom) Size() (n int) {
+func (m *RetiredDenomHistory) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -320,16 +1246,122 @@ func (m *GenesisDenom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.AuthorityMetadata.Size()
+	if m.CreationHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GenesisDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinterAllowances) > 0 {
+		for _, e := range m.MinterAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RenouncedCapabilities) > 0 {
+		l = 0
+		for _, e := range m.RenouncedCapabilities {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	l = m.Timelock.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MintRateLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.PendingMintRateLimit != nil {
+		l = m.PendingMintRateLimit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MintRecords) > 0 {
+		for _, e := range m.MintRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreationDeposit) > 0 {
+		for _, e := range m.CreationDeposit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.References) > 0 {
+		for _, e := range m.References {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CreationHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreationTime)
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.Stats.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -341,28 +1373,936 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, GenesisDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPendingActionId", wireType)
+			}
+			m.NextPendingActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPendingActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActions = append(m.PendingActions, PendingAction{})
+			if err := m.PendingActions[len(m.PendingActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDenomCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorDenomCounts = append(m.CreatorDenomCounts, CreatorDenomCount{})
+			if err := m.CreatorDenomCounts[len(m.CreatorDenomCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCreationCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockCreationCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredDenoms = append(m.RetiredDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFeesCollected = append(m.TotalFeesCollected, types.Coin{})
+			if err := m.TotalFeesCollected[len(m.TotalFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredDenomHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredDenomHistories = append(m.RetiredDenomHistories, RetiredDenomHistory{})
+			if err := m.RetiredDenomHistories[len(m.RetiredDenomHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetiredDenomHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetiredDenomHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetiredDenomHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, AuditLogEntry{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAllowances = append(m.MinterAllowances, MinterAllowance{})
+			if err := m.MinterAllowances[len(m.MinterAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType == 0 {
+				var v DenomCapability
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= DenomCapability(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RenouncedCapabilities = append(m.RenouncedCapabilities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RenouncedCapabilities) == 0 {
+					m.RenouncedCapabilities = make([]DenomCapability, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v DenomCapability
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= DenomCapability(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RenouncedCapabilities = append(m.RenouncedCapabilities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenouncedCapabilities", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -389,13 +2329,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timelock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -422,66 +2362,119 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FactoryDenoms = append(m.FactoryDenoms, GenesisDenom{})
-			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMintRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingMintRateLimit == nil {
+				m.PendingMintRateLimit = &PendingMintRateLimit{}
+			}
+			if err := m.PendingMintRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.MintRecords = append(m.MintRecords, MintRecord{})
+			if err := m.MintRecords[len(m.MintRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CreationDeposit = append(m.CreationDeposit, types.Coin{})
+			if err := m.CreationDeposit[len(m.CreationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisDenom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisDenom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -491,27 +2484,48 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.References = append(m.References, ReferenceRecord{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -538,7 +2552,74 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, AuditLogEntry{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
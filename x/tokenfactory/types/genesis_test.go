@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
 
@@ -86,6 +89,662 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "with roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:   "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							Minters: []string{"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+							Burners: []string{"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid role member",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:            "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							MetadataManagers: []string{"moose"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate role member",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:             "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							ForceTransferrers: []string{"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh", "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "with pending admin",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						PendingAdmin: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid pending admin",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						PendingAdmin: "moose",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "with minter allowances",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MinterAllowances: []types.MinterAllowance{
+							types.NewMinterAllowance("cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", sdk.NewInt(100), sdk.NewInt(10), time.Hour, time.Unix(0, 0)),
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "remaining above minter allowance",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MinterAllowances: []types.MinterAllowance{
+							{
+								Minter:          "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+								Allowance:       sdk.NewInt(100),
+								Remaining:       sdk.NewInt(101),
+								ReplenishAmount: sdk.ZeroInt(),
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate minter allowances",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MinterAllowances: []types.MinterAllowance{
+							types.NewMinterAllowance("cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", sdk.NewInt(100), sdk.ZeroInt(), 0, time.Unix(0, 0)),
+							types.NewMinterAllowance("cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", sdk.NewInt(50), sdk.ZeroInt(), 0, time.Unix(0, 0)),
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "with max supply",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MaxSupply: sdk.NewInt(21_000_000),
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "negative max supply",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MaxSupply: sdk.NewInt(-1),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "frozen addresses",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						FrozenAddresses: []string{"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate frozen addresses",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						FrozenAddresses: []string{
+							"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+							"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowlist",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						AllowlistEnabled: true,
+						Allowlist:        []string{"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "allowlist without allowlist mode",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Allowlist: []string{"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allowed address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						AllowlistEnabled: true,
+						Allowlist:        []string{"invalid"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid before send hook address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						BeforeSendHookAddress: "invalid",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate renounced capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						RenouncedCapabilities: []types.DenomCapability{types.CapabilityMintable, types.CapabilityMintable},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "timelocked denom with pending action",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Timelock: types.NewDenomTimelock(48*time.Hour, sdk.NewInt(1000)),
+					},
+				},
+				NextPendingActionId: 2,
+				PendingActions: []types.PendingAction{
+					{
+						Id:    1,
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						Action: &types.PendingAction_ProposeAdmin{ProposeAdmin: types.NewMsgProposeAdmin(
+							"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+							"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						)},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "negative timelock delay",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Timelock: types.NewDenomTimelock(-time.Hour, sdk.ZeroInt()),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending action id not below the next id",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+					},
+				},
+				NextPendingActionId: 1,
+				PendingActions: []types.PendingAction{
+					{
+						Id:    1,
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						Action: &types.PendingAction_ProposeAdmin{ProposeAdmin: types.NewMsgProposeAdmin(
+							"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+							"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending action of another denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+					},
+				},
+				NextPendingActionId: 2,
+				PendingActions: []types.PendingAction{
+					{
+						Id:    1,
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						Action: &types.PendingAction_ProposeAdmin{ProposeAdmin: types.NewMsgProposeAdmin(
+							"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+							"cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "mint rate limit with a window in blocks and in time",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MintRateLimit: types.MintRateLimit{Limit: sdk.NewInt(1000), WindowBlocks: 100, WindowDuration: time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate mint records",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						MintRateLimit: types.NewBlockMintRateLimit(sdk.NewInt(1000), 100),
+						MintRecords: []types.MintRecord{
+							{Height: 1, Amount: sdk.NewInt(10)},
+							{Height: 1, Amount: sdk.NewInt(20)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "creation deposit",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						CreationDeposit: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)),
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid creation deposit",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						CreationDeposit: sdk.Coins{sdk.Coin{Denom: "uosmo", Amount: sdk.NewInt(-100)}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "references",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						References: []types.ReferenceRecord{
+							{ReferenceId: "payroll-1", Action: types.TypeMsgMint, TxHash: "AB12", Height: 10, ExpireTime: time.Unix(1000, 0)},
+							{ReferenceId: "payroll-2", Action: types.TypeMsgBurn, TxHash: "AB12", Height: 10, ExpireTime: time.Unix(1000, 0)},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate reference id",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						References: []types.ReferenceRecord{
+							{ReferenceId: "payroll-1", Action: types.TypeMsgMint, TxHash: "AB12", Height: 10, ExpireTime: time.Unix(1000, 0)},
+							{ReferenceId: "payroll-1", Action: types.TypeMsgBurn, TxHash: "AB12", Height: 10, ExpireTime: time.Unix(1000, 0)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reference action",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						References: []types.ReferenceRecord{
+							{ReferenceId: "payroll-1", Action: types.TypeMsgForceTransfer, TxHash: "AB12", Height: 10, ExpireTime: time.Unix(1000, 0)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative creation height",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						CreationHeight: -1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "audit log",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						AuditLog: []types.AuditLogEntry{
+							{Sequence: 4, Action: types.TypeMsgMint, Actor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Counterparties: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"}, Amount: sdk.NewInt(100), Height: 10, Time: time.Unix(1000, 0), TxHash: "AB12"},
+							{Sequence: 5, Action: types.TypeMsgMint, Actor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Counterparties: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"}, Amount: sdk.NewInt(100), Height: 10, Time: time.Unix(1000, 0), TxHash: "AB12"},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "audit log out of order",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						AuditLog: []types.AuditLogEntry{
+							{Sequence: 5, Action: types.TypeMsgMint, Actor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Counterparties: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"}, Amount: sdk.NewInt(100), Height: 10, Time: time.Unix(1000, 0), TxHash: "AB12"},
+							{Sequence: 4, Action: types.TypeMsgMint, Actor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Counterparties: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"}, Amount: sdk.NewInt(100), Height: 10, Time: time.Unix(1000, 0), TxHash: "AB12"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid audit log counterparty",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						AuditLog: []types.AuditLogEntry{
+							{Sequence: 4, Action: types.TypeMsgMint, Actor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Counterparties: []string{"bitcoin"}, Amount: sdk.NewInt(100), Height: 10, Time: time.Unix(1000, 0), TxHash: "AB12"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "retired denoms",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+					},
+				},
+				RetiredDenoms: []string{"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin"},
+			},
+			valid: true,
+		},
+		{
+			desc: "retired denom still a factory denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+					},
+				},
+				RetiredDenoms: []string{"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin"},
+			},
+			valid: false,
+		},
+		{
+			desc: "retired denom histories",
+			genState: &types.GenesisState{
+				RetiredDenoms: []string{"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin"},
+				RetiredDenomHistories: []types.RetiredDenomHistory{
+					{
+						Denom:          "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+						CreationHeight: 1,
+						Stats:          types.NewDenomStats(),
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "history of a denom that was not retired",
+			genState: &types.GenesisState{
+				RetiredDenomHistories: []types.RetiredDenomHistory{
+					{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate retired denom histories",
+			genState: &types.GenesisState{
+				RetiredDenoms: []string{"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin"},
+				RetiredDenomHistories: []types.RetiredDenomHistory{
+					{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin"},
+					{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate retired denoms",
+			genState: &types.GenesisState{
+				RetiredDenoms: []string{
+					"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+					"factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "creation counters",
+			genState: &types.GenesisState{
+				CreatorDenomCounts: []types.CreatorDenomCount{
+					{Creator: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Count: 2},
+					{Creator: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", Count: 1},
+				},
+				BlockCreationCount: types.BlockCreationCount{Height: 10, Count: 2},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate creator denom counts",
+			genState: &types.GenesisState{
+				CreatorDenomCounts: []types.CreatorDenomCount{
+					{Creator: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Count: 2},
+					{Creator: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Count: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid creator denom count address",
+			genState: &types.GenesisState{
+				CreatorDenomCounts: []types.CreatorDenomCount{
+					{Creator: "invalid", Count: 2},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "multiple denoms",
 			genState: &types.GenesisState{
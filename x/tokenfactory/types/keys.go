@@ -1,7 +1,11 @@
 package types
 
 import (
+	"fmt"
 	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -25,10 +29,39 @@ const (
 const KeySeparator = "|"
 
 var (
-	DenomAuthorityMetadataKey = "authoritymetadata"
-	DenomsPrefixKey           = "denoms"
-	CreatorPrefixKey          = "creator"
-	AdminPrefixKey            = "admin"
+	DenomAuthorityMetadataKey    = "authoritymetadata"
+	DenomPendingAdminKey         = "pendingadmin"
+	MinterAllowancePrefixKey     = "minterallowance"
+	DenomMaxSupplyKey            = "maxsupply"
+	DenomPausedKey               = "paused"
+	FrozenAddressPrefixKey       = "frozen"
+	DenomAllowlistEnabledKey     = "allowlistenabled"
+	AllowlistPrefixKey           = "allowlist"
+	DenomBeforeSendHookKey       = "beforesendhook"
+	RenouncedCapabilityPrefixKey = "renounced"
+	DenomTimelockKey             = "timelock"
+	PendingActionPrefixKey       = "pendingaction"
+	DenomMintRateLimitKey        = "mintratelimit"
+	DenomPendingMintRateLimitKey = "pendingmintratelimit"
+	MintRecordPrefixKey          = "mintrecord"
+	ReferencePrefixKey           = "reference"
+	DenomCreationDepositKey      = "creationdeposit"
+	DenomCreationHeightKey       = "creationheight"
+	DenomCreationTimeKey         = "creationtime"
+	DenomStatsKey                = "stats"
+	AuditLogPrefixKey            = "auditlog"
+	DenomNextAuditLogSequenceKey = "nextauditlogsequence"
+	DenomsPrefixKey              = "denoms"
+	CreatorPrefixKey             = "creator"
+	AdminPrefixKey               = "admin"
+	TimelockQueuePrefixKey       = "timelockqueue"
+	ReferenceQueuePrefixKey      = "referencequeue"
+	NextPendingActionIDKey       = "nextpendingactionid"
+	ParamsKey                    = "params"
+	CreatorDenomCountPrefixKey   = "creatordenomcount"
+	BlockCreationCountKey        = "blockcreationcount"
+	RetiredDenomPrefixKey        = "retireddenom"
+	ModuleSummaryKey             = "modulesummary"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -37,13 +70,153 @@ func GetDenomPrefixStore(denom string) []byte {
 	return []byte(strings.Join([]string{DenomsPrefixKey, denom, ""}, KeySeparator))
 }
 
+// GetMinterAllowancesPrefix returns the prefix, within the denom prefix store, where the
+// allowances of the delegated minters of the denom are stored
+func GetMinterAllowancesPrefix() []byte {
+	return []byte(strings.Join([]string{MinterAllowancePrefixKey, ""}, KeySeparator))
+}
+
+// GetMinterAllowanceKey returns the key, within the denom prefix store, where the allowance
+// of a specific minter is stored
+func GetMinterAllowanceKey(minter string) []byte {
+	return []byte(strings.Join([]string{MinterAllowancePrefixKey, minter}, KeySeparator))
+}
+
+// GetFrozenAddressesPrefix returns the prefix, within the denom prefix store, where the
+// frozen addresses of the denom are stored
+func GetFrozenAddressesPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, ""}, KeySeparator))
+}
+
+// GetFrozenAddressKey returns the key, within the denom prefix store, marking an address
+// as frozen
+func GetFrozenAddressKey(address string) []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, address}, KeySeparator))
+}
+
+// GetAllowlistPrefix returns the prefix, within the denom prefix store, where the addresses
+// allowed to receive the denom are stored
+func GetAllowlistPrefix() []byte {
+	return []byte(strings.Join([]string{AllowlistPrefixKey, ""}, KeySeparator))
+}
+
+// GetAllowlistKey returns the key, within the denom prefix store, marking an address as
+// allowed to receive the denom
+func GetAllowlistKey(address string) []byte {
+	return []byte(strings.Join([]string{AllowlistPrefixKey, address}, KeySeparator))
+}
+
+// GetRenouncedCapabilityKey returns the key, within the denom prefix store, marking a
+// capability as renounced
+func GetRenouncedCapabilityKey(capability DenomCapability) []byte {
+	return []byte(strings.Join([]string{RenouncedCapabilityPrefixKey, capability.ShortName()}, KeySeparator))
+}
+
+// GetPendingActionsPrefix returns the prefix, within the denom prefix store, where the
+// timelocked actions of the denom are stored
+func GetPendingActionsPrefix() []byte {
+	return []byte(strings.Join([]string{PendingActionPrefixKey, ""}, KeySeparator))
+}
+
+// GetPendingActionKey returns the key, within the denom prefix store, where a timelocked
+// action is stored
+func GetPendingActionKey(id uint64) []byte {
+	return []byte(strings.Join([]string{PendingActionPrefixKey, string(sdk.Uint64ToBigEndian(id))}, KeySeparator))
+}
+
+// GetMintRecordsPrefix returns the prefix, within the denom prefix store, where the amounts
+// minted within the window of the mint rate limit are stored
+func GetMintRecordsPrefix() []byte {
+	return []byte(strings.Join([]string{MintRecordPrefixKey, ""}, KeySeparator))
+}
+
+// GetMintRecordKey returns the key, within the denom prefix store, where the amount minted
+// at a specific height is stored
+func GetMintRecordKey(height int64) []byte {
+	return []byte(strings.Join([]string{MintRecordPrefixKey, string(sdk.Uint64ToBigEndian(uint64(height)))}, KeySeparator))
+}
+
+// GetAuditLogPrefix returns the prefix, within the denom prefix store, where the audit log of the
+// denom is stored
+func GetAuditLogPrefix() []byte {
+	return []byte(strings.Join([]string{AuditLogPrefixKey, ""}, KeySeparator))
+}
+
+// GetAuditLogKey returns the key, within the denom prefix store, where an entry of the audit log
+// is stored
+func GetAuditLogKey(sequence uint64) []byte {
+	return []byte(strings.Join([]string{AuditLogPrefixKey, string(sdk.Uint64ToBigEndian(sequence))}, KeySeparator))
+}
+
+// GetTimelockQueuePrefix returns the store prefix where the timelocked actions of all the
+// denoms are indexed by ready time
+func GetTimelockQueuePrefix() []byte {
+	return []byte(strings.Join([]string{TimelockQueuePrefixKey, ""}, KeySeparator))
+}
+
+// GetTimelockQueueKey returns the key, within the timelock queue, indexing a timelocked action
+func GetTimelockQueueKey(readyTime time.Time, id uint64) []byte {
+	return []byte(strings.Join([]string{string(sdk.FormatTimeBytes(readyTime)), string(sdk.Uint64ToBigEndian(id))}, KeySeparator))
+}
+
+// GetReferencesPrefix returns the prefix, within the denom prefix store, where the reference ids
+// of the mints and burns of the denom are stored
+func GetReferencesPrefix() []byte {
+	return []byte(strings.Join([]string{ReferencePrefixKey, ""}, KeySeparator))
+}
+
+// GetReferenceKey returns the key, within the denom prefix store, where the record of a reference
+// id is stored
+func GetReferenceKey(referenceID string) []byte {
+	return []byte(strings.Join([]string{ReferencePrefixKey, referenceID}, KeySeparator))
+}
+
+// GetReferenceQueuePrefix returns the store prefix where the reference ids of all the denoms are
+// indexed by expiry time
+func GetReferenceQueuePrefix() []byte {
+	return []byte(strings.Join([]string{ReferenceQueuePrefixKey, ""}, KeySeparator))
+}
+
+// GetReferenceQueueKey returns the key, within the reference queue, indexing the reference id
+// of a denom
+func GetReferenceQueueKey(expireTime time.Time, denom, referenceID string) []byte {
+	return []byte(strings.Join([]string{string(sdk.FormatTimeBytes(expireTime)), denom, referenceID}, KeySeparator))
+}
+
+// ParseReferenceQueueKey returns the denom and reference id indexed by a key of the reference
+// queue
+func ParseReferenceQueueKey(key []byte) (denom, referenceID string, err error) {
+	parts := strings.Split(string(key), KeySeparator)
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("invalid reference queue key: %s", key)
+	}
+	return parts[1], parts[2], nil
+}
+
+// GetCreatorDenomCountsPrefix returns the store prefix where the number of denoms created by
+// each creator is stored
+func GetCreatorDenomCountsPrefix() []byte {
+	return []byte(strings.Join([]string{CreatorDenomCountPrefixKey, ""}, KeySeparator))
+}
+
 // GetCreatorsPrefix returns the store prefix where the list of the denoms created by a specific
 // creator are stored
 func GetCreatorPrefix(creator string) []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, creator, ""}, KeySeparator))
 }
 
+// GetAdminPrefix returns the store prefix where the list of the denoms administered by a
+// specific admin are stored
+func GetAdminPrefix(admin string) []byte {
+	return []byte(strings.Join([]string{AdminPrefixKey, admin, ""}, KeySeparator))
+}
+
 // GetCreatorsPrefix returns the store prefix where a list of all creator addresses are stored
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetRetiredDenomsPrefix returns the store prefix where the retired denoms are stored
+func GetRetiredDenomsPrefix() []byte {
+	return []byte(strings.Join([]string{RetiredDenomPrefixKey, ""}, KeySeparator))
+}
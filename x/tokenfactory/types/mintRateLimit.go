@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// NewBlockMintRateLimit returns a rate limit capping the amount minted within any window of
+// windowBlocks blocks
+func NewBlockMintRateLimit(limit math.Int, windowBlocks uint64) MintRateLimit {
+	return MintRateLimit{
+		Limit:        limit,
+		WindowBlocks: windowBlocks,
+	}
+}
+
+// NewTimeMintRateLimit returns a rate limit capping the amount minted within any window of
+// windowDuration
+func NewTimeMintRateLimit(limit math.Int, windowDuration time.Duration) MintRateLimit {
+	return MintRateLimit{
+		Limit:          limit,
+		WindowDuration: windowDuration,
+	}
+}
+
+func (l MintRateLimit) Validate() error {
+	if l.Limit.IsNil() || l.Limit.IsNegative() {
+		return fmt.Errorf("invalid limit: %s", l.Limit)
+	}
+
+	if l.WindowDuration < 0 {
+		return fmt.Errorf("invalid window duration: %s", l.WindowDuration)
+	}
+
+	if l.Limit.IsZero() {
+		return nil
+	}
+
+	if (l.WindowBlocks > 0) == (l.WindowDuration > 0) {
+		return fmt.Errorf("the window must be set either in blocks or in time")
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the mints are rate limited
+func (l MintRateLimit) IsEnabled() bool {
+	return !l.Limit.IsNil() && l.Limit.IsPositive()
+}
+
+// Loosens returns true if the given rate limit could let more be minted than this one, in
+// which case it must not take effect right away
+func (l MintRateLimit) Loosens(other MintRateLimit) bool {
+	if !l.IsEnabled() {
+		return false
+	}
+
+	if !other.IsEnabled() || other.Limit.GT(l.Limit) {
+		return true
+	}
+
+	if l.WindowBlocks > 0 {
+		return other.WindowBlocks < l.WindowBlocks
+	}
+	return other.WindowDuration < l.WindowDuration
+}
+
+// InWindow returns true if a mint record still counts toward the rate limit at the given block
+func (l MintRateLimit) InWindow(record MintRecord, height int64, blockTime time.Time) bool {
+	if l.WindowBlocks > 0 {
+		return height-record.Height < int64(l.WindowBlocks)
+	}
+	return blockTime.Sub(record.Time) < l.WindowDuration
+}
+
+func (r MintRecord) Validate() error {
+	if r.Height < 0 {
+		return fmt.Errorf("invalid mint record height: %d", r.Height)
+	}
+
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("invalid mint record amount: %s", r.Amount)
+	}
+
+	return nil
+}
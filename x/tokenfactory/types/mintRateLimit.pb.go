@@ -0,0 +1,1001 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/mintRateLimit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintRateLimit caps the amount of a denom that can be minted within any
+// rolling window, measured either in blocks or in time.
+type MintRateLimit struct {
+	// Maximum amount minted within the window. Zero disables the rate limit.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit" yaml:"limit"`
+	// Length of the window in blocks, if measured in blocks
+	WindowBlocks uint64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+	// Length of the window in time, if measured in time
+	WindowDuration time.Duration `protobuf:"bytes,3,opt,name=window_duration,json=windowDuration,proto3,stdduration" json:"window_duration" yaml:"window_duration"`
+}
+
+func (m *MintRateLimit) Reset()         { *m = MintRateLimit{} }
+func (m *MintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MintRateLimit) ProtoMessage()    {}
+func (*MintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9afee8716d99d09, []int{0}
+}
+func (m *MintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateLimit.Merge(m, src)
+}
+func (m *MintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateLimit proto.InternalMessageInfo
+
+func (m *MintRateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *MintRateLimit) GetWindowDuration() time.Duration {
+	if m != nil {
+		return m.WindowDuration
+	}
+	return 0
+}
+
+// PendingMintRateLimit is a loosened mint rate limit, which replaces the
+// current one at effective_time.
+type PendingMintRateLimit struct {
+	RateLimit     MintRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit" yaml:"rate_limit"`
+	EffectiveTime time.Time     `protobuf:"bytes,2,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time" yaml:"effective_time"`
+}
+
+func (m *PendingMintRateLimit) Reset()         { *m = PendingMintRateLimit{} }
+func (m *PendingMintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*PendingMintRateLimit) ProtoMessage()    {}
+func (*PendingMintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9afee8716d99d09, []int{1}
+}
+func (m *PendingMintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMintRateLimit.Merge(m, src)
+}
+func (m *PendingMintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMintRateLimit proto.InternalMessageInfo
+
+func (m *PendingMintRateLimit) GetRateLimit() MintRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return MintRateLimit{}
+}
+
+func (m *PendingMintRateLimit) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// MintRecord is the amount of a rate limited denom minted in a block, kept
+// while the block is within the window of the rate limit.
+type MintRecord struct {
+	Height int64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time   time.Time             `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9afee8716d99d09, []int{2}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+func (m *MintRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MintRateLimit)(nil), "osmosis.tokenfactory.v1beta1.MintRateLimit")
+	proto.RegisterType((*PendingMintRateLimit)(nil), "osmosis.tokenfactory.v1beta1.PendingMintRateLimit")
+	proto.RegisterType((*MintRecord)(nil), "osmosis.tokenfactory.v1beta1.MintRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/mintRateLimit.proto", fileDescriptor_b9afee8716d99d09)
+}
+
+var fileDescriptor_b9afee8716d99d09 = []byte{
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xb7, 0x52, 0xa9, 0xee, 0x3a, 0xb4, 0xa8, 0x83, 0xb6, 0x42, 0x49, 0xc9, 0xa9, 0x08,
+	0xd5, 0x61, 0xe5, 0x80, 0x34, 0xe0, 0x12, 0x21, 0xa1, 0x49, 0x20, 0xb1, 0x68, 0x27, 0x2e, 0x95,
+	0x9b, 0xb8, 0xa9, 0xd5, 0xc6, 0xae, 0x12, 0x77, 0xa3, 0xbf, 0x82, 0x1d, 0x39, 0xf2, 0x23, 0xf8,
+	0x11, 0x3b, 0x4e, 0x9c, 0x10, 0x42, 0x01, 0xb5, 0x17, 0xb8, 0xf6, 0x17, 0xa0, 0xd8, 0xce, 0xba,
+	0x0c, 0x09, 0xb8, 0xf9, 0xf3, 0xfb, 0xde, 0xf3, 0x7b, 0xfe, 0x6c, 0xf8, 0x88, 0x27, 0x11, 0x4f,
+	0x68, 0xe2, 0x08, 0x3e, 0x21, 0x6c, 0x84, 0x7d, 0xc1, 0xe3, 0x85, 0x73, 0x7a, 0x30, 0x24, 0x02,
+	0x1f, 0x38, 0x11, 0x65, 0xc2, 0xc3, 0x82, 0xbc, 0xa2, 0x11, 0x15, 0x68, 0x16, 0x73, 0xc1, 0x8d,
+	0x7b, 0x9a, 0x81, 0xae, 0x33, 0x90, 0x66, 0xb4, 0x1b, 0x21, 0x0f, 0xb9, 0x6c, 0x74, 0xb2, 0x95,
+	0xe2, 0xb4, 0x5b, 0xbe, 0x24, 0x0d, 0x14, 0xa0, 0x0a, 0x0d, 0x99, 0x21, 0xe7, 0xe1, 0x94, 0x38,
+	0xb2, 0x1a, 0xce, 0x47, 0x4e, 0x30, 0x8f, 0xb1, 0xa0, 0x9c, 0x69, 0xdc, 0xba, 0x89, 0x0b, 0x1a,
+	0x91, 0x44, 0xe0, 0x68, 0xa6, 0x1a, 0xec, 0xf7, 0x5b, 0xb0, 0xfe, 0xfa, 0xba, 0x4f, 0xe3, 0x18,
+	0xde, 0x9a, 0x66, 0x8b, 0x26, 0xe8, 0x80, 0x6e, 0xd5, 0x7d, 0x7a, 0x91, 0x5a, 0xa5, 0xaf, 0xa9,
+	0xb5, 0xaf, 0xce, 0x4d, 0x82, 0x09, 0xa2, 0xdc, 0x89, 0xb0, 0x18, 0xa3, 0x23, 0x26, 0xd6, 0xa9,
+	0xb5, 0xb3, 0xc0, 0xd1, 0xf4, 0xd0, 0x96, 0x1c, 0xfb, 0xf3, 0xa7, 0x1e, 0xd4, 0x06, 0x8f, 0x98,
+	0xf0, 0x94, 0x92, 0xf1, 0x1c, 0xd6, 0xcf, 0x28, 0x0b, 0xf8, 0xd9, 0x60, 0x38, 0xe5, 0xfe, 0x24,
+	0x69, 0x6e, 0x75, 0x40, 0xb7, 0xec, 0x36, 0xd7, 0xa9, 0xd5, 0x50, 0xec, 0x02, 0x6c, 0x7b, 0x3b,
+	0xaa, 0x76, 0x65, 0x69, 0x8c, 0xe0, 0x6d, 0x8d, 0xe7, 0xe9, 0x9a, 0xdb, 0x1d, 0xd0, 0xad, 0xf5,
+	0x5b, 0x48, 0xc5, 0x43, 0x79, 0x3c, 0xf4, 0x42, 0x37, 0xb8, 0x76, 0x66, 0x7b, 0x9d, 0x5a, 0x77,
+	0x0a, 0xfa, 0x39, 0xdf, 0xfe, 0xf0, 0xdd, 0x02, 0xde, 0xae, 0xda, 0xcd, 0x39, 0x87, 0xe5, 0x9f,
+	0x1f, 0x2d, 0x60, 0xff, 0x02, 0xb0, 0xf1, 0x86, 0xb0, 0x80, 0xb2, 0xb0, 0x78, 0x31, 0x04, 0xc2,
+	0x18, 0x0b, 0x32, 0xd8, 0xdc, 0x4e, 0xad, 0xff, 0x10, 0xfd, 0x6d, 0x9e, 0xa8, 0x20, 0xe0, 0xb6,
+	0xb4, 0xa7, 0x3d, 0xe5, 0x69, 0x23, 0x66, 0x7b, 0xd5, 0xf8, 0xea, 0x98, 0x00, 0xee, 0x92, 0xd1,
+	0x88, 0xf8, 0x82, 0x9e, 0x92, 0x41, 0x36, 0x2e, 0x79, 0x5b, 0xb5, 0x7e, 0xfb, 0x8f, 0xb0, 0x27,
+	0xf9, 0x2c, 0xdd, 0xfb, 0x5a, 0x79, 0x5f, 0x29, 0x17, 0xf9, 0xf6, 0x79, 0x16, 0xb6, 0x7e, 0xb5,
+	0x99, 0xd1, 0x74, 0xd6, 0x6f, 0x00, 0x42, 0xe9, 0x91, 0xf8, 0x3c, 0x0e, 0x8c, 0x07, 0xb0, 0x32,
+	0x26, 0x34, 0x1c, 0xab, 0x74, 0xdb, 0xee, 0xde, 0x3a, 0xb5, 0xea, 0x4a, 0x52, 0xed, 0xdb, 0x9e,
+	0x6e, 0x30, 0x5e, 0xc2, 0xf2, 0x7f, 0x7a, 0xbb, 0xab, 0xbd, 0xd5, 0x94, 0xd0, 0xc6, 0x91, 0x14,
+	0x30, 0x4e, 0x60, 0x05, 0x47, 0x7c, 0xce, 0x84, 0x9c, 0x69, 0xd5, 0x7d, 0xf6, 0xaf, 0xf7, 0xa6,
+	0x0d, 0x29, 0xd2, 0xcd, 0x07, 0xa7, 0xb5, 0x54, 0x3c, 0xf7, 0xf8, 0x62, 0x69, 0x82, 0xcb, 0xa5,
+	0x09, 0x7e, 0x2c, 0x4d, 0x70, 0xbe, 0x32, 0x4b, 0x97, 0x2b, 0xb3, 0xf4, 0x65, 0x65, 0x96, 0xde,
+	0x3e, 0x09, 0xa9, 0x18, 0xcf, 0x87, 0xc8, 0xe7, 0x91, 0xc3, 0x78, 0x4c, 0x71, 0x8f, 0x11, 0xa1,
+	0x7e, 0x71, 0x2f, 0xff, 0xc6, 0xef, 0x8a, 0xbf, 0x5a, 0x2c, 0x66, 0x24, 0x19, 0x56, 0x64, 0xc2,
+	0xc7, 0xbf, 0x07, 0x00, 0x51, 0xd7, 0xa9, 0x33, 0xfa, 0x03, 0x00, 0x00,
+}
+
+func (this *MintRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintRateLimit)
+	if !ok {
+		that2, ok := that.(MintRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Limit.Equal(that1.Limit) {
+		return false
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
+	}
+	if this.WindowDuration != that1.WindowDuration {
+		return false
+	}
+	return true
+}
+func (this *PendingMintRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingMintRateLimit)
+	if !ok {
+		that2, ok := that.(PendingMintRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RateLimit.Equal(&that1.RateLimit) {
+		return false
+	}
+	if !this.EffectiveTime.Equal(that1.EffectiveTime) {
+		return false
+	}
+	return true
+}
+func (this *MintRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintRecord)
+	if !ok {
+		that2, ok := that.(MintRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (m *MintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.WindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintRateLimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMintRateLimit(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMintRateLimit(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.WindowBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration)
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	return n
+}
+
+func (m *PendingMintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	return n
+}
+
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	return n
+}
+
+func sovMintRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintRateLimit(x uint64) (n int) {
+	return sovMintRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.WindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
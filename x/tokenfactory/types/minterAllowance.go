@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMinterAllowance returns a minter allowance with its full allowance remaining, last
+// replenished at the given block time.
+func NewMinterAllowance(minter string, allowance math.Int, replenishAmount math.Int, replenishPeriod time.Duration, blockTime time.Time) MinterAllowance {
+	return MinterAllowance{
+		Minter:          minter,
+		Allowance:       allowance,
+		Remaining:       allowance,
+		ReplenishAmount: replenishAmount,
+		ReplenishPeriod: replenishPeriod,
+		LastReplenished: blockTime,
+	}
+}
+
+func (a MinterAllowance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Minter); err != nil {
+		return err
+	}
+
+	if a.Allowance.IsNil() || a.Allowance.IsNegative() {
+		return fmt.Errorf("invalid allowance: %s", a.Allowance)
+	}
+
+	if a.Remaining.IsNil() || a.Remaining.IsNegative() || a.Remaining.GT(a.Allowance) {
+		return fmt.Errorf("remaining allowance must be between 0 and %s: %s", a.Allowance, a.Remaining)
+	}
+
+	return validateReplenishment(a.ReplenishAmount, a.ReplenishPeriod)
+}
+
+// Replenish returns the allowance with the remaining allowance topped up for every full
+// replenish period elapsed until the given block time, up to the configured allowance.
+func (a MinterAllowance) Replenish(blockTime time.Time) MinterAllowance {
+	if !a.ReplenishAmount.IsPositive() || a.ReplenishPeriod <= 0 {
+		return a
+	}
+
+	elapsed := blockTime.Sub(a.LastReplenished)
+	if elapsed < a.ReplenishPeriod {
+		return a
+	}
+
+	periods := int64(elapsed / a.ReplenishPeriod)
+	a.Remaining = math.MinInt(a.Allowance, a.Remaining.Add(a.ReplenishAmount.MulRaw(periods)))
+	a.LastReplenished = a.LastReplenished.Add(time.Duration(periods) * a.ReplenishPeriod)
+	return a
+}
+
+func validateReplenishment(replenishAmount math.Int, replenishPeriod time.Duration) error {
+	if replenishAmount.IsNil() || replenishAmount.IsNegative() {
+		return fmt.Errorf("invalid replenish amount: %s", replenishAmount)
+	}
+
+	if replenishPeriod < 0 {
+		return fmt.Errorf("invalid replenish period: %s", replenishPeriod)
+	}
+
+	if replenishAmount.IsPositive() && replenishPeriod == 0 {
+		return fmt.Errorf("replenish period must be set when replenishing %s", replenishAmount)
+	}
+
+	return nil
+}
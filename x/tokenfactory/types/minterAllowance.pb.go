@@ -0,0 +1,634 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/minterAllowance.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MinterAllowance defines how much of a denom a delegated minter is allowed to
+// mint. The remaining allowance can optionally be replenished by
+// replenish_amount every replenish_period, up to the configured allowance.
+type MinterAllowance struct {
+	// Address allowed to mint the denom
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+	// Maximum amount the minter can hold as remaining allowance
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance" yaml:"allowance"`
+	// Amount the minter can still mint
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining" yaml:"remaining"`
+	// Amount added back to the remaining allowance every replenish_period. Zero
+	// disables replenishment.
+	ReplenishAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=replenish_amount,json=replenishAmount,proto3,customtype=cosmossdk.io/math.Int" json:"replenish_amount" yaml:"replenish_amount"`
+	ReplenishPeriod time.Duration         `protobuf:"bytes,5,opt,name=replenish_period,json=replenishPeriod,proto3,stdduration" json:"replenish_period" yaml:"replenish_period"`
+	// Block time at which the remaining allowance was last replenished
+	LastReplenished time.Time `protobuf:"bytes,6,opt,name=last_replenished,json=lastReplenished,proto3,stdtime" json:"last_replenished" yaml:"last_replenished"`
+}
+
+func (m *MinterAllowance) Reset()         { *m = MinterAllowance{} }
+func (m *MinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MinterAllowance) ProtoMessage()    {}
+func (*MinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef6611ab553c0fec, []int{0}
+}
+func (m *MinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterAllowance.Merge(m, src)
+}
+func (m *MinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterAllowance proto.InternalMessageInfo
+
+func (m *MinterAllowance) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *MinterAllowance) GetReplenishPeriod() time.Duration {
+	if m != nil {
+		return m.ReplenishPeriod
+	}
+	return 0
+}
+
+func (m *MinterAllowance) GetLastReplenished() time.Time {
+	if m != nil {
+		return m.LastReplenished
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MinterAllowance)(nil), "osmosis.tokenfactory.v1beta1.MinterAllowance")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/minterAllowance.proto", fileDescriptor_ef6611ab553c0fec)
+}
+
+var fileDescriptor_ef6611ab553c0fec = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x8a, 0x13, 0x41,
+	0x10, 0xc6, 0x33, 0x1a, 0x03, 0x3b, 0x22, 0x1b, 0x07, 0xc5, 0xd9, 0x20, 0x33, 0xcb, 0x78, 0x59,
+	0x0f, 0xe9, 0x66, 0xd7, 0x83, 0xb0, 0x17, 0xd9, 0x20, 0xc2, 0x1e, 0x04, 0x0d, 0x9e, 0x04, 0x09,
+	0x9d, 0xa4, 0x77, 0xd2, 0xee, 0x74, 0xd7, 0xd8, 0x5d, 0x51, 0xf3, 0x16, 0x7b, 0xf4, 0xe8, 0x43,
+	0xf8, 0x10, 0x8b, 0xa7, 0xc5, 0x93, 0x78, 0x88, 0x92, 0x5c, 0x3c, 0xef, 0x13, 0x48, 0xba, 0x67,
+	0x66, 0xf3, 0x47, 0x10, 0x6f, 0xe9, 0xfa, 0xea, 0xfb, 0x7e, 0x15, 0x6a, 0xca, 0x3f, 0x00, 0x23,
+	0xc1, 0x08, 0x43, 0x11, 0x4e, 0xb9, 0x3a, 0x61, 0x03, 0x04, 0x3d, 0xa1, 0xef, 0xf7, 0xfb, 0x1c,
+	0xd9, 0x3e, 0x95, 0x42, 0x21, 0xd7, 0x47, 0x59, 0x06, 0x1f, 0x98, 0x1a, 0x70, 0x92, 0x6b, 0x40,
+	0x08, 0xee, 0x17, 0x1e, 0xb2, 0xec, 0x21, 0x85, 0xa7, 0x75, 0x27, 0x85, 0x14, 0x6c, 0x23, 0x5d,
+	0xfc, 0x72, 0x9e, 0xd6, 0xce, 0xc0, 0x9a, 0x7a, 0x4e, 0x70, 0x8f, 0x42, 0x8a, 0x52, 0x80, 0x34,
+	0xe3, 0xd4, 0xbe, 0xfa, 0xe3, 0x13, 0x3a, 0x1c, 0x6b, 0x86, 0x02, 0x54, 0xa1, 0xc7, 0xeb, 0x3a,
+	0x0a, 0xc9, 0x0d, 0x32, 0x99, 0xbb, 0x86, 0xe4, 0x6b, 0xdd, 0xdf, 0x7e, 0xbe, 0x3a, 0x69, 0xf0,
+	0xd0, 0x6f, 0xb8, 0xe1, 0x43, 0x6f, 0xd7, 0xdb, 0xdb, 0xea, 0xdc, 0xbe, 0x9c, 0xc6, 0xb7, 0x26,
+	0x4c, 0x66, 0x87, 0x89, 0xab, 0x27, 0xdd, 0xa2, 0x21, 0x78, 0xe3, 0x6f, 0xb1, 0xd2, 0x17, 0x5e,
+	0xb3, 0xdd, 0x4f, 0xce, 0xa7, 0x71, 0xed, 0xc7, 0x34, 0xbe, 0xeb, 0x06, 0x35, 0xc3, 0x53, 0x22,
+	0x80, 0x4a, 0x86, 0x23, 0x72, 0xac, 0xf0, 0x72, 0x1a, 0x37, 0x5d, 0x54, 0xe5, 0x4b, 0xbe, 0x7d,
+	0x69, 0xfb, 0xc5, 0xbf, 0x3a, 0x56, 0xd8, 0xbd, 0x4a, 0x5c, 0xc4, 0x6b, 0x2e, 0x99, 0x50, 0x42,
+	0xa5, 0xe1, 0xf5, 0xff, 0x8a, 0xaf, 0x7c, 0x1b, 0xf1, 0x95, 0x12, 0xbc, 0xf3, 0x9b, 0x9a, 0xe7,
+	0x19, 0x57, 0xc2, 0x8c, 0x7a, 0x4c, 0xc2, 0x58, 0x61, 0x58, 0xb7, 0x94, 0x67, 0xff, 0xa2, 0xdc,
+	0x2b, 0x29, 0xab, 0xf6, 0x75, 0xd8, 0x76, 0xd5, 0x70, 0x64, 0xf5, 0x40, 0x2c, 0x23, 0x73, 0xae,
+	0x05, 0x0c, 0xc3, 0x1b, 0xbb, 0xde, 0xde, 0xcd, 0x83, 0x1d, 0xe2, 0x76, 0x45, 0xca, 0x5d, 0x91,
+	0xa7, 0xc5, 0x2e, 0x3b, 0x0f, 0x16, 0xd3, 0xfc, 0x0d, 0xea, 0x02, 0x92, 0x4f, 0x3f, 0x63, 0x6f,
+	0x09, 0xf5, 0xc2, 0x56, 0x83, 0xb7, 0x7e, 0x33, 0x63, 0x06, 0x7b, 0x55, 0x9d, 0x0f, 0xc3, 0x86,
+	0x45, 0xb5, 0x36, 0x50, 0xaf, 0xca, 0xcf, 0x62, 0x9d, 0xb5, 0x9e, 0x90, 0x9c, 0x59, 0xd6, 0xa2,
+	0xdc, 0xbd, 0xaa, 0x1e, 0xd6, 0x7f, 0x7f, 0x8e, 0xbd, 0xce, 0xcb, 0xf3, 0x59, 0xe4, 0x5d, 0xcc,
+	0x22, 0xef, 0xd7, 0x2c, 0xf2, 0xce, 0xe6, 0x51, 0xed, 0x62, 0x1e, 0xd5, 0xbe, 0xcf, 0xa3, 0xda,
+	0xeb, 0xc7, 0xa9, 0xc0, 0xd1, 0xb8, 0x4f, 0x06, 0x20, 0xa9, 0x02, 0x2d, 0x58, 0x5b, 0x71, 0x74,
+	0x77, 0xd3, 0x2e, 0x0f, 0xe7, 0xe3, 0xea, 0x1d, 0xe1, 0x24, 0xe7, 0xa6, 0xdf, 0xb0, 0x23, 0x3e,
+	0xfa, 0x33, 0x00, 0x4e, 0xa8, 0x81, 0xaa, 0x6c, 0x03, 0x00, 0x00,
+}
+
+func (this *MinterAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MinterAllowance)
+	if !ok {
+		that2, ok := that.(MinterAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Minter != that1.Minter {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
+	if !this.Remaining.Equal(that1.Remaining) {
+		return false
+	}
+	if !this.ReplenishAmount.Equal(that1.ReplenishAmount) {
+		return false
+	}
+	if this.ReplenishPeriod != that1.ReplenishPeriod {
+		return false
+	}
+	if !this.LastReplenished.Equal(that1.LastReplenished) {
+		return false
+	}
+	return true
+}
+func (m *MinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReplenished, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReplenished):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMinterAllowance(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReplenishPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMinterAllowance(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ReplenishAmount.Size()
+		i -= size
+		if _, err := m.ReplenishAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinterAllowance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinterAllowance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinterAllowance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintMinterAllowance(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMinterAllowance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMinterAllowance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovMinterAllowance(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovMinterAllowance(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovMinterAllowance(uint64(l))
+	l = m.ReplenishAmount.Size()
+	n += 1 + l + sovMinterAllowance(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReplenishPeriod)
+	n += 1 + l + sovMinterAllowance(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReplenished)
+	n += 1 + l + sovMinterAllowance(uint64(l))
+	return n
+}
+
+func sovMinterAllowance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMinterAllowance(x uint64) (n int) {
+	return sovMinterAllowance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinterAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplenishAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReplenishAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplenished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastReplenished, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinterAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinterAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMinterAllowance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMinterAllowance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinterAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMinterAllowance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMinterAllowance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMinterAllowance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMinterAllowance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMinterAllowance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMinterAllowance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -8,12 +11,38 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgMint             = "tf_mint"
-	TypeMsgBurn             = "tf_burn"
-	TypeMsgForceTransfer    = "force_transfer"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom             = "create_denom"
+	TypeMsgMint                    = "tf_mint"
+	TypeMsgBurn                    = "tf_burn"
+	TypeMsgForceTransfer           = "force_transfer"
+	TypeMsgChangeAdmin             = "change_admin"
+	TypeMsgSetDenomMetadata        = "set_denom_metadata"
+	TypeMsgGrantRole               = "grant_role"
+	TypeMsgRevokeRole              = "revoke_role"
+	TypeMsgProposeAdmin            = "propose_admin"
+	TypeMsgAcceptAdmin             = "accept_admin"
+	TypeMsgCancelAdminProposal     = "cancel_admin_proposal"
+	TypeMsgConfigureMinter         = "configure_minter"
+	TypeMsgIncreaseMinterAllowance = "increase_minter_allowance"
+	TypeMsgDecreaseMinterAllowance = "decrease_minter_allowance"
+	TypeMsgRemoveMinter            = "remove_minter"
+	TypeMsgSetMaxSupply            = "set_max_supply"
+	TypeMsgPause                   = "pause"
+	TypeMsgUnpause                 = "unpause"
+	TypeMsgFreeze                  = "freeze"
+	TypeMsgUnfreeze                = "unfreeze"
+	TypeMsgAddToAllowlist          = "add_to_allowlist"
+	TypeMsgRemoveFromAllowlist     = "remove_from_allowlist"
+	TypeMsgSetBeforeSendHook       = "set_before_send_hook"
+	TypeMsgRenounceCapability      = "renounce_capability"
+	TypeMsgSetTimelock             = "set_timelock"
+	TypeMsgCancelPendingAction     = "cancel_pending_action"
+	TypeMsgSetMintRateLimit        = "set_mint_rate_limit"
+	TypeMsgUpdateParams            = "update_params"
+	TypeMsgRetireDenom             = "retire_denom"
+	TypeMsgBatchMint               = "batch_mint"
+	TypeMsgBatchBurn               = "batch_burn"
+	TypeMsgBatchForceTransfer      = "batch_force_transfer"
 )
 
 // NewMsgCreateDenom creates a msg to create a new denom
@@ -37,6 +66,22 @@ func (m MsgTokenFactoryCreateDenom) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
 	}
 
+	if !m.MaxSupply.IsNil() && m.MaxSupply.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidMaxSupply, m.MaxSupply.String())
+	}
+
+	err = ValidateRenouncedCapabilities(m.RenouncedCapabilities)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if m.FeeDenom != "" {
+		err = sdk.ValidateDenom(m.FeeDenom)
+		if err != nil {
+			return sdkerrors.Wrap(ErrInvalidFeeDenom, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -84,6 +129,13 @@ func (m MsgTokenFactoryMint) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
 	}
 
+	if m.ReferenceId != "" {
+		err = ValidateReferenceID(m.ReferenceId)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -132,6 +184,13 @@ func (m MsgTokenFactoryBurn) ValidateBasic() error {
 		}
 	}
 
+	if m.ReferenceId != "" {
+		err = ValidateReferenceID(m.ReferenceId)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -187,12 +246,13 @@ func (m MsgTokenFactoryForceTransfer) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// NewMsgChangeAdmin creates a message to burn tokens
-func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgTokenFactoryChangeAdmin {
+// NewMsgChangeAdmin creates a message to renounce the admin of a denom
+func NewMsgChangeAdmin(sender, denom, newAdmin string, confirmRenounce bool) *MsgTokenFactoryChangeAdmin {
 	return &MsgTokenFactoryChangeAdmin{
-		Sender:   sender,
-		Denom:    denom,
-		NewAdmin: newAdmin,
+		Sender:          sender,
+		Denom:           denom,
+		NewAdmin:        newAdmin,
+		ConfirmRenounce: confirmRenounce,
 	}
 }
 
@@ -204,9 +264,12 @@ func (m MsgTokenFactoryChangeAdmin) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
-	_, err = sdk.AccAddressFromBech32(m.NewAdmin)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	if m.NewAdmin != "" {
+		return sdkerrors.Wrap(ErrAdminChangeNotProposed, "use MsgTokenFactoryProposeAdmin to hand over adminship")
+	}
+
+	if !m.ConfirmRenounce {
+		return ErrRenounceNotConfirmed
 	}
 
 	_, _, err = DeconstructDenom(m.Denom)
@@ -263,3 +326,862 @@ func (m MsgTokenFactorySetDenomMetadata) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgGrantRole creates a message to grant a denom role to an address
+func NewMsgGrantRole(sender, denom string, role DenomRole, address string) *MsgTokenFactoryGrantRole {
+	return &MsgTokenFactoryGrantRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgTokenFactoryGrantRole) Route() string { return RouterKey }
+func (m MsgTokenFactoryGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgTokenFactoryGrantRole) ValidateBasic() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Role, m.Address)
+}
+
+func (m MsgTokenFactoryGrantRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRevokeRole creates a message to revoke a denom role from an address
+func NewMsgRevokeRole(sender, denom string, role DenomRole, address string) *MsgTokenFactoryRevokeRole {
+	return &MsgTokenFactoryRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgTokenFactoryRevokeRole) Route() string { return RouterKey }
+func (m MsgTokenFactoryRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgTokenFactoryRevokeRole) ValidateBasic() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Role, m.Address)
+}
+
+func (m MsgTokenFactoryRevokeRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateRoleMsg(sender, denom string, role DenomRole, address string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	err = role.ValidateDelegable()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidRole, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgProposeAdmin creates a message to propose a new admin for a denom
+func NewMsgProposeAdmin(sender, denom, newAdmin string) *MsgTokenFactoryProposeAdmin {
+	return &MsgTokenFactoryProposeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+func (m MsgTokenFactoryProposeAdmin) Route() string { return RouterKey }
+func (m MsgTokenFactoryProposeAdmin) Type() string  { return TypeMsgProposeAdmin }
+func (m MsgTokenFactoryProposeAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	if m.NewAdmin == m.Sender {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new admin cannot be the same as current admin")
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryProposeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryProposeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgAcceptAdmin creates a message to accept the adminship of a denom
+func NewMsgAcceptAdmin(sender, denom string) *MsgTokenFactoryAcceptAdmin {
+	return &MsgTokenFactoryAcceptAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgTokenFactoryAcceptAdmin) Route() string { return RouterKey }
+func (m MsgTokenFactoryAcceptAdmin) Type() string  { return TypeMsgAcceptAdmin }
+func (m MsgTokenFactoryAcceptAdmin) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+func (m MsgTokenFactoryAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryAcceptAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgCancelAdminProposal creates a message to cancel a pending admin proposal
+func NewMsgCancelAdminProposal(sender, denom string) *MsgTokenFactoryCancelAdminProposal {
+	return &MsgTokenFactoryCancelAdminProposal{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgTokenFactoryCancelAdminProposal) Route() string { return RouterKey }
+func (m MsgTokenFactoryCancelAdminProposal) Type() string  { return TypeMsgCancelAdminProposal }
+func (m MsgTokenFactoryCancelAdminProposal) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+func (m MsgTokenFactoryCancelAdminProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryCancelAdminProposal) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateDenomMsg(sender, denom string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgConfigureMinter creates a message to let a minter mint a denom up to an allowance
+func NewMsgConfigureMinter(sender, denom, minter string, allowance, replenishAmount math.Int, replenishPeriod time.Duration) *MsgTokenFactoryConfigureMinter {
+	return &MsgTokenFactoryConfigureMinter{
+		Sender:          sender,
+		Denom:           denom,
+		Minter:          minter,
+		Allowance:       allowance,
+		ReplenishAmount: replenishAmount,
+		ReplenishPeriod: replenishPeriod,
+	}
+}
+
+func (m MsgTokenFactoryConfigureMinter) Route() string { return RouterKey }
+func (m MsgTokenFactoryConfigureMinter) Type() string  { return TypeMsgConfigureMinter }
+func (m MsgTokenFactoryConfigureMinter) ValidateBasic() error {
+	err := validateMinterMsg(m.Sender, m.Denom, m.Minter)
+	if err != nil {
+		return err
+	}
+
+	if m.Allowance.IsNil() || m.Allowance.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidMinterAllowance, "invalid allowance: %s", m.Allowance)
+	}
+
+	err = validateReplenishment(m.ReplenishAmount, m.ReplenishPeriod)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidMinterAllowance, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryConfigureMinter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryConfigureMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgIncreaseMinterAllowance creates a message to raise the allowance of a minter
+func NewMsgIncreaseMinterAllowance(sender, denom, minter string, amount math.Int) *MsgTokenFactoryIncreaseMinterAllowance {
+	return &MsgTokenFactoryIncreaseMinterAllowance{
+		Sender: sender,
+		Denom:  denom,
+		Minter: minter,
+		Amount: amount,
+	}
+}
+
+func (m MsgTokenFactoryIncreaseMinterAllowance) Route() string { return RouterKey }
+func (m MsgTokenFactoryIncreaseMinterAllowance) Type() string  { return TypeMsgIncreaseMinterAllowance }
+func (m MsgTokenFactoryIncreaseMinterAllowance) ValidateBasic() error {
+	err := validateMinterMsg(m.Sender, m.Denom, m.Minter)
+	if err != nil {
+		return err
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryIncreaseMinterAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryIncreaseMinterAllowance) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgDecreaseMinterAllowance creates a message to lower the allowance of a minter
+func NewMsgDecreaseMinterAllowance(sender, denom, minter string, amount math.Int) *MsgTokenFactoryDecreaseMinterAllowance {
+	return &MsgTokenFactoryDecreaseMinterAllowance{
+		Sender: sender,
+		Denom:  denom,
+		Minter: minter,
+		Amount: amount,
+	}
+}
+
+func (m MsgTokenFactoryDecreaseMinterAllowance) Route() string { return RouterKey }
+func (m MsgTokenFactoryDecreaseMinterAllowance) Type() string  { return TypeMsgDecreaseMinterAllowance }
+func (m MsgTokenFactoryDecreaseMinterAllowance) ValidateBasic() error {
+	err := validateMinterMsg(m.Sender, m.Denom, m.Minter)
+	if err != nil {
+		return err
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryDecreaseMinterAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryDecreaseMinterAllowance) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRemoveMinter creates a message to remove the allowance of a minter
+func NewMsgRemoveMinter(sender, denom, minter string) *MsgTokenFactoryRemoveMinter {
+	return &MsgTokenFactoryRemoveMinter{
+		Sender: sender,
+		Denom:  denom,
+		Minter: minter,
+	}
+}
+
+func (m MsgTokenFactoryRemoveMinter) Route() string { return RouterKey }
+func (m MsgTokenFactoryRemoveMinter) Type() string  { return TypeMsgRemoveMinter }
+func (m MsgTokenFactoryRemoveMinter) ValidateBasic() error {
+	return validateMinterMsg(m.Sender, m.Denom, m.Minter)
+}
+
+func (m MsgTokenFactoryRemoveMinter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryRemoveMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetMaxSupply creates a message to cap the total supply of a denom
+func NewMsgSetMaxSupply(sender, denom string, maxSupply math.Int) *MsgTokenFactorySetMaxSupply {
+	return &MsgTokenFactorySetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgTokenFactorySetMaxSupply) Route() string { return RouterKey }
+func (m MsgTokenFactorySetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgTokenFactorySetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.MaxSupply.IsNil() || !m.MaxSupply.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidMaxSupply, "max supply must be positive")
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactorySetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactorySetMaxSupply) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgPause creates a message to pause the transfers of a denom
+func NewMsgPause(sender, denom string) *MsgTokenFactoryPause {
+	return &MsgTokenFactoryPause{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgTokenFactoryPause) Route() string { return RouterKey }
+func (m MsgTokenFactoryPause) Type() string  { return TypeMsgPause }
+func (m MsgTokenFactoryPause) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+func (m MsgTokenFactoryPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryPause) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUnpause creates a message to resume the transfers of a paused denom
+func NewMsgUnpause(sender, denom string) *MsgTokenFactoryUnpause {
+	return &MsgTokenFactoryUnpause{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgTokenFactoryUnpause) Route() string { return RouterKey }
+func (m MsgTokenFactoryUnpause) Type() string  { return TypeMsgUnpause }
+func (m MsgTokenFactoryUnpause) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+func (m MsgTokenFactoryUnpause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryUnpause) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgFreeze creates a message to freeze addresses holding a denom
+func NewMsgFreeze(sender, denom string, addresses []string) *MsgTokenFactoryFreeze {
+	return &MsgTokenFactoryFreeze{
+		Sender:    sender,
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgTokenFactoryFreeze) Route() string { return RouterKey }
+func (m MsgTokenFactoryFreeze) Type() string  { return TypeMsgFreeze }
+func (m MsgTokenFactoryFreeze) ValidateBasic() error {
+	return validateAddressesMsg(m.Sender, m.Denom, m.Addresses)
+}
+
+func (m MsgTokenFactoryFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryFreeze) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUnfreeze creates a message to unfreeze addresses holding a denom
+func NewMsgUnfreeze(sender, denom string, addresses []string) *MsgTokenFactoryUnfreeze {
+	return &MsgTokenFactoryUnfreeze{
+		Sender:    sender,
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgTokenFactoryUnfreeze) Route() string { return RouterKey }
+func (m MsgTokenFactoryUnfreeze) Type() string  { return TypeMsgUnfreeze }
+func (m MsgTokenFactoryUnfreeze) ValidateBasic() error {
+	return validateAddressesMsg(m.Sender, m.Denom, m.Addresses)
+}
+
+func (m MsgTokenFactoryUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryUnfreeze) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateAddressesMsg(sender, denom string, addresses []string) error {
+	err := validateDenomMsg(sender, denom)
+	if err != nil {
+		return err
+	}
+
+	if len(addresses) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no addresses")
+	}
+
+	seen := map[string]bool{}
+	for _, address := range addresses {
+		_, err = sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+		}
+		if seen[address] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address: %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// NewMsgAddToAllowlist creates a message to allow addresses to receive a denom
+func NewMsgAddToAllowlist(sender, denom string, addresses []string) *MsgTokenFactoryAddToAllowlist {
+	return &MsgTokenFactoryAddToAllowlist{
+		Sender:    sender,
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgTokenFactoryAddToAllowlist) Route() string { return RouterKey }
+func (m MsgTokenFactoryAddToAllowlist) Type() string  { return TypeMsgAddToAllowlist }
+func (m MsgTokenFactoryAddToAllowlist) ValidateBasic() error {
+	return validateAddressesMsg(m.Sender, m.Denom, m.Addresses)
+}
+
+func (m MsgTokenFactoryAddToAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryAddToAllowlist) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRemoveFromAllowlist creates a message to prevent addresses from receiving a denom
+func NewMsgRemoveFromAllowlist(sender, denom string, addresses []string) *MsgTokenFactoryRemoveFromAllowlist {
+	return &MsgTokenFactoryRemoveFromAllowlist{
+		Sender:    sender,
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgTokenFactoryRemoveFromAllowlist) Route() string { return RouterKey }
+func (m MsgTokenFactoryRemoveFromAllowlist) Type() string  { return TypeMsgRemoveFromAllowlist }
+func (m MsgTokenFactoryRemoveFromAllowlist) ValidateBasic() error {
+	return validateAddressesMsg(m.Sender, m.Denom, m.Addresses)
+}
+
+func (m MsgTokenFactoryRemoveFromAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryRemoveFromAllowlist) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetBeforeSendHook creates a message to attach a contract called before every transfer
+// of a denom
+func NewMsgSetBeforeSendHook(sender, denom, cosmwasmAddress string) *MsgTokenFactorySetBeforeSendHook {
+	return &MsgTokenFactorySetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+	}
+}
+
+func (m MsgTokenFactorySetBeforeSendHook) Route() string { return RouterKey }
+func (m MsgTokenFactorySetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
+func (m MsgTokenFactorySetBeforeSendHook) ValidateBasic() error {
+	err := validateDenomMsg(m.Sender, m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.CosmwasmAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.CosmwasmAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid cosmwasm contract address (%s)", err)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactorySetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactorySetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRenounceCapability creates a message to permanently give up a capability over a denom
+func NewMsgRenounceCapability(sender, denom string, capability DenomCapability) *MsgTokenFactoryRenounceCapability {
+	return &MsgTokenFactoryRenounceCapability{
+		Sender:     sender,
+		Denom:      denom,
+		Capability: capability,
+	}
+}
+
+func (m MsgTokenFactoryRenounceCapability) Route() string { return RouterKey }
+func (m MsgTokenFactoryRenounceCapability) Type() string  { return TypeMsgRenounceCapability }
+func (m MsgTokenFactoryRenounceCapability) ValidateBasic() error {
+	err := validateDenomMsg(m.Sender, m.Denom)
+	if err != nil {
+		return err
+	}
+
+	err = m.Capability.Validate()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryRenounceCapability) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryRenounceCapability) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetTimelock creates a message to delay the sensitive admin actions over a denom
+func NewMsgSetTimelock(sender, denom string, delay time.Duration, mintThreshold math.Int) *MsgTokenFactorySetTimelock {
+	return &MsgTokenFactorySetTimelock{
+		Sender:        sender,
+		Denom:         denom,
+		Delay:         delay,
+		MintThreshold: mintThreshold,
+	}
+}
+
+func (m MsgTokenFactorySetTimelock) Route() string { return RouterKey }
+func (m MsgTokenFactorySetTimelock) Type() string  { return TypeMsgSetTimelock }
+func (m MsgTokenFactorySetTimelock) ValidateBasic() error {
+	err := validateDenomMsg(m.Sender, m.Denom)
+	if err != nil {
+		return err
+	}
+
+	err = NewDenomTimelock(m.Delay, m.MintThreshold).Validate()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidTimelock, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactorySetTimelock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactorySetTimelock) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgCancelPendingAction creates a message to cancel a timelocked action before it is executed
+func NewMsgCancelPendingAction(sender, denom string, id uint64) *MsgTokenFactoryCancelPendingAction {
+	return &MsgTokenFactoryCancelPendingAction{
+		Sender: sender,
+		Denom:  denom,
+		Id:     id,
+	}
+}
+
+func (m MsgTokenFactoryCancelPendingAction) Route() string { return RouterKey }
+func (m MsgTokenFactoryCancelPendingAction) Type() string  { return TypeMsgCancelPendingAction }
+func (m MsgTokenFactoryCancelPendingAction) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+func (m MsgTokenFactoryCancelPendingAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryCancelPendingAction) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetMintRateLimit creates a message to cap the amount of a denom minted within a
+// rolling window
+func NewMsgSetMintRateLimit(sender, denom string, rateLimit MintRateLimit) *MsgTokenFactorySetMintRateLimit {
+	return &MsgTokenFactorySetMintRateLimit{
+		Sender:    sender,
+		Denom:     denom,
+		RateLimit: rateLimit,
+	}
+}
+
+func (m MsgTokenFactorySetMintRateLimit) Route() string { return RouterKey }
+func (m MsgTokenFactorySetMintRateLimit) Type() string  { return TypeMsgSetMintRateLimit }
+func (m MsgTokenFactorySetMintRateLimit) ValidateBasic() error {
+	err := validateDenomMsg(m.Sender, m.Denom)
+	if err != nil {
+		return err
+	}
+
+	err = m.RateLimit.Validate()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintRateLimit, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactorySetMintRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactorySetMintRateLimit) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUpdateParams creates a message to update the module params
+func NewMsgUpdateParams(authority string, params Params) *MsgTokenFactoryUpdateParams {
+	return &MsgTokenFactoryUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (m MsgTokenFactoryUpdateParams) Route() string { return RouterKey }
+func (m MsgTokenFactoryUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (m MsgTokenFactoryUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return m.Params.Validate()
+}
+
+func (m MsgTokenFactoryUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgRetireDenom creates a message to retire a denom with no supply
+func NewMsgRetireDenom(sender, denom string) *MsgTokenFactoryRetireDenom {
+	return &MsgTokenFactoryRetireDenom{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgTokenFactoryRetireDenom) Route() string { return RouterKey }
+func (m MsgTokenFactoryRetireDenom) Type() string  { return TypeMsgRetireDenom }
+func (m MsgTokenFactoryRetireDenom) ValidateBasic() error {
+	return validateDenomMsg(m.Sender, m.Denom)
+}
+
+func (m MsgTokenFactoryRetireDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryRetireDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgBatchMint creates a message to mint a denom to many addresses at once
+func NewMsgBatchMint(sender, denom string, entries []BatchEntry) *MsgTokenFactoryBatchMint {
+	return &MsgTokenFactoryBatchMint{
+		Sender:  sender,
+		Denom:   denom,
+		Entries: entries,
+	}
+}
+
+func (m MsgTokenFactoryBatchMint) Route() string { return RouterKey }
+func (m MsgTokenFactoryBatchMint) Type() string  { return TypeMsgBatchMint }
+func (m MsgTokenFactoryBatchMint) ValidateBasic() error {
+	err := validateDenomMsg(m.Sender, m.Denom)
+	if err != nil {
+		return err
+	}
+
+	err = validateBatchEntries(m.Entries)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryBatchMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryBatchMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgBatchBurn creates a message to burn a denom from many addresses at once
+func NewMsgBatchBurn(sender, denom string, entries []BatchEntry) *MsgTokenFactoryBatchBurn {
+	return &MsgTokenFactoryBatchBurn{
+		Sender:  sender,
+		Denom:   denom,
+		Entries: entries,
+	}
+}
+
+func (m MsgTokenFactoryBatchBurn) Route() string { return RouterKey }
+func (m MsgTokenFactoryBatchBurn) Type() string  { return TypeMsgBatchBurn }
+func (m MsgTokenFactoryBatchBurn) ValidateBasic() error {
+	err := validateDenomMsg(m.Sender, m.Denom)
+	if err != nil {
+		return err
+	}
+
+	err = validateBatchEntries(m.Entries)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryBatchBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryBatchBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgBatchForceTransfer creates a message to move a denom from many addresses to a single one
+// at once
+func NewMsgBatchForceTransfer(sender, denom string, entries []BatchEntry, transferTo string) *MsgTokenFactoryBatchForceTransfer {
+	return &MsgTokenFactoryBatchForceTransfer{
+		Sender:            sender,
+		Denom:             denom,
+		Entries:           entries,
+		TransferToAddress: transferTo,
+	}
+}
+
+func (m MsgTokenFactoryBatchForceTransfer) Route() string { return RouterKey }
+func (m MsgTokenFactoryBatchForceTransfer) Type() string  { return TypeMsgBatchForceTransfer }
+func (m MsgTokenFactoryBatchForceTransfer) ValidateBasic() error {
+	err := validateDenomMsg(m.Sender, m.Denom)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.TransferToAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid to address (%s)", err)
+	}
+
+	err = validateBatchEntries(m.Entries)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgTokenFactoryBatchForceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTokenFactoryBatchForceTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateMinterMsg(sender, denom, minter string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(minter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
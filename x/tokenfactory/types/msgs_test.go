@@ -2,8 +2,11 @@ package types_test
 
 import (
 	fmt "fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -67,6 +70,62 @@ func TestMsgCreateDenom(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "with max supply",
+			msg: createMsg(func(msg types.MsgTokenFactoryCreateDenom) types.MsgTokenFactoryCreateDenom {
+				msg.MaxSupply = sdk.NewInt(21_000_000)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative max supply",
+			msg: createMsg(func(msg types.MsgTokenFactoryCreateDenom) types.MsgTokenFactoryCreateDenom {
+				msg.MaxSupply = sdk.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "with renounced capabilities",
+			msg: createMsg(func(msg types.MsgTokenFactoryCreateDenom) types.MsgTokenFactoryCreateDenom {
+				msg.RenouncedCapabilities = []types.DenomCapability{types.CapabilityMintable, types.CapabilityForceTransferable}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "duplicate renounced capability",
+			msg: createMsg(func(msg types.MsgTokenFactoryCreateDenom) types.MsgTokenFactoryCreateDenom {
+				msg.RenouncedCapabilities = []types.DenomCapability{types.CapabilityMintable, types.CapabilityMintable}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unspecified renounced capability",
+			msg: createMsg(func(msg types.MsgTokenFactoryCreateDenom) types.MsgTokenFactoryCreateDenom {
+				msg.RenouncedCapabilities = []types.DenomCapability{types.CapabilityUnspecified}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "with fee denom",
+			msg: createMsg(func(msg types.MsgTokenFactoryCreateDenom) types.MsgTokenFactoryCreateDenom {
+				msg.FeeDenom = "uosmo"
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid fee denom",
+			msg: createMsg(func(msg types.MsgTokenFactoryCreateDenom) types.MsgTokenFactoryCreateDenom {
+				msg.FeeDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -140,6 +199,30 @@ func TestMsgMint(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "with reference id",
+			msg: createMsg(func(msg types.MsgTokenFactoryMint) types.MsgTokenFactoryMint {
+				msg.ReferenceId = "payroll-2023-01:1"
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid reference id",
+			msg: createMsg(func(msg types.MsgTokenFactoryMint) types.MsgTokenFactoryMint {
+				msg.ReferenceId = "payroll|1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "reference id too long",
+			msg: createMsg(func(msg types.MsgTokenFactoryMint) types.MsgTokenFactoryMint {
+				msg.ReferenceId = strings.Repeat("a", types.MaxReferenceIDLength+1)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -210,6 +293,24 @@ func TestMsgBurn(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "with reference id",
+			msg: func() *types.MsgTokenFactoryBurn {
+				msg := types.NewMsgBurn(addr1.String(), sdk.NewCoin("bitcoin", sdk.NewInt(500000000)))
+				msg.ReferenceId = "payroll-2023-01:1"
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid reference id",
+			msg: func() *types.MsgTokenFactoryBurn {
+				msg := types.NewMsgBurn(addr1.String(), sdk.NewCoin("bitcoin", sdk.NewInt(500000000)))
+				msg.ReferenceId = "payroll|1"
+				return msg
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -221,7 +322,7 @@ func TestMsgBurn(t *testing.T) {
 	}
 }
 
-// TestMsgChangeAdmin tests if valid/invalid create denom messages are properly validated/invalidated
+// TestMsgChangeAdmin tests if valid/invalid change admin messages are properly validated/invalidated
 func TestMsgChangeAdmin(t *testing.T) {
 	// generate a private/public key pair and get the respective address
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -234,7 +335,8 @@ func TestMsgChangeAdmin(t *testing.T) {
 	baseMsg := types.NewMsgChangeAdmin(
 		addr1.String(),
 		tokenFactoryDenom,
-		addr2.String(),
+		"",
+		true,
 	)
 
 	// validate changeAdmin message was created as intended
@@ -252,35 +354,127 @@ func TestMsgChangeAdmin(t *testing.T) {
 		{
 			name: "proper msg",
 			msg: func() *types.MsgTokenFactoryChangeAdmin {
-				msg := baseMsg
-				return msg
+				msg := *baseMsg
+				return &msg
 			},
 			expectPass: true,
 		},
 		{
 			name: "empty sender",
 			msg: func() *types.MsgTokenFactoryChangeAdmin {
-				msg := baseMsg
+				msg := *baseMsg
 				msg.Sender = ""
-				return msg
+				return &msg
 			},
 			expectPass: false,
 		},
 		{
-			name: "empty newAdmin",
+			name: "non-empty newAdmin",
 			msg: func() *types.MsgTokenFactoryChangeAdmin {
-				msg := baseMsg
-				msg.NewAdmin = ""
-				return msg
+				msg := *baseMsg
+				msg.NewAdmin = addr2.String()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "renounce not confirmed",
+			msg: func() *types.MsgTokenFactoryChangeAdmin {
+				msg := *baseMsg
+				msg.ConfirmRenounce = false
+				return &msg
 			},
 			expectPass: false,
 		},
 		{
 			name: "invalid denom",
 			msg: func() *types.MsgTokenFactoryChangeAdmin {
-				msg := baseMsg
+				msg := *baseMsg
 				msg.Denom = "bitcoin"
-				return msg
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgProposeAdmin tests if valid/invalid propose admin messages are properly validated/invalidated
+func TestMsgProposeAdmin(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper proposeAdmin message
+	baseMsg := types.NewMsgProposeAdmin(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate proposeAdmin message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "propose_admin")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgTokenFactoryProposeAdmin
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgTokenFactoryProposeAdmin {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgTokenFactoryProposeAdmin {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty newAdmin",
+			msg: func() *types.MsgTokenFactoryProposeAdmin {
+				msg := *baseMsg
+				msg.NewAdmin = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "newAdmin is sender",
+			msg: func() *types.MsgTokenFactoryProposeAdmin {
+				msg := *baseMsg
+				msg.NewAdmin = addr1.String()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgTokenFactoryProposeAdmin {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
 			},
 			expectPass: false,
 		},
@@ -400,3 +594,1072 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgGrantRole tests if valid/invalid grant role messages are properly validated/invalidated
+func TestMsgGrantRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper grantRole message
+	createMsg := func(after func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+		properMsg := *types.NewMsgGrantRole(
+			addr1.String(),
+			tokenFactoryDenom,
+			types.RoleMinter,
+			addr2.String(),
+		)
+
+		return after(properMsg)
+	}
+
+	// validate grantRole message was created as intended
+	msg := createMsg(func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "grant_role")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTokenFactoryGrantRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: createMsg(func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+				msg.Sender = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty address",
+			msg: createMsg(func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+				msg.Address = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+				msg.Denom = "bitcoin"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unspecified role",
+			msg: createMsg(func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+				msg.Role = types.RoleUnspecified
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: createMsg(func(msg types.MsgTokenFactoryGrantRole) types.MsgTokenFactoryGrantRole {
+				msg.Role = types.DenomRole(100)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestParseDenomRole(t *testing.T) {
+	for _, role := range types.DelegableRoles() {
+		parsed, err := types.ParseDenomRole(role.ShortName())
+		require.NoError(t, err)
+		require.Equal(t, role, parsed)
+
+		parsed, err = types.ParseDenomRole(role.String())
+		require.NoError(t, err)
+		require.Equal(t, role, parsed)
+	}
+
+	require.Equal(t, "force-transferrer", types.RoleForceTransferrer.ShortName())
+
+	_, err := types.ParseDenomRole("unspecified")
+	require.Error(t, err)
+	_, err = types.ParseDenomRole("admin")
+	require.Error(t, err)
+}
+
+func TestParseDenomCapability(t *testing.T) {
+	for _, capability := range types.AllCapabilities() {
+		parsed, err := types.ParseDenomCapability(capability.ShortName())
+		require.NoError(t, err)
+		require.Equal(t, capability, parsed)
+
+		parsed, err = types.ParseDenomCapability(capability.String())
+		require.NoError(t, err)
+		require.Equal(t, capability, parsed)
+	}
+
+	require.Equal(t, "burnable-from-others", types.CapabilityBurnableFromOthers.ShortName())
+
+	_, err := types.ParseDenomCapability("unspecified")
+	require.Error(t, err)
+	_, err = types.ParseDenomCapability("burnable")
+	require.Error(t, err)
+}
+
+// TestMsgRenounceCapability tests if valid/invalid renounce capability messages are properly
+// validated/invalidated
+func TestMsgRenounceCapability(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate renounce capability message was created as intended
+	msg := types.NewMsgRenounceCapability(addr1.String(), tokenFactoryDenom, types.CapabilityMintable)
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "renounce_capability")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name       string
+		sender     string
+		denom      string
+		capability types.DenomCapability
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			capability: types.CapabilityMetadataMutable,
+			expectPass: true,
+		},
+		{
+			name:       "empty sender",
+			sender:     "",
+			denom:      tokenFactoryDenom,
+			capability: types.CapabilityMintable,
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			sender:     addr1.String(),
+			denom:      "bitcoin",
+			capability: types.CapabilityMintable,
+			expectPass: false,
+		},
+		{
+			name:       "unspecified capability",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			capability: types.CapabilityUnspecified,
+			expectPass: false,
+		},
+		{
+			name:       "unknown capability",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			capability: types.DenomCapability(99),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := types.NewMsgRenounceCapability(test.sender, test.denom, test.capability)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetTimelock tests if valid/invalid set timelock messages are properly validated/invalidated
+func TestMsgSetTimelock(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate set timelock message was created as intended
+	msg := types.NewMsgSetTimelock(addr1.String(), tokenFactoryDenom, 48*time.Hour, sdk.NewInt(1000))
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_timelock")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name          string
+		sender        string
+		denom         string
+		delay         time.Duration
+		mintThreshold math.Int
+		expectPass    bool
+	}{
+		{
+			name:          "proper msg",
+			sender:        addr1.String(),
+			denom:         tokenFactoryDenom,
+			delay:         48 * time.Hour,
+			mintThreshold: sdk.NewInt(1000),
+			expectPass:    true,
+		},
+		{
+			name:          "removing the timelock",
+			sender:        addr1.String(),
+			denom:         tokenFactoryDenom,
+			delay:         0,
+			mintThreshold: sdk.ZeroInt(),
+			expectPass:    true,
+		},
+		{
+			name:          "empty sender",
+			sender:        "",
+			denom:         tokenFactoryDenom,
+			delay:         48 * time.Hour,
+			mintThreshold: sdk.ZeroInt(),
+			expectPass:    false,
+		},
+		{
+			name:          "invalid denom",
+			sender:        addr1.String(),
+			denom:         "bitcoin",
+			delay:         48 * time.Hour,
+			mintThreshold: sdk.ZeroInt(),
+			expectPass:    false,
+		},
+		{
+			name:          "negative delay",
+			sender:        addr1.String(),
+			denom:         tokenFactoryDenom,
+			delay:         -time.Hour,
+			mintThreshold: sdk.ZeroInt(),
+			expectPass:    false,
+		},
+		{
+			name:          "negative mint threshold",
+			sender:        addr1.String(),
+			denom:         tokenFactoryDenom,
+			delay:         48 * time.Hour,
+			mintThreshold: sdk.NewInt(-1),
+			expectPass:    false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := types.NewMsgSetTimelock(test.sender, test.denom, test.delay, test.mintThreshold)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetMintRateLimit tests if valid/invalid set mint rate limit messages are properly validated/invalidated
+func TestMsgSetMintRateLimit(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate set mint rate limit message was created as intended
+	msg := types.NewMsgSetMintRateLimit(addr1.String(), tokenFactoryDenom, types.NewBlockMintRateLimit(sdk.NewInt(1000), 100))
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_mint_rate_limit")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name       string
+		sender     string
+		denom      string
+		rateLimit  types.MintRateLimit
+		expectPass bool
+	}{
+		{
+			name:       "window in blocks",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			rateLimit:  types.NewBlockMintRateLimit(sdk.NewInt(1000), 100),
+			expectPass: true,
+		},
+		{
+			name:       "window in time",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			rateLimit:  types.NewTimeMintRateLimit(sdk.NewInt(1000), time.Hour),
+			expectPass: true,
+		},
+		{
+			name:       "removing the rate limit",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			rateLimit:  types.NewBlockMintRateLimit(sdk.ZeroInt(), 0),
+			expectPass: true,
+		},
+		{
+			name:       "empty sender",
+			sender:     "",
+			denom:      tokenFactoryDenom,
+			rateLimit:  types.NewBlockMintRateLimit(sdk.NewInt(1000), 100),
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			sender:     addr1.String(),
+			denom:      "bitcoin",
+			rateLimit:  types.NewBlockMintRateLimit(sdk.NewInt(1000), 100),
+			expectPass: false,
+		},
+		{
+			name:       "negative limit",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			rateLimit:  types.NewBlockMintRateLimit(sdk.NewInt(-1), 100),
+			expectPass: false,
+		},
+		{
+			name:       "no window",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			rateLimit:  types.NewBlockMintRateLimit(sdk.NewInt(1000), 0),
+			expectPass: false,
+		},
+		{
+			name:       "window in blocks and in time",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			rateLimit:  types.MintRateLimit{Limit: sdk.NewInt(1000), WindowBlocks: 100, WindowDuration: time.Hour},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := types.NewMsgSetMintRateLimit(test.sender, test.denom, test.rateLimit)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgConfigureMinter tests if valid/invalid configure minter messages are properly validated/invalidated
+func TestMsgUpdateParams(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// validate update params message was created as intended
+	msg := types.NewMsgUpdateParams(addr1.String(), types.DefaultParams())
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "update_params")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name       string
+		authority  string
+		params     types.Params
+		expectPass bool
+	}{
+		{
+			name:       "default params",
+			authority:  addr1.String(),
+			params:     types.DefaultParams(),
+			expectPass: true,
+		},
+		{
+			name:       "no creation fee",
+			authority:  addr1.String(),
+			params:     types.NewParams(sdk.NewCoins(), 0),
+			expectPass: true,
+		},
+		{
+			name:       "empty authority",
+			authority:  "",
+			params:     types.DefaultParams(),
+			expectPass: false,
+		},
+		{
+			name:       "invalid creation fee",
+			authority:  addr1.String(),
+			params:     types.NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, 0),
+			expectPass: false,
+		},
+		{
+			name:       "negative mint rate limit increase delay",
+			authority:  addr1.String(),
+			params:     types.NewParams(sdk.NewCoins(), -time.Hour),
+			expectPass: false,
+		},
+		{
+			name:      "allowlist mode",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:      sdk.NewCoins(),
+				DenomCreationMode:     types.CreationModeAllowlist,
+				CreatorAllowlist:      []string{addr1.String()},
+				AllowedCreatorCodeIds: []uint64{1, 2},
+			},
+			expectPass: true,
+		},
+		{
+			name:      "invalid denom creation mode",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:  sdk.NewCoins(),
+				DenomCreationMode: types.DenomCreationMode(5),
+			},
+			expectPass: false,
+		},
+		{
+			name:      "invalid allowed creator",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee: sdk.NewCoins(),
+				CreatorAllowlist: []string{"invalid"},
+			},
+			expectPass: false,
+		},
+		{
+			name:      "duplicate allowed creator",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee: sdk.NewCoins(),
+				CreatorAllowlist: []string{addr1.String(), addr1.String()},
+			},
+			expectPass: false,
+		},
+		{
+			name:      "fee split",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:               sdk.NewCoins(),
+				DenomCreationFeeBurnRatio:      sdk.NewDecWithPrec(5, 1),
+				DenomCreationFeeRecipient:      addr1.String(),
+				DenomCreationFeeRecipientRatio: sdk.NewDecWithPrec(5, 1),
+			},
+			expectPass: true,
+		},
+		{
+			name:      "fee split above one",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:               sdk.NewCoins(),
+				DenomCreationFeeBurnRatio:      sdk.NewDecWithPrec(6, 1),
+				DenomCreationFeeRecipient:      addr1.String(),
+				DenomCreationFeeRecipientRatio: sdk.NewDecWithPrec(5, 1),
+			},
+			expectPass: false,
+		},
+		{
+			name:      "negative fee burn ratio",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:          sdk.NewCoins(),
+				DenomCreationFeeBurnRatio: sdk.NewDec(-1),
+			},
+			expectPass: false,
+		},
+		{
+			name:      "fee recipient ratio without a recipient",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:               sdk.NewCoins(),
+				DenomCreationFeeRecipientRatio: sdk.NewDecWithPrec(5, 1),
+			},
+			expectPass: false,
+		},
+		{
+			name:      "invalid fee recipient",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:          sdk.NewCoins(),
+				DenomCreationFeeRecipient: "invalid",
+			},
+			expectPass: false,
+		},
+		{
+			name:      "zero allowed creator code id",
+			authority: addr1.String(),
+			params: types.Params{
+				DenomCreationFee:      sdk.NewCoins(),
+				AllowedCreatorCodeIds: []uint64{0},
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := types.NewMsgUpdateParams(test.authority, test.params)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgConfigureMinter(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper configureMinter message
+	baseMsg := types.NewMsgConfigureMinter(addr1.String(), tokenFactoryDenom, addr2.String(), sdk.NewInt(100), sdk.NewInt(10), time.Hour)
+
+	// validate configureMinter message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "configure_minter")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgTokenFactoryConfigureMinter
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgTokenFactoryConfigureMinter {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "no replenishment",
+			msg: func() *types.MsgTokenFactoryConfigureMinter {
+				msg := *baseMsg
+				msg.ReplenishAmount = sdk.ZeroInt()
+				msg.ReplenishPeriod = 0
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid minter",
+			msg: func() *types.MsgTokenFactoryConfigureMinter {
+				msg := *baseMsg
+				msg.Minter = "moose"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative allowance",
+			msg: func() *types.MsgTokenFactoryConfigureMinter {
+				msg := *baseMsg
+				msg.Allowance = sdk.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "replenish amount without period",
+			msg: func() *types.MsgTokenFactoryConfigureMinter {
+				msg := *baseMsg
+				msg.ReplenishPeriod = 0
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative replenish period",
+			msg: func() *types.MsgTokenFactoryConfigureMinter {
+				msg := *baseMsg
+				msg.ReplenishPeriod = -time.Hour
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgTokenFactoryConfigureMinter {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetMaxSupply tests if valid/invalid set max supply messages are properly validated/invalidated
+func TestMsgSetMaxSupply(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMaxSupply message
+	baseMsg := types.NewMsgSetMaxSupply(addr1.String(), tokenFactoryDenom, sdk.NewInt(21_000_000))
+
+	// validate setMaxSupply message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_max_supply")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgTokenFactorySetMaxSupply
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgTokenFactorySetMaxSupply {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgTokenFactorySetMaxSupply {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgTokenFactorySetMaxSupply {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero max supply",
+			msg: func() *types.MsgTokenFactorySetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdk.ZeroInt()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative max supply",
+			msg: func() *types.MsgTokenFactorySetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdk.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgPause tests if valid/invalid pause and unpause messages are properly validated/invalidated
+func TestMsgPause(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate pause and unpause messages were created as intended
+	pauseMsg := types.NewMsgPause(addr1.String(), tokenFactoryDenom)
+	require.Equal(t, pauseMsg.Route(), types.RouterKey)
+	require.Equal(t, pauseMsg.Type(), "pause")
+	require.Equal(t, pauseMsg.GetSigners(), []sdk.AccAddress{addr1})
+	unpauseMsg := types.NewMsgUnpause(addr1.String(), tokenFactoryDenom)
+	require.Equal(t, unpauseMsg.Route(), types.RouterKey)
+	require.Equal(t, unpauseMsg.Type(), "unpause")
+	require.Equal(t, unpauseMsg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name       string
+		sender     string
+		denom      string
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			expectPass: true,
+		},
+		{
+			name:       "empty sender",
+			sender:     "",
+			denom:      tokenFactoryDenom,
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			sender:     addr1.String(),
+			denom:      "bitcoin",
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		for _, msg := range []sdk.Msg{types.NewMsgPause(test.sender, test.denom), types.NewMsgUnpause(test.sender, test.denom)} {
+			if test.expectPass {
+				require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+			} else {
+				require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+			}
+		}
+	}
+}
+
+// TestMsgRetireDenom tests if valid/invalid retire denom messages are properly validated/invalidated
+func TestMsgRetireDenom(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate retire denom message was created as intended
+	msg := types.NewMsgRetireDenom(addr1.String(), tokenFactoryDenom)
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "retire_denom")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name       string
+		msg        *types.MsgTokenFactoryRetireDenom
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.NewMsgRetireDenom(addr1.String(), tokenFactoryDenom),
+			expectPass: true,
+		},
+		{
+			name:       "empty sender",
+			msg:        types.NewMsgRetireDenom("", tokenFactoryDenom),
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			msg:        types.NewMsgRetireDenom(addr1.String(), "bitcoin"),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgAddresses tests if valid/invalid freeze, unfreeze and allowlist messages are properly
+// validated/invalidated
+func TestMsgAddresses(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate the messages were created as intended
+	freezeMsg := types.NewMsgFreeze(addr1.String(), tokenFactoryDenom, []string{addr2.String()})
+	require.Equal(t, freezeMsg.Route(), types.RouterKey)
+	require.Equal(t, freezeMsg.Type(), "freeze")
+	require.Equal(t, freezeMsg.GetSigners(), []sdk.AccAddress{addr1})
+	unfreezeMsg := types.NewMsgUnfreeze(addr1.String(), tokenFactoryDenom, []string{addr2.String()})
+	require.Equal(t, unfreezeMsg.Route(), types.RouterKey)
+	require.Equal(t, unfreezeMsg.Type(), "unfreeze")
+	require.Equal(t, unfreezeMsg.GetSigners(), []sdk.AccAddress{addr1})
+	addToAllowlistMsg := types.NewMsgAddToAllowlist(addr1.String(), tokenFactoryDenom, []string{addr2.String()})
+	require.Equal(t, addToAllowlistMsg.Route(), types.RouterKey)
+	require.Equal(t, addToAllowlistMsg.Type(), "add_to_allowlist")
+	require.Equal(t, addToAllowlistMsg.GetSigners(), []sdk.AccAddress{addr1})
+	removeFromAllowlistMsg := types.NewMsgRemoveFromAllowlist(addr1.String(), tokenFactoryDenom, []string{addr2.String()})
+	require.Equal(t, removeFromAllowlistMsg.Route(), types.RouterKey)
+	require.Equal(t, removeFromAllowlistMsg.Type(), "remove_from_allowlist")
+	require.Equal(t, removeFromAllowlistMsg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name       string
+		sender     string
+		denom      string
+		addresses  []string
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			addresses:  []string{addr1.String(), addr2.String()},
+			expectPass: true,
+		},
+		{
+			name:       "empty sender",
+			sender:     "",
+			denom:      tokenFactoryDenom,
+			addresses:  []string{addr2.String()},
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			sender:     addr1.String(),
+			denom:      "bitcoin",
+			addresses:  []string{addr2.String()},
+			expectPass: false,
+		},
+		{
+			name:       "no addresses",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			addresses:  nil,
+			expectPass: false,
+		},
+		{
+			name:       "invalid address",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			addresses:  []string{"invalid"},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate address",
+			sender:     addr1.String(),
+			denom:      tokenFactoryDenom,
+			addresses:  []string{addr2.String(), addr2.String()},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		for _, msg := range []sdk.Msg{
+			types.NewMsgFreeze(test.sender, test.denom, test.addresses),
+			types.NewMsgUnfreeze(test.sender, test.denom, test.addresses),
+			types.NewMsgAddToAllowlist(test.sender, test.denom, test.addresses),
+			types.NewMsgRemoveFromAllowlist(test.sender, test.denom, test.addresses),
+		} {
+			if test.expectPass {
+				require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+			} else {
+				require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+			}
+		}
+	}
+}
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly
+// validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate set before send hook message was created as intended
+	msg := types.NewMsgSetBeforeSendHook(addr1.String(), tokenFactoryDenom, addr2.String())
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_before_send_hook")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{addr1})
+
+	tests := []struct {
+		name            string
+		sender          string
+		denom           string
+		cosmwasmAddress string
+		expectPass      bool
+	}{
+		{
+			name:            "proper msg",
+			sender:          addr1.String(),
+			denom:           tokenFactoryDenom,
+			cosmwasmAddress: addr2.String(),
+			expectPass:      true,
+		},
+		{
+			name:            "hook removal",
+			sender:          addr1.String(),
+			denom:           tokenFactoryDenom,
+			cosmwasmAddress: "",
+			expectPass:      true,
+		},
+		{
+			name:            "empty sender",
+			sender:          "",
+			denom:           tokenFactoryDenom,
+			cosmwasmAddress: addr2.String(),
+			expectPass:      false,
+		},
+		{
+			name:            "invalid denom",
+			sender:          addr1.String(),
+			denom:           "bitcoin",
+			cosmwasmAddress: addr2.String(),
+			expectPass:      false,
+		},
+		{
+			name:            "invalid cosmwasm address",
+			sender:          addr1.String(),
+			denom:           tokenFactoryDenom,
+			cosmwasmAddress: "invalid",
+			expectPass:      false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := types.NewMsgSetBeforeSendHook(test.sender, test.denom, test.cosmwasmAddress)
+		if test.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMinterAllowanceReplenish(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	allowance := types.NewMinterAllowance("", sdk.NewInt(100), sdk.NewInt(10), time.Hour, start)
+	allowance.Remaining = sdk.ZeroInt()
+
+	require.Equal(t, allowance, allowance.Replenish(start.Add(time.Hour-time.Nanosecond)))
+
+	replenished := allowance.Replenish(start.Add(2*time.Hour + time.Minute))
+	require.Equal(t, sdk.NewInt(20), replenished.Remaining)
+	require.Equal(t, start.Add(2*time.Hour), replenished.LastReplenished)
+
+	replenished = allowance.Replenish(start.Add(1000 * time.Hour))
+	require.Equal(t, sdk.NewInt(100), replenished.Remaining)
+
+	allowance.ReplenishAmount = sdk.ZeroInt()
+	require.Equal(t, allowance, allowance.Replenish(start.Add(1000*time.Hour)))
+}
+
+// TestMsgBatch tests if valid/invalid batch mint, burn and force transfer messages are properly
+// validated/invalidated
+func TestMsgBatch(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	entries := []types.BatchEntry{
+		types.NewBatchEntry(addr1.String(), sdk.NewInt(100)),
+		types.NewBatchEntry(addr2.String(), sdk.NewInt(200)),
+	}
+
+	// validate the messages were created as intended
+	batchMintMsg := types.NewMsgBatchMint(addr1.String(), tokenFactoryDenom, entries)
+	require.Equal(t, batchMintMsg.Route(), types.RouterKey)
+	require.Equal(t, batchMintMsg.Type(), "batch_mint")
+	require.Equal(t, batchMintMsg.GetSigners(), []sdk.AccAddress{addr1})
+	batchBurnMsg := types.NewMsgBatchBurn(addr1.String(), tokenFactoryDenom, entries)
+	require.Equal(t, batchBurnMsg.Route(), types.RouterKey)
+	require.Equal(t, batchBurnMsg.Type(), "batch_burn")
+	require.Equal(t, batchBurnMsg.GetSigners(), []sdk.AccAddress{addr1})
+	batchForceTransferMsg := types.NewMsgBatchForceTransfer(addr1.String(), tokenFactoryDenom, entries, addr2.String())
+	require.Equal(t, batchForceTransferMsg.Route(), types.RouterKey)
+	require.Equal(t, batchForceTransferMsg.Type(), "batch_force_transfer")
+	require.Equal(t, batchForceTransferMsg.GetSigners(), []sdk.AccAddress{addr1})
+	require.Equal(t, sdk.NewInt(300), types.BatchEntriesTotal(entries))
+
+	tests := []struct {
+		name       string
+		entries    []types.BatchEntry
+		denom      string
+		transferTo string
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			entries:    entries,
+			denom:      tokenFactoryDenom,
+			transferTo: addr2.String(),
+			expectPass: true,
+		},
+		{
+			name:       "no entries",
+			entries:    nil,
+			denom:      tokenFactoryDenom,
+			transferTo: addr2.String(),
+			expectPass: false,
+		},
+		{
+			name:       "invalid entry address",
+			entries:    []types.BatchEntry{types.NewBatchEntry("invalid", sdk.NewInt(100))},
+			denom:      tokenFactoryDenom,
+			transferTo: addr2.String(),
+			expectPass: false,
+		},
+		{
+			name:       "zero entry amount",
+			entries:    []types.BatchEntry{types.NewBatchEntry(addr2.String(), sdk.ZeroInt())},
+			denom:      tokenFactoryDenom,
+			transferTo: addr2.String(),
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			entries:    entries,
+			denom:      "bitcoin",
+			transferTo: addr2.String(),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msgs := []sdk.Msg{
+			types.NewMsgBatchMint(addr1.String(), test.denom, test.entries),
+			types.NewMsgBatchBurn(addr1.String(), test.denom, test.entries),
+			types.NewMsgBatchForceTransfer(addr1.String(), test.denom, test.entries, test.transferTo),
+		}
+		for _, msg := range msgs {
+			if test.expectPass {
+				require.NoError(t, msg.ValidateBasic(), "test: %v", test.name)
+			} else {
+				require.Error(t, msg.ValidateBasic(), "test: %v", test.name)
+			}
+		}
+	}
+
+	// the address to transfer to must be valid
+	require.Error(t, types.NewMsgBatchForceTransfer(addr1.String(), tokenFactoryDenom, entries, "invalid").ValidateBasic())
+}
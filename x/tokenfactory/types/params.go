@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -9,36 +10,158 @@ import (
 
 // Parameter store keys.
 var (
-	KeyDenomCreationFee     = []byte("DenomCreationFee")
-	DefaultCreationFeeDenom = sdk.DefaultBondDenom
+	KeyDenomCreationFee               = []byte("DenomCreationFee")
+	DefaultCreationFeeDenom           = sdk.DefaultBondDenom
+	DefaultMintRateLimitIncreaseDelay = 24 * time.Hour
+	DefaultReferenceIDRetention       = 7 * 24 * time.Hour
+	DefaultAuditLogMaxEntries         = uint64(1000)
+	DefaultBeforeSendHookGasLimit     = uint64(500_000)
 )
 
-// ParamTable for gamm module.
+// ParamKeyTable for the tokenfactory module. The params are no longer managed by the x/params
+// module, so it is only used to migrate them out of it.
+//
+// Deprecated: the params are stored in the module store and updated through MsgUpdateParams.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(denomCreationFee sdk.Coins) Params {
+func NewParams(denomCreationFee sdk.Coins, mintRateLimitIncreaseDelay time.Duration) Params {
 	return Params{
-		DenomCreationFee: denomCreationFee,
+		DenomCreationFee:               denomCreationFee,
+		MintRateLimitIncreaseDelay:     mintRateLimitIncreaseDelay,
+		DenomCreationFeeBurnRatio:      sdk.ZeroDec(),
+		DenomCreationFeeRecipientRatio: sdk.ZeroDec(),
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin(DefaultCreationFeeDenom, 10_000_000)),
+		DenomCreationFee:               sdk.NewCoins(sdk.NewInt64Coin(DefaultCreationFeeDenom, 10_000_000)),
+		MintRateLimitIncreaseDelay:     DefaultMintRateLimitIncreaseDelay,
+		DenomCreationFeeBurnRatio:      sdk.ZeroDec(),
+		DenomCreationFeeRecipientRatio: sdk.ZeroDec(),
+		ReferenceIdRetention:           DefaultReferenceIDRetention,
+		AuditLogMaxEntries:             DefaultAuditLogMaxEntries,
+		BeforeSendHookGasLimit:         DefaultBeforeSendHookGasLimit,
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
 	err := validateDenomCreationFee(p.DenomCreationFee)
+	if err != nil {
+		return err
+	}
+
+	err = validateMintRateLimitIncreaseDelay(p.MintRateLimitIncreaseDelay)
+	if err != nil {
+		return err
+	}
+
+	err = validateDenomCreationMode(p.DenomCreationMode)
+	if err != nil {
+		return err
+	}
+
+	err = validateCreatorAllowlist(p.CreatorAllowlist)
+	if err != nil {
+		return err
+	}
+
+	err = validateAllowedCreatorCodeIDs(p.AllowedCreatorCodeIds)
+	if err != nil {
+		return err
+	}
+
+	err = validateReferenceIDRetention(p.ReferenceIdRetention)
+	if err != nil {
+		return err
+	}
+
+	err = validateProtectedAddresses(p.ProtectedAddresses)
+	if err != nil {
+		return err
+	}
+
+	return validateDenomCreationFeeSplit(p)
+}
+
+// GetDenomCreationFeeOption returns the creation fee option paid in a denom, or the first option
+// if the denom is empty. It returns no coins if creating a denom is free.
+func (p Params) GetDenomCreationFeeOption(feeDenom string) (sdk.Coins, error) {
+	if len(p.DenomCreationFee) == 0 {
+		return sdk.NewCoins(), nil
+	}
+	if feeDenom == "" {
+		return sdk.NewCoins(p.DenomCreationFee[0]), nil
+	}
+
+	found, fee := p.DenomCreationFee.Find(feeDenom)
+	if !found {
+		return nil, ErrInvalidFeeDenom.Wrapf("%s is not one of %s", feeDenom, p.DenomCreationFee)
+	}
+	return sdk.NewCoins(fee), nil
+}
+
+// SplitDenomCreationFee splits a creation fee into the shares that are burned, sent to the fee
+// recipient and sent to the community pool
+func (p Params) SplitDenomCreationFee(fee sdk.Coins) (burned, recipient, communityPool sdk.Coins) {
+	burned = sdk.NewCoins()
+	recipient = sdk.NewCoins()
+	for _, coin := range fee {
+		burned = burned.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(decOrZero(p.DenomCreationFeeBurnRatio)).TruncateInt()))
+		if p.DenomCreationFeeRecipient != "" {
+			recipient = recipient.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(decOrZero(p.DenomCreationFeeRecipientRatio)).TruncateInt()))
+		}
+	}
+	return burned, recipient, fee.Sub(burned...).Sub(recipient...)
+}
+
+// BeforeSendHookGasLimitOrDefault returns the gas limit of the calls to the before send hooks,
+// or DefaultBeforeSendHookGasLimit if it is missing from the stored params
+func (p Params) BeforeSendHookGasLimitOrDefault() uint64 {
+	if p.BeforeSendHookGasLimit == 0 {
+		return DefaultBeforeSendHookGasLimit
+	}
+	return p.BeforeSendHookGasLimit
+}
 
-	return err
+// decOrZero returns zero for a ratio missing from the stored params
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
 }
 
-// Implements params.ParamSet.
+// IsCreatorAllowed returns true if an address, or a contract instantiated from a code id, can
+// create denoms. A codeID of zero means the address is not a contract.
+func (p Params) IsCreatorAllowed(address string, codeID uint64) bool {
+	if p.DenomCreationMode == CreationModePermissionless {
+		return true
+	}
+
+	for _, allowed := range p.CreatorAllowlist {
+		if allowed == address {
+			return true
+		}
+	}
+
+	if codeID == 0 {
+		return false
+	}
+	for _, allowed := range p.AllowedCreatorCodeIds {
+		if allowed == codeID {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements params.ParamSet. Only the denom creation fee was ever managed by the x/params module,
+// so it is the only param listed, as they are only read to be migrated.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
@@ -57,3 +180,124 @@ func validateDenomCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateMintRateLimitIncreaseDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("invalid mint rate limit increase delay: %s", v)
+	}
+
+	return nil
+}
+
+func validateReferenceIDRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("invalid reference id retention: %s", v)
+	}
+
+	return nil
+}
+
+func validateProtectedAddresses(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := map[string]bool{}
+	for _, address := range v {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid protected address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate protected address: %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+func validateDenomCreationMode(i interface{}) error {
+	v, ok := i.(DenomCreationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := DenomCreationMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid denom creation mode: %s", v)
+	}
+
+	return nil
+}
+
+func validateCreatorAllowlist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := map[string]bool{}
+	for _, address := range v {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid creator address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate creator address: %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+func validateDenomCreationFeeSplit(p Params) error {
+	burnRatio := decOrZero(p.DenomCreationFeeBurnRatio)
+	recipientRatio := decOrZero(p.DenomCreationFeeRecipientRatio)
+
+	if burnRatio.IsNegative() || recipientRatio.IsNegative() || burnRatio.Add(recipientRatio).GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid denom creation fee split: burn ratio %s and recipient ratio %s can't be negative and must add up to at most 1", burnRatio, recipientRatio)
+	}
+
+	if p.DenomCreationFeeRecipient == "" {
+		if recipientRatio.IsPositive() {
+			return fmt.Errorf("denom creation fee recipient ratio %s without a recipient", recipientRatio)
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.DenomCreationFeeRecipient); err != nil {
+		return fmt.Errorf("invalid denom creation fee recipient %s: %w", p.DenomCreationFeeRecipient, err)
+	}
+
+	return nil
+}
+
+func validateAllowedCreatorCodeIDs(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := map[uint64]bool{}
+	for _, codeID := range v {
+		if codeID == 0 {
+			return fmt.Errorf("invalid creator code id: %d", codeID)
+		}
+		if seen[codeID] {
+			return fmt.Errorf("duplicate creator code id: %d", codeID)
+		}
+		seen[codeID] = true
+	}
+
+	return nil
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,9 +30,78 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomCreationMode enumerates who can create denoms.
+type DenomCreationMode int32
+
+const (
+	// Any account can create denoms
+	CreationModePermissionless DenomCreationMode = 0
+	// Only the addresses on the creator allowlist, and the contracts
+	// instantiated from an allowed code id, can create denoms
+	CreationModeAllowlist DenomCreationMode = 1
+)
+
+var DenomCreationMode_name = map[int32]string{
+	0: "DENOM_CREATION_MODE_PERMISSIONLESS",
+	1: "DENOM_CREATION_MODE_ALLOWLIST",
+}
+
+var DenomCreationMode_value = map[string]int32{
+	"DENOM_CREATION_MODE_PERMISSIONLESS": 0,
+	"DENOM_CREATION_MODE_ALLOWLIST":      1,
+}
+
+func (x DenomCreationMode) String() string {
+	return proto.EnumName(DenomCreationMode_name, int32(x))
+}
+
+func (DenomCreationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cc8299d306f3ff47, []int{0}
+}
+
 // Params defines the parameters for the tokenfactory module.
 type Params struct {
+	// fee options for creating a denom, of which the creator pays one
 	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// delay before a loosened mint rate limit takes effect
+	MintRateLimitIncreaseDelay time.Duration `protobuf:"bytes,2,opt,name=mint_rate_limit_increase_delay,json=mintRateLimitIncreaseDelay,proto3,stdduration" json:"mint_rate_limit_increase_delay" yaml:"mint_rate_limit_increase_delay"`
+	// whether anyone can create denoms, or only the allowed creators
+	DenomCreationMode DenomCreationMode `protobuf:"varint,3,opt,name=denom_creation_mode,json=denomCreationMode,proto3,enum=osmosis.tokenfactory.v1beta1.DenomCreationMode" json:"denom_creation_mode,omitempty" yaml:"denom_creation_mode"`
+	// addresses allowed to create denoms in allowlist mode
+	CreatorAllowlist []string `protobuf:"bytes,4,rep,name=creator_allowlist,json=creatorAllowlist,proto3" json:"creator_allowlist,omitempty" yaml:"creator_allowlist"`
+	// code ids whose contracts are allowed to create denoms in allowlist mode
+	AllowedCreatorCodeIds []uint64 `protobuf:"varint,5,rep,packed,name=allowed_creator_code_ids,json=allowedCreatorCodeIds,proto3" json:"allowed_creator_code_ids,omitempty" yaml:"allowed_creator_code_ids"`
+	// maximum number of denoms an address can create, zero for no limit
+	MaxDenomsPerCreator uint64 `protobuf:"varint,6,opt,name=max_denoms_per_creator,json=maxDenomsPerCreator,proto3" json:"max_denoms_per_creator,omitempty" yaml:"max_denoms_per_creator"`
+	// maximum number of denoms created chain-wide in a block, zero for no limit
+	MaxCreationsPerBlock uint64 `protobuf:"varint,7,opt,name=max_creations_per_block,json=maxCreationsPerBlock,proto3" json:"max_creations_per_block,omitempty" yaml:"max_creations_per_block"`
+	// share of the creation fee that is burned
+	DenomCreationFeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=denom_creation_fee_burn_ratio,json=denomCreationFeeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"denom_creation_fee_burn_ratio" yaml:"denom_creation_fee_burn_ratio"`
+	// address receiving a share of the creation fee, if any
+	DenomCreationFeeRecipient string `protobuf:"bytes,9,opt,name=denom_creation_fee_recipient,json=denomCreationFeeRecipient,proto3" json:"denom_creation_fee_recipient,omitempty" yaml:"denom_creation_fee_recipient"`
+	// share of the creation fee sent to the fee recipient. The rest of the fee
+	// that is not burned goes to the community pool.
+	DenomCreationFeeRecipientRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=denom_creation_fee_recipient_ratio,json=denomCreationFeeRecipientRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"denom_creation_fee_recipient_ratio" yaml:"denom_creation_fee_recipient_ratio"`
+	// gas consumed when creating a denom, in addition to the creation fee
+	DenomCreationGasConsume uint64 `protobuf:"varint,11,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// whether the creation fee is held in escrow by the module account and
+	// refunded to the admin when the denom is retired, instead of being split
+	// between burning, the fee recipient and the community pool
+	DenomCreationFeeAsDeposit bool `protobuf:"varint,12,opt,name=denom_creation_fee_as_deposit,json=denomCreationFeeAsDeposit,proto3" json:"denom_creation_fee_as_deposit,omitempty" yaml:"denom_creation_fee_as_deposit"`
+	// whether the subdenom of a retired denom can be used again by its creator
+	AllowSubdenomReuse bool `protobuf:"varint,13,opt,name=allow_subdenom_reuse,json=allowSubdenomReuse,proto3" json:"allow_subdenom_reuse,omitempty" yaml:"allow_subdenom_reuse"`
+	// how long the reference id of a mint or burn is kept, during which it can't
+	// be used again for the same denom. Zero disables reference ids.
+	ReferenceIdRetention time.Duration `protobuf:"bytes,14,opt,name=reference_id_retention,json=referenceIdRetention,proto3,stdduration" json:"reference_id_retention" yaml:"reference_id_retention"`
+	// addresses whose balances can't be force transferred or burned by the
+	// admins of denoms, in addition to module accounts and ICS-20 escrow
+	// addresses
+	ProtectedAddresses []string `protobuf:"bytes,15,rep,name=protected_addresses,json=protectedAddresses,proto3" json:"protected_addresses,omitempty" yaml:"protected_addresses"`
+	// maximum number of entries kept in the audit log of each denom, beyond
+	// which the oldest entries are pruned. Zero disables the audit log.
+	AuditLogMaxEntries uint64 `protobuf:"varint,16,opt,name=audit_log_max_entries,json=auditLogMaxEntries,proto3" json:"audit_log_max_entries,omitempty" yaml:"audit_log_max_entries"`
+	// gas limit of the calls to the before send hooks of denoms
+	BeforeSendHookGasLimit uint64 `protobuf:"varint,17,opt,name=before_send_hook_gas_limit,json=beforeSendHookGasLimit,proto3" json:"before_send_hook_gas_limit,omitempty" yaml:"before_send_hook_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,7 +144,106 @@ func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *Params) GetMintRateLimitIncreaseDelay() time.Duration {
+	if m != nil {
+		return m.MintRateLimitIncreaseDelay
+	}
+	return 0
+}
+
+func (m *Params) GetDenomCreationMode() DenomCreationMode {
+	if m != nil {
+		return m.DenomCreationMode
+	}
+	return CreationModePermissionless
+}
+
+func (m *Params) GetCreatorAllowlist() []string {
+	if m != nil {
+		return m.CreatorAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedCreatorCodeIds() []uint64 {
+	if m != nil {
+		return m.AllowedCreatorCodeIds
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDenomsPerCreator() uint64 {
+	if m != nil {
+		return m.MaxDenomsPerCreator
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCreationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxCreationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetDenomCreationFeeRecipient() string {
+	if m != nil {
+		return m.DenomCreationFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetDenomCreationGasConsume() uint64 {
+	if m != nil {
+		return m.DenomCreationGasConsume
+	}
+	return 0
+}
+
+func (m *Params) GetDenomCreationFeeAsDeposit() bool {
+	if m != nil {
+		return m.DenomCreationFeeAsDeposit
+	}
+	return false
+}
+
+func (m *Params) GetAllowSubdenomReuse() bool {
+	if m != nil {
+		return m.AllowSubdenomReuse
+	}
+	return false
+}
+
+func (m *Params) GetReferenceIdRetention() time.Duration {
+	if m != nil {
+		return m.ReferenceIdRetention
+	}
+	return 0
+}
+
+func (m *Params) GetProtectedAddresses() []string {
+	if m != nil {
+		return m.ProtectedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetAuditLogMaxEntries() uint64 {
+	if m != nil {
+		return m.AuditLogMaxEntries
+	}
+	return 0
+}
+
+func (m *Params) GetBeforeSendHookGasLimit() uint64 {
+	if m != nil {
+		return m.BeforeSendHookGasLimit
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.DenomCreationMode", DenomCreationMode_name, DenomCreationMode_value)
 	proto.RegisterType((*Params)(nil), "osmosis.tokenfactory.v1beta1.Params")
 }
 
@@ -80,27 +252,75 @@ func init() {
 }
 
 var fileDescriptor_cc8299d306f3ff47 = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4e, 0x02, 0x41,
-	0x10, 0x86, 0xef, 0x62, 0x42, 0x81, 0x8d, 0x21, 0x16, 0x42, 0xcc, 0x62, 0xa8, 0xb0, 0xe0, 0x36,
-	0xa8, 0x89, 0x89, 0x25, 0x24, 0x76, 0x24, 0x6a, 0x69, 0x43, 0xe6, 0x8e, 0x01, 0x36, 0x78, 0x3b,
-	0x64, 0x77, 0x30, 0xde, 0x5b, 0x58, 0xd9, 0xdb, 0xfa, 0x24, 0x94, 0x94, 0x56, 0x68, 0xe0, 0x0d,
-	0x7c, 0x02, 0xc3, 0xee, 0x62, 0x30, 0x26, 0x56, 0xbb, 0x93, 0xf9, 0xff, 0x6f, 0x66, 0xfe, 0xf2,
-	0x29, 0xd9, 0x9c, 0xac, 0xb2, 0x92, 0x69, 0x82, 0x7a, 0x08, 0x19, 0x93, 0x29, 0xe4, 0x63, 0x3b,
-	0x45, 0x86, 0xb6, 0x9c, 0x82, 0x81, 0xdc, 0x26, 0x53, 0x43, 0x4c, 0x95, 0xe3, 0x20, 0x4d, 0x76,
-	0xa5, 0x49, 0x90, 0xd6, 0x0e, 0x47, 0x34, 0x22, 0x27, 0x94, 0x9b, 0x9f, 0xf7, 0xd4, 0x2e, 0xfe,
-	0xc5, 0xc3, 0x8c, 0xc7, 0x64, 0x14, 0x17, 0x3d, 0x64, 0x18, 0x00, 0x43, 0x70, 0x55, 0x33, 0x67,
-	0xeb, 0x7b, 0x9c, 0x2f, 0x42, 0x4b, 0xf8, 0x4a, 0xa6, 0x60, 0xf1, 0x87, 0x93, 0x91, 0xd2, 0xbe,
-	0xdf, 0x78, 0x8d, 0xcb, 0xa5, 0x1b, 0xb7, 0x75, 0xe5, 0x25, 0x2e, 0x57, 0x06, 0xa8, 0x29, 0xef,
-	0x67, 0x06, 0x81, 0x15, 0xe9, 0xfe, 0x10, 0xf1, 0x28, 0x3e, 0xd9, 0x6b, 0xee, 0x9f, 0x55, 0x93,
-	0x80, 0xdd, 0x80, 0xb6, 0x47, 0x24, 0x5d, 0x52, 0xba, 0xd3, 0x9b, 0x2f, 0xeb, 0xd1, 0xd7, 0xb2,
-	0x5e, 0x2d, 0x20, 0x7f, 0xb8, 0x6a, 0xfc, 0x45, 0x34, 0xde, 0x3e, 0xea, 0xcd, 0x91, 0xe2, 0xf1,
-	0x2c, 0x4d, 0x32, 0xca, 0xc3, 0x82, 0xe1, 0x69, 0xd9, 0xc1, 0x44, 0x72, 0x31, 0x45, 0xeb, 0x68,
-	0xf6, 0xee, 0xc0, 0x01, 0xba, 0xc1, 0x7f, 0x8d, 0xd8, 0xb9, 0x9d, 0xaf, 0x44, 0xbc, 0x58, 0x89,
-	0xf8, 0x73, 0x25, 0xe2, 0xe7, 0xb5, 0x88, 0x16, 0x6b, 0x11, 0xbd, 0xaf, 0x45, 0x74, 0x7f, 0xb9,
-	0x43, 0xd5, 0x64, 0x14, 0xb4, 0x34, 0xb2, 0xcf, 0xae, 0xb5, 0x0d, 0xef, 0xe9, 0x77, 0x96, 0x6e,
-	0x54, 0x5a, 0x72, 0xd7, 0x9f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x98, 0xa5, 0x63, 0x0e, 0xcf,
-	0x01, 0x00, 0x00,
+	// 1077 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x92, 0x10, 0x9a, 0x29, 0xb4, 0xc9, 0xe6, 0x4f, 0x37, 0x26, 0xd9, 0x75, 0xb7, 0x14,
+	0x1c, 0xa4, 0xd8, 0x4a, 0x41, 0x42, 0x42, 0x5c, 0xe2, 0x3f, 0x2d, 0x96, 0xec, 0x38, 0x5d, 0x57,
+	0x20, 0x10, 0xd2, 0x68, 0xbc, 0xfb, 0xe2, 0x2c, 0xf1, 0xee, 0x58, 0x33, 0x63, 0x48, 0xb8, 0x70,
+	0xe1, 0x80, 0x7a, 0xea, 0x09, 0x71, 0xa9, 0x38, 0x20, 0x71, 0xe0, 0x0c, 0xdf, 0xa1, 0xc7, 0x8a,
+	0x13, 0xe2, 0xb0, 0x45, 0xc9, 0x37, 0xd8, 0x4f, 0x80, 0x76, 0x76, 0x36, 0x75, 0x9d, 0x4d, 0x4a,
+	0x4f, 0xf6, 0xbc, 0xdf, 0xef, 0xfd, 0xde, 0xbc, 0x37, 0xef, 0xcd, 0x2c, 0xda, 0xa4, 0x3c, 0xa0,
+	0xdc, 0xe7, 0x55, 0x41, 0x0f, 0x21, 0xdc, 0x27, 0xae, 0xa0, 0xec, 0xb8, 0xfa, 0xcd, 0x76, 0x1f,
+	0x04, 0xd9, 0xae, 0x8e, 0x08, 0x23, 0x01, 0xaf, 0x8c, 0x18, 0x15, 0x54, 0x5f, 0x57, 0xd4, 0xca,
+	0x24, 0xb5, 0xa2, 0xa8, 0xc5, 0xe5, 0x01, 0x1d, 0x50, 0x49, 0xac, 0x26, 0xff, 0x52, 0x9f, 0xe2,
+	0x87, 0x97, 0xca, 0x93, 0xb1, 0x38, 0xa0, 0xcc, 0x17, 0xc7, 0x1d, 0x10, 0xc4, 0x23, 0x82, 0x28,
+	0xaf, 0x35, 0x57, 0xba, 0xe1, 0x54, 0x2e, 0x5d, 0x28, 0xc8, 0x4c, 0x57, 0xd5, 0x3e, 0xe1, 0x70,
+	0xa6, 0xe3, 0x52, 0x3f, 0xcc, 0xf0, 0x01, 0xa5, 0x83, 0x21, 0x54, 0xe5, 0xaa, 0x3f, 0xde, 0xaf,
+	0x7a, 0x63, 0x46, 0x84, 0x4f, 0x15, 0x6e, 0xff, 0x70, 0x1d, 0xcd, 0xed, 0xc9, 0xac, 0xf4, 0x9f,
+	0x34, 0xa4, 0x7b, 0x10, 0xd2, 0x00, 0xbb, 0x0c, 0x24, 0x07, 0xef, 0x03, 0x18, 0x5a, 0x69, 0xa6,
+	0x7c, 0xf5, 0xce, 0x5a, 0x45, 0x85, 0x4d, 0x02, 0x65, 0x49, 0x56, 0xea, 0xd4, 0x0f, 0x6b, 0x9d,
+	0x27, 0x91, 0x55, 0x88, 0x23, 0x6b, 0xed, 0x98, 0x04, 0xc3, 0x8f, 0xed, 0xf3, 0x12, 0xf6, 0xef,
+	0xcf, 0xac, 0xf2, 0xc0, 0x17, 0x07, 0xe3, 0x7e, 0xc5, 0xa5, 0x81, 0x4a, 0x40, 0xfd, 0x6c, 0x71,
+	0xef, 0xb0, 0x2a, 0x8e, 0x47, 0xc0, 0xa5, 0x1a, 0x77, 0x16, 0xa4, 0x40, 0x5d, 0xf9, 0xdf, 0x05,
+	0xd0, 0x1f, 0x69, 0xc8, 0x0c, 0xfc, 0x50, 0x60, 0x46, 0x04, 0xe0, 0xa1, 0x1f, 0xf8, 0x02, 0xfb,
+	0x61, 0x12, 0x81, 0x03, 0xf6, 0x60, 0x48, 0x8e, 0x8d, 0xd7, 0x4a, 0x9a, 0xdc, 0x64, 0x9a, 0x6d,
+	0x25, 0xcb, 0xb6, 0xd2, 0x50, 0xd9, 0xd6, 0xb6, 0xd5, 0x26, 0x6f, 0xa7, 0x9b, 0xbc, 0x5c, 0xce,
+	0xfe, 0xf9, 0x99, 0xa5, 0x39, 0xc5, 0x84, 0xe4, 0x10, 0x01, 0xed, 0x84, 0xd2, 0x52, 0x8c, 0x46,
+	0x42, 0xd0, 0xbf, 0x47, 0x4b, 0x53, 0x79, 0x06, 0xd4, 0x03, 0x63, 0xa6, 0xa4, 0x95, 0xaf, 0xdd,
+	0xa9, 0x56, 0x2e, 0xeb, 0x8c, 0x4a, 0x63, 0x32, 0xbf, 0x0e, 0xf5, 0xa0, 0x66, 0xc6, 0x91, 0x55,
+	0xcc, 0xad, 0x5e, 0xa2, 0x6a, 0x3b, 0x8b, 0xde, 0xb4, 0x8b, 0xde, 0x42, 0x8b, 0x92, 0x44, 0x19,
+	0x26, 0xc3, 0x21, 0xfd, 0x76, 0xe8, 0x73, 0x61, 0xcc, 0x96, 0x66, 0xca, 0xf3, 0xb5, 0xf5, 0x38,
+	0xb2, 0x8c, 0x54, 0xed, 0x1c, 0xc5, 0x76, 0x16, 0x94, 0x6d, 0x27, 0x33, 0xe9, 0x5f, 0x21, 0x43,
+	0xe2, 0xe0, 0xe1, 0x8c, 0xef, 0x52, 0x0f, 0xb0, 0xef, 0x71, 0xe3, 0xf5, 0xd2, 0x4c, 0x79, 0xb6,
+	0x76, 0x2b, 0x8e, 0x2c, 0x2b, 0x55, 0xbc, 0x88, 0x69, 0x3b, 0x2b, 0x0a, 0xaa, 0xa7, 0x48, 0x9d,
+	0x7a, 0xd0, 0xf2, 0xb8, 0xfe, 0x19, 0x5a, 0x0d, 0xc8, 0x11, 0x96, 0x19, 0x70, 0x3c, 0x02, 0x96,
+	0xb9, 0x1a, 0x73, 0x25, 0xad, 0x3c, 0x5b, 0xbb, 0x19, 0x47, 0xd6, 0x86, 0x3a, 0x94, 0x5c, 0x9e,
+	0xed, 0x2c, 0x05, 0xe4, 0x48, 0x16, 0x8d, 0xef, 0x01, 0x53, 0xf2, 0xfa, 0x17, 0xe8, 0x46, 0xc2,
+	0xcf, 0x2a, 0x95, 0xba, 0xf4, 0x87, 0xd4, 0x3d, 0x34, 0xde, 0x90, 0xc2, 0x76, 0x1c, 0x59, 0xe6,
+	0x73, 0xe1, 0x1c, 0xa2, 0xed, 0x2c, 0x07, 0xe4, 0x28, 0x2b, 0x6b, 0x22, 0x5e, 0x4b, 0xcc, 0xfa,
+	0x6f, 0x1a, 0xda, 0x38, 0xdf, 0xc5, 0xb8, 0x3f, 0x66, 0x21, 0x96, 0xed, 0x64, 0x5c, 0x29, 0x69,
+	0xe5, 0xf9, 0x9a, 0x97, 0xf4, 0xd4, 0x3f, 0x91, 0xf5, 0xee, 0xff, 0xe8, 0xed, 0x06, 0xb8, 0x71,
+	0x64, 0xbd, 0x73, 0xd1, 0x88, 0x4c, 0x88, 0xdb, 0x7f, 0xfd, 0xb1, 0x85, 0xd4, 0xb0, 0x35, 0xc0,
+	0x75, 0xd6, 0xa6, 0xe7, 0xa1, 0x36, 0x66, 0xa1, 0x93, 0x2c, 0xf4, 0x03, 0xb4, 0x9e, 0x23, 0xc5,
+	0xc0, 0xf5, 0x47, 0x3e, 0x84, 0xc2, 0x98, 0x97, 0xdb, 0x7c, 0x2f, 0x8e, 0xac, 0x5b, 0x17, 0x06,
+	0x3e, 0x63, 0xdb, 0xe7, 0x23, 0x39, 0x19, 0xa6, 0xff, 0xa9, 0xa1, 0x4b, 0x9d, 0x55, 0x5d, 0x90,
+	0x0c, 0xe8, 0xbf, 0x72, 0x5d, 0x36, 0x5f, 0xbe, 0xbd, 0xfc, 0xe2, 0x98, 0x17, 0x6e, 0x39, 0xad,
+	0x50, 0x1f, 0x15, 0xa7, 0x44, 0x07, 0x84, 0x63, 0x97, 0x86, 0x7c, 0x1c, 0x80, 0x71, 0x55, 0x36,
+	0xca, 0xed, 0x38, 0xb2, 0x6e, 0xe6, 0x6e, 0x60, 0x82, 0x6b, 0x3b, 0x37, 0x5e, 0x08, 0x75, 0x8f,
+	0xf0, 0x7a, 0x8a, 0xe8, 0x5f, 0xe7, 0x76, 0x0b, 0xe1, 0xd8, 0x83, 0x11, 0xe5, 0xbe, 0x30, 0xde,
+	0x2c, 0x69, 0xe5, 0x2b, 0xb5, 0xf2, 0xa5, 0xe7, 0xff, 0x9c, 0x9e, 0x73, 0x0e, 0x3b, 0xbc, 0x91,
+	0x62, 0xfa, 0x7d, 0xb4, 0x2c, 0xc7, 0x0c, 0xf3, 0x71, 0x3f, 0x55, 0x61, 0x30, 0xe6, 0x60, 0xbc,
+	0x25, 0x43, 0x58, 0x71, 0x64, 0xbd, 0x3d, 0x31, 0xa7, 0x53, 0x2c, 0xdb, 0xd1, 0xa5, 0xb9, 0xa7,
+	0xac, 0x4e, 0x62, 0xd4, 0xbf, 0x43, 0xab, 0x0c, 0xf6, 0x81, 0x41, 0xe8, 0x26, 0x93, 0x8c, 0x19,
+	0x08, 0x08, 0x93, 0xc0, 0xc6, 0xb5, 0x97, 0x5d, 0xaa, 0x9b, 0xea, 0x52, 0x55, 0xf3, 0x9b, 0x2f,
+	0x93, 0x5e, 0xa6, 0xcb, 0x67, 0x60, 0xcb, 0x73, 0x32, 0x48, 0xef, 0xa2, 0xa5, 0x44, 0x15, 0x5c,
+	0x01, 0x1e, 0x26, 0x9e, 0xc7, 0x80, 0x73, 0xe0, 0xc6, 0x75, 0x79, 0x8f, 0x4d, 0xdc, 0x8a, 0x39,
+	0x24, 0xdb, 0xd1, 0xcf, 0xac, 0x3b, 0x99, 0x51, 0xef, 0xa1, 0x15, 0x32, 0xf6, 0x7c, 0x81, 0x87,
+	0x74, 0x80, 0x93, 0xb1, 0x87, 0x50, 0x30, 0x1f, 0xb8, 0xb1, 0x20, 0x8f, 0xba, 0x14, 0x47, 0xd6,
+	0xba, 0x2a, 0x50, 0x1e, 0x2d, 0xa9, 0x50, 0x62, 0x6f, 0xd3, 0x41, 0x87, 0x1c, 0x35, 0x53, 0xa3,
+	0x4e, 0x50, 0xb1, 0x0f, 0xfb, 0x94, 0x01, 0xe6, 0x10, 0x7a, 0xf8, 0x80, 0xd2, 0x43, 0xd9, 0x1a,
+	0xf2, 0xe9, 0x30, 0x16, 0xa7, 0x9b, 0xe8, 0x62, 0xae, 0xed, 0xac, 0xa6, 0x60, 0x0f, 0x42, 0xef,
+	0x53, 0x4a, 0x0f, 0xef, 0x11, 0x2e, 0x1f, 0x97, 0xf7, 0x7f, 0xd1, 0xd0, 0xe2, 0xb9, 0x77, 0x41,
+	0xbf, 0x8b, 0xec, 0x46, 0x73, 0xb7, 0xdb, 0xc1, 0x75, 0xa7, 0xb9, 0xf3, 0xa0, 0xd5, 0xdd, 0xc5,
+	0x9d, 0x6e, 0xa3, 0x89, 0xf7, 0x9a, 0x4e, 0xa7, 0xd5, 0xeb, 0xb5, 0xba, 0xbb, 0xed, 0x66, 0xaf,
+	0xb7, 0x50, 0x28, 0x9a, 0x0f, 0x1f, 0x97, 0x8a, 0x93, 0x9e, 0x7b, 0xc0, 0x02, 0x9f, 0x73, 0x9f,
+	0x86, 0x43, 0xe0, 0x5c, 0xff, 0x04, 0x6d, 0xe4, 0xe9, 0xec, 0xb4, 0xdb, 0xdd, 0xcf, 0xdb, 0xad,
+	0xde, 0x83, 0x05, 0xad, 0xb8, 0xf6, 0xf0, 0x71, 0x69, 0x65, 0x52, 0xe2, 0xec, 0x7d, 0x28, 0xce,
+	0xfe, 0xf8, 0xab, 0x59, 0xa8, 0xdd, 0x7f, 0x72, 0x62, 0x6a, 0x4f, 0x4f, 0x4c, 0xed, 0xdf, 0x13,
+	0x53, 0x7b, 0x74, 0x6a, 0x16, 0x9e, 0x9e, 0x9a, 0x85, 0xbf, 0x4f, 0xcd, 0xc2, 0x97, 0x1f, 0x4d,
+	0x8c, 0x79, 0x48, 0x99, 0x4f, 0xb6, 0x42, 0x10, 0xe9, 0x07, 0xce, 0x56, 0xf6, 0x85, 0x73, 0xf4,
+	0xe2, 0x07, 0x8f, 0x9c, 0xfd, 0xfe, 0x9c, 0xec, 0xa8, 0x0f, 0xfe, 0x1b, 0x00, 0x8d, 0x1f, 0x0f,
+	0x74, 0x74, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +343,139 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BeforeSendHookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BeforeSendHookGasLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AuditLogMaxEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuditLogMaxEntries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ProtectedAddresses) > 0 {
+		for iNdEx := len(m.ProtectedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProtectedAddresses[iNdEx])
+			copy(dAtA[i:], m.ProtectedAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ProtectedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReferenceIdRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReferenceIdRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	if m.AllowSubdenomReuse {
+		i--
+		if m.AllowSubdenomReuse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DenomCreationFeeAsDeposit {
+		i--
+		if m.DenomCreationFeeAsDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DenomCreationGasConsume != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DenomCreationFeeRecipientRatio.Size()
+		i -= size
+		if _, err := m.DenomCreationFeeRecipientRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.DenomCreationFeeRecipient) > 0 {
+		i -= len(m.DenomCreationFeeRecipient)
+		copy(dAtA[i:], m.DenomCreationFeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DenomCreationFeeRecipient)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.DenomCreationFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.DenomCreationFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxCreationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCreationsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDenomsPerCreator))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowedCreatorCodeIds) > 0 {
+		dAtA3 := make([]byte, len(m.AllowedCreatorCodeIds)*10)
+		var j2 int
+		for _, num := range m.AllowedCreatorCodeIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintParams(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatorAllowlist) > 0 {
+		for iNdEx := len(m.CreatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.CreatorAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.CreatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DenomCreationMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MintRateLimitIncreaseDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintRateLimitIncreaseDelay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
 	if len(m.DenomCreationFee) > 0 {
 		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -163,6 +516,61 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintRateLimitIncreaseDelay)
+	n += 1 + l + sovParams(uint64(l))
+	if m.DenomCreationMode != 0 {
+		n += 1 + sovParams(uint64(m.DenomCreationMode))
+	}
+	if len(m.CreatorAllowlist) > 0 {
+		for _, s := range m.CreatorAllowlist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedCreatorCodeIds) > 0 {
+		l = 0
+		for _, e := range m.AllowedCreatorCodeIds {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxDenomsPerCreator))
+	}
+	if m.MaxCreationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxCreationsPerBlock))
+	}
+	l = m.DenomCreationFeeBurnRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.DenomCreationFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.DenomCreationFeeRecipientRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DenomCreationGasConsume != 0 {
+		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
+	}
+	if m.DenomCreationFeeAsDeposit {
+		n += 2
+	}
+	if m.AllowSubdenomReuse {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReferenceIdRetention)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.ProtectedAddresses) > 0 {
+		for _, s := range m.ProtectedAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AuditLogMaxEntries != 0 {
+		n += 2 + sovParams(uint64(m.AuditLogMaxEntries))
+	}
+	if m.BeforeSendHookGasLimit != 0 {
+		n += 2 + sovParams(uint64(m.BeforeSendHookGasLimit))
+	}
 	return n
 }
 
@@ -235,6 +643,466 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitIncreaseDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MintRateLimitIncreaseDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationMode", wireType)
+			}
+			m.DenomCreationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomCreationMode |= DenomCreationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorAllowlist = append(m.CreatorAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedCreatorCodeIds = append(m.AllowedCreatorCodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AllowedCreatorCodeIds) == 0 {
+					m.AllowedCreatorCodeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedCreatorCodeIds = append(m.AllowedCreatorCodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCreatorCodeIds", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerCreator", wireType)
+			}
+			m.MaxDenomsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreationsPerBlock", wireType)
+			}
+			m.MaxCreationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCreationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomCreationFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeRecipientRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomCreationFeeRecipientRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationGasConsume", wireType)
+			}
+			m.DenomCreationGasConsume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomCreationGasConsume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeAsDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenomCreationFeeAsDeposit = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSubdenomReuse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSubdenomReuse = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceIdRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ReferenceIdRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtectedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtectedAddresses = append(m.ProtectedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogMaxEntries", wireType)
+			}
+			m.AuditLogMaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditLogMaxEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookGasLimit", wireType)
+			}
+			m.BeforeSendHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
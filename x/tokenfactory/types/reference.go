@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// MaxReferenceIDLength is the maximum length of the reference id of a mint or burn
+const MaxReferenceIDLength = 64
+
+// reference ids are restricted to characters that can't clash with the key separator
+var referenceIDRegex = regexp.MustCompile(`^[a-zA-Z0-9_.:-]+$`)
+
+// ValidateReferenceID returns an error if a client-supplied reference id is malformed
+func ValidateReferenceID(referenceID string) error {
+	if len(referenceID) > MaxReferenceIDLength {
+		return fmt.Errorf("reference id is longer than %d characters: %s", MaxReferenceIDLength, referenceID)
+	}
+
+	if !referenceIDRegex.MatchString(referenceID) {
+		return fmt.Errorf("invalid reference id: %s", referenceID)
+	}
+
+	return nil
+}
+
+func (r ReferenceRecord) Validate() error {
+	err := ValidateReferenceID(r.ReferenceId)
+	if err != nil {
+		return err
+	}
+
+	if r.Action != TypeMsgMint && r.Action != TypeMsgBurn {
+		return fmt.Errorf("invalid reference action: %s", r.Action)
+	}
+
+	if r.Height < 0 {
+		return fmt.Errorf("invalid reference height: %d", r.Height)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the reference id can be used again at the given time
+func (r ReferenceRecord) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(r.ExpireTime)
+}
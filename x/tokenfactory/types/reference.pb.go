@@ -0,0 +1,564 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/reference.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReferenceRecord records the transaction that used a client-supplied reference
+// id to mint or burn a denom. The reference id can't be used again for the
+// denom until the record expires.
+type ReferenceRecord struct {
+	ReferenceId string `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty" yaml:"reference_id"`
+	// type of the message that used the reference id, either tf_mint or tf_burn
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty" yaml:"action"`
+	// hash of the transaction, hex encoded
+	TxHash     string    `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	Height     int64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	ExpireTime time.Time `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time" yaml:"expire_time"`
+}
+
+func (m *ReferenceRecord) Reset()         { *m = ReferenceRecord{} }
+func (m *ReferenceRecord) String() string { return proto.CompactTextString(m) }
+func (*ReferenceRecord) ProtoMessage()    {}
+func (*ReferenceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ed89a9cd40e2b80, []int{0}
+}
+func (m *ReferenceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferenceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferenceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferenceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceRecord.Merge(m, src)
+}
+func (m *ReferenceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferenceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceRecord proto.InternalMessageInfo
+
+func (m *ReferenceRecord) GetReferenceId() string {
+	if m != nil {
+		return m.ReferenceId
+	}
+	return ""
+}
+
+func (m *ReferenceRecord) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ReferenceRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReferenceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReferenceRecord) GetExpireTime() time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*ReferenceRecord)(nil), "osmosis.tokenfactory.v1beta1.ReferenceRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/reference.proto", fileDescriptor_5ed89a9cd40e2b80)
+}
+
+var fileDescriptor_5ed89a9cd40e2b80 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0xab, 0x40,
+	0x14, 0x86, 0x99, 0xb6, 0xb7, 0x37, 0x97, 0x5e, 0x35, 0xa2, 0x89, 0xa4, 0x31, 0x4c, 0xc3, 0xaa,
+	0x46, 0x0b, 0xa9, 0x2e, 0x4c, 0xba, 0xec, 0x4a, 0x97, 0x12, 0x57, 0xba, 0x68, 0x06, 0x3a, 0x85,
+	0x89, 0x85, 0x43, 0x60, 0x6a, 0xe8, 0x5b, 0xf4, 0x11, 0x7c, 0x07, 0x5f, 0xa2, 0xcb, 0x2e, 0x5d,
+	0xa1, 0x69, 0x37, 0xae, 0x79, 0x02, 0x03, 0x03, 0x0d, 0xee, 0x38, 0xe7, 0x7c, 0xff, 0x39, 0xcc,
+	0xff, 0xcb, 0x57, 0x10, 0xfb, 0x10, 0xb3, 0xd8, 0xe4, 0xf0, 0x42, 0x83, 0x19, 0x71, 0x38, 0x44,
+	0x4b, 0xf3, 0x75, 0x68, 0x53, 0x4e, 0x86, 0x66, 0x44, 0x67, 0x34, 0xa2, 0x81, 0x43, 0x8d, 0x30,
+	0x02, 0x0e, 0xca, 0x79, 0x49, 0x1b, 0x75, 0xda, 0x28, 0xe9, 0xee, 0xa9, 0x0b, 0x2e, 0x14, 0xa0,
+	0x99, 0x7f, 0x09, 0x4d, 0x17, 0xbb, 0x00, 0xee, 0x9c, 0x9a, 0x45, 0x65, 0x2f, 0x66, 0x26, 0x67,
+	0x3e, 0x8d, 0x39, 0xf1, 0x43, 0x01, 0xe8, 0xef, 0x0d, 0xf9, 0xc8, 0xaa, 0x0e, 0x59, 0xd4, 0x81,
+	0x68, 0xaa, 0x8c, 0xe4, 0xff, 0xfb, 0xdb, 0x13, 0x36, 0x55, 0x51, 0x0f, 0xf5, 0xff, 0x8d, 0xcf,
+	0xb2, 0x14, 0x9f, 0x2c, 0x89, 0x3f, 0x1f, 0xe9, 0xf5, 0xa9, 0x6e, 0x75, 0xf6, 0xe5, 0xfd, 0x54,
+	0xb9, 0x90, 0xdb, 0xc4, 0xe1, 0x0c, 0x02, 0xb5, 0x51, 0xa8, 0x8e, 0xb3, 0x14, 0x1f, 0x08, 0x95,
+	0xe8, 0xeb, 0x56, 0x09, 0x28, 0x97, 0xf2, 0x5f, 0x9e, 0x4c, 0x3c, 0x12, 0x7b, 0x6a, 0xb3, 0x60,
+	0x95, 0x2c, 0xc5, 0x87, 0x82, 0x2d, 0x07, 0xba, 0xd5, 0xe6, 0xc9, 0x1d, 0x89, 0xbd, 0x7c, 0xaf,
+	0x47, 0x99, 0xeb, 0x71, 0xb5, 0xd5, 0x43, 0xfd, 0x66, 0x7d, 0xaf, 0xe8, 0xeb, 0x56, 0x09, 0x28,
+	0xcf, 0x72, 0x87, 0x26, 0x21, 0x8b, 0xe8, 0x24, 0x7f, 0xac, 0xfa, 0xa7, 0x87, 0xfa, 0x9d, 0xeb,
+	0xae, 0x21, 0x9c, 0x30, 0x2a, 0x27, 0x8c, 0xc7, 0xca, 0x89, 0xb1, 0xb6, 0x4e, 0xb1, 0x94, 0xa5,
+	0x58, 0x11, 0xfb, 0x6a, 0x62, 0x7d, 0xf5, 0x89, 0x91, 0x25, 0x8b, 0x4e, 0x2e, 0x18, 0xb5, 0xbe,
+	0xdf, 0x30, 0x1a, 0x3f, 0xac, 0xb7, 0x1a, 0xda, 0x6c, 0x35, 0xf4, 0xb5, 0xd5, 0xd0, 0x6a, 0xa7,
+	0x49, 0x9b, 0x9d, 0x26, 0x7d, 0xec, 0x34, 0xe9, 0xe9, 0xd6, 0x65, 0xdc, 0x5b, 0xd8, 0x86, 0x03,
+	0xbe, 0x19, 0x40, 0xc4, 0xc8, 0x20, 0xa0, 0x5c, 0xe4, 0x3b, 0xa8, 0x02, 0x4e, 0x7e, 0xe7, 0xcd,
+	0x97, 0x21, 0x8d, 0xed, 0x76, 0xf1, 0x63, 0x37, 0x3f, 0x03, 0x00, 0xca, 0x9d, 0xfc, 0x7c, 0x14,
+	0x02, 0x00, 0x00,
+}
+
+func (this *ReferenceRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferenceRecord)
+	if !ok {
+		that2, ok := that.(ReferenceRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReferenceId != that1.ReferenceId {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.ExpireTime.Equal(that1.ExpireTime) {
+		return false
+	}
+	return true
+}
+func (m *ReferenceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferenceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferenceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpireTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReference(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintReference(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintReference(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintReference(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintReference(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReference(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReference(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReferenceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovReference(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovReference(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovReference(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReference(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpireTime)
+	n += 1 + l + sovReference(uint64(l))
+	return n
+}
+
+func sovReference(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReference(x uint64) (n int) {
+	return sovReference(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReferenceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReference
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferenceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferenceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReference(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReference
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReference(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReference
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReference
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReference
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReference
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReference
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReference
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReference        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReference          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReference = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPendingActionsPerBlock is the maximum number of timelocked actions executed at the end of a
+// block. The ready actions beyond it are executed in the following blocks, in order.
+const MaxPendingActionsPerBlock = 100
+
+// PendingActionGasLimit is the gas available to each timelocked action executed at the end of a
+// block, as its sender only paid for queuing it. An action running out of gas is dropped.
+const PendingActionGasLimit = uint64(5_000_000)
+
+// NewDenomTimelock returns a timelock delaying the sensitive admin actions over a denom,
+// including the mints of more than mintThreshold if it is positive.
+func NewDenomTimelock(delay time.Duration, mintThreshold math.Int) DenomTimelock {
+	return DenomTimelock{
+		Delay:         delay,
+		MintThreshold: mintThreshold,
+	}
+}
+
+func (t DenomTimelock) Validate() error {
+	if t.Delay < 0 {
+		return fmt.Errorf("invalid timelock delay: %s", t.Delay)
+	}
+
+	if !t.MintThreshold.IsNil() && t.MintThreshold.IsNegative() {
+		return fmt.Errorf("invalid timelock mint threshold: %s", t.MintThreshold)
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the sensitive admin actions are delayed
+func (t DenomTimelock) IsEnabled() bool {
+	return t.Delay > 0
+}
+
+// LocksMint returns true if minting the given amount is delayed by the timelock
+func (t DenomTimelock) LocksMint(amount math.Int) bool {
+	return t.IsEnabled() && !t.MintThreshold.IsNil() && t.MintThreshold.IsPositive() && amount.GT(t.MintThreshold)
+}
+
+// NewPendingAction wraps a timelocked message into a pending action executed after readyTime
+func NewPendingAction(id uint64, readyTime time.Time, msg sdk.Msg) (PendingAction, error) {
+	action := PendingAction{
+		Id:        id,
+		ReadyTime: readyTime,
+	}
+
+	switch msg := msg.(type) {
+	case *MsgTokenFactoryChangeAdmin:
+		action.Action = &PendingAction_ChangeAdmin{ChangeAdmin: msg}
+	case *MsgTokenFactoryProposeAdmin:
+		action.Action = &PendingAction_ProposeAdmin{ProposeAdmin: msg}
+	case *MsgTokenFactoryMint:
+		action.Action = &PendingAction_Mint{Mint: msg}
+	case *MsgTokenFactoryForceTransfer:
+		action.Action = &PendingAction_ForceTransfer{ForceTransfer: msg}
+	case *MsgTokenFactorySetDenomMetadata:
+		action.Action = &PendingAction_SetDenomMetadata{SetDenomMetadata: msg}
+	case *MsgTokenFactorySetTimelock:
+		action.Action = &PendingAction_SetTimelock{SetTimelock: msg}
+	case *MsgTokenFactoryBatchMint:
+		action.Action = &PendingAction_BatchMint{BatchMint: msg}
+	case *MsgTokenFactoryBatchForceTransfer:
+		action.Action = &PendingAction_BatchForceTransfer{BatchForceTransfer: msg}
+	case *MsgTokenFactoryGrantRole:
+		action.Action = &PendingAction_GrantRole{GrantRole: msg}
+	case *MsgTokenFactoryConfigureMinter:
+		action.Action = &PendingAction_ConfigureMinter{ConfigureMinter: msg}
+	case *MsgTokenFactoryIncreaseMinterAllowance:
+		action.Action = &PendingAction_IncreaseMinterAllowance{IncreaseMinterAllowance: msg}
+	case *MsgTokenFactorySetBeforeSendHook:
+		action.Action = &PendingAction_SetBeforeSendHook{SetBeforeSendHook: msg}
+	default:
+		return PendingAction{}, fmt.Errorf("%T can't be timelocked", msg)
+	}
+
+	action.Denom = action.msgDenom()
+	return action, nil
+}
+
+// GetMsg returns the timelocked message, or nil if the action is empty
+func (a PendingAction) GetMsg() sdk.Msg {
+	switch action := a.Action.(type) {
+	case *PendingAction_ChangeAdmin:
+		return action.ChangeAdmin
+	case *PendingAction_ProposeAdmin:
+		return action.ProposeAdmin
+	case *PendingAction_Mint:
+		return action.Mint
+	case *PendingAction_ForceTransfer:
+		return action.ForceTransfer
+	case *PendingAction_SetDenomMetadata:
+		return action.SetDenomMetadata
+	case *PendingAction_SetTimelock:
+		return action.SetTimelock
+	case *PendingAction_BatchMint:
+		return action.BatchMint
+	case *PendingAction_BatchForceTransfer:
+		return action.BatchForceTransfer
+	case *PendingAction_GrantRole:
+		return action.GrantRole
+	case *PendingAction_ConfigureMinter:
+		return action.ConfigureMinter
+	case *PendingAction_IncreaseMinterAllowance:
+		return action.IncreaseMinterAllowance
+	case *PendingAction_SetBeforeSendHook:
+		return action.SetBeforeSendHook
+	default:
+		return nil
+	}
+}
+
+// msgDenom returns the denom the timelocked message applies to
+func (a PendingAction) msgDenom() string {
+	switch action := a.Action.(type) {
+	case *PendingAction_ChangeAdmin:
+		return action.ChangeAdmin.Denom
+	case *PendingAction_ProposeAdmin:
+		return action.ProposeAdmin.Denom
+	case *PendingAction_Mint:
+		return action.Mint.Amount.Denom
+	case *PendingAction_ForceTransfer:
+		return action.ForceTransfer.Amount.Denom
+	case *PendingAction_SetDenomMetadata:
+		return action.SetDenomMetadata.Metadata.Base
+	case *PendingAction_SetTimelock:
+		return action.SetTimelock.Denom
+	case *PendingAction_BatchMint:
+		return action.BatchMint.Denom
+	case *PendingAction_BatchForceTransfer:
+		return action.BatchForceTransfer.Denom
+	case *PendingAction_GrantRole:
+		return action.GrantRole.Denom
+	case *PendingAction_ConfigureMinter:
+		return action.ConfigureMinter.Denom
+	case *PendingAction_IncreaseMinterAllowance:
+		return action.IncreaseMinterAllowance.Denom
+	case *PendingAction_SetBeforeSendHook:
+		return action.SetBeforeSendHook.Denom
+	default:
+		return ""
+	}
+}
+
+func (a PendingAction) Validate() error {
+	msg := a.GetMsg()
+	if msg == nil {
+		return fmt.Errorf("pending action %d has no message", a.Id)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.msgDenom() != a.Denom {
+		return fmt.Errorf("pending action %d of %s applies to %s", a.Id, a.Denom, a.msgDenom())
+	}
+
+	return nil
+}
@@ -0,0 +1,1716 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/timelock.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomTimelock delays the sensitive admin actions over a denom: admin changes,
+// role grants, minter allowance increases, before send hook changes, force
+// transfers, metadata changes and large mints are queued as pending actions,
+// and only executed once the delay has elapsed.
+type DenomTimelock struct {
+	// Delay between the submission of a sensitive action and its execution.
+	// Zero disables the timelock.
+	Delay time.Duration `protobuf:"bytes,1,opt,name=delay,proto3,stdduration" json:"delay" yaml:"delay"`
+	// Mints of more than this amount are timelocked. Zero leaves mints
+	// executing right away.
+	MintThreshold cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=mint_threshold,json=mintThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"mint_threshold" yaml:"mint_threshold"`
+}
+
+func (m *DenomTimelock) Reset()         { *m = DenomTimelock{} }
+func (m *DenomTimelock) String() string { return proto.CompactTextString(m) }
+func (*DenomTimelock) ProtoMessage()    {}
+func (*DenomTimelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64756efa26051292, []int{0}
+}
+func (m *DenomTimelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTimelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTimelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTimelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTimelock.Merge(m, src)
+}
+func (m *DenomTimelock) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTimelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTimelock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTimelock proto.InternalMessageInfo
+
+func (m *DenomTimelock) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+// PendingAction is a timelocked admin action over a denom, waiting to be
+// executed at the end of the first block after its ready time.
+type PendingAction struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// Block time after which the action is executed
+	ReadyTime time.Time `protobuf:"bytes,3,opt,name=ready_time,json=readyTime,proto3,stdtime" json:"ready_time" yaml:"ready_time"`
+	// The queued message, executed as if it was submitted at the ready time
+	//
+	// Types that are valid to be assigned to Action:
+	//	*PendingAction_ChangeAdmin
+	//	*PendingAction_ProposeAdmin
+	//	*PendingAction_Mint
+	//	*PendingAction_ForceTransfer
+	//	*PendingAction_SetDenomMetadata
+	//	*PendingAction_SetTimelock
+	//	*PendingAction_BatchMint
+	//	*PendingAction_BatchForceTransfer
+	//	*PendingAction_GrantRole
+	//	*PendingAction_ConfigureMinter
+	//	*PendingAction_IncreaseMinterAllowance
+	//	*PendingAction_SetBeforeSendHook
+	Action isPendingAction_Action `protobuf_oneof:"action"`
+}
+
+func (m *PendingAction) Reset()         { *m = PendingAction{} }
+func (m *PendingAction) String() string { return proto.CompactTextString(m) }
+func (*PendingAction) ProtoMessage()    {}
+func (*PendingAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64756efa26051292, []int{1}
+}
+func (m *PendingAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAction.Merge(m, src)
+}
+func (m *PendingAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAction proto.InternalMessageInfo
+
+type isPendingAction_Action interface {
+	isPendingAction_Action()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type PendingAction_ChangeAdmin struct {
+	ChangeAdmin *MsgTokenFactoryChangeAdmin `protobuf:"bytes,4,opt,name=change_admin,json=changeAdmin,proto3,oneof" json:"change_admin,omitempty"`
+}
+type PendingAction_ProposeAdmin struct {
+	ProposeAdmin *MsgTokenFactoryProposeAdmin `protobuf:"bytes,5,opt,name=propose_admin,json=proposeAdmin,proto3,oneof" json:"propose_admin,omitempty"`
+}
+type PendingAction_Mint struct {
+	Mint *MsgTokenFactoryMint `protobuf:"bytes,6,opt,name=mint,proto3,oneof" json:"mint,omitempty"`
+}
+type PendingAction_ForceTransfer struct {
+	ForceTransfer *MsgTokenFactoryForceTransfer `protobuf:"bytes,7,opt,name=force_transfer,json=forceTransfer,proto3,oneof" json:"force_transfer,omitempty"`
+}
+type PendingAction_SetDenomMetadata struct {
+	SetDenomMetadata *MsgTokenFactorySetDenomMetadata `protobuf:"bytes,8,opt,name=set_denom_metadata,json=setDenomMetadata,proto3,oneof" json:"set_denom_metadata,omitempty"`
+}
+type PendingAction_SetTimelock struct {
+	SetTimelock *MsgTokenFactorySetTimelock `protobuf:"bytes,9,opt,name=set_timelock,json=setTimelock,proto3,oneof" json:"set_timelock,omitempty"`
+}
+type PendingAction_BatchMint struct {
+	BatchMint *MsgTokenFactoryBatchMint `protobuf:"bytes,10,opt,name=batch_mint,json=batchMint,proto3,oneof" json:"batch_mint,omitempty"`
+}
+type PendingAction_BatchForceTransfer struct {
+	BatchForceTransfer *MsgTokenFactoryBatchForceTransfer `protobuf:"bytes,11,opt,name=batch_force_transfer,json=batchForceTransfer,proto3,oneof" json:"batch_force_transfer,omitempty"`
+}
+type PendingAction_GrantRole struct {
+	GrantRole *MsgTokenFactoryGrantRole `protobuf:"bytes,12,opt,name=grant_role,json=grantRole,proto3,oneof" json:"grant_role,omitempty"`
+}
+type PendingAction_ConfigureMinter struct {
+	ConfigureMinter *MsgTokenFactoryConfigureMinter `protobuf:"bytes,13,opt,name=configure_minter,json=configureMinter,proto3,oneof" json:"configure_minter,omitempty"`
+}
+type PendingAction_IncreaseMinterAllowance struct {
+	IncreaseMinterAllowance *MsgTokenFactoryIncreaseMinterAllowance `protobuf:"bytes,14,opt,name=increase_minter_allowance,json=increaseMinterAllowance,proto3,oneof" json:"increase_minter_allowance,omitempty"`
+}
+type PendingAction_SetBeforeSendHook struct {
+	SetBeforeSendHook *MsgTokenFactorySetBeforeSendHook `protobuf:"bytes,15,opt,name=set_before_send_hook,json=setBeforeSendHook,proto3,oneof" json:"set_before_send_hook,omitempty"`
+}
+
+func (*PendingAction_ChangeAdmin) isPendingAction_Action()             {}
+func (*PendingAction_ProposeAdmin) isPendingAction_Action()            {}
+func (*PendingAction_Mint) isPendingAction_Action()                    {}
+func (*PendingAction_ForceTransfer) isPendingAction_Action()           {}
+func (*PendingAction_SetDenomMetadata) isPendingAction_Action()        {}
+func (*PendingAction_SetTimelock) isPendingAction_Action()             {}
+func (*PendingAction_BatchMint) isPendingAction_Action()               {}
+func (*PendingAction_BatchForceTransfer) isPendingAction_Action()      {}
+func (*PendingAction_GrantRole) isPendingAction_Action()               {}
+func (*PendingAction_ConfigureMinter) isPendingAction_Action()         {}
+func (*PendingAction_IncreaseMinterAllowance) isPendingAction_Action() {}
+func (*PendingAction_SetBeforeSendHook) isPendingAction_Action()       {}
+
+func (m *PendingAction) GetAction() isPendingAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *PendingAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingAction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PendingAction) GetReadyTime() time.Time {
+	if m != nil {
+		return m.ReadyTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingAction) GetChangeAdmin() *MsgTokenFactoryChangeAdmin {
+	if x, ok := m.GetAction().(*PendingAction_ChangeAdmin); ok {
+		return x.ChangeAdmin
+	}
+	return nil
+}
+
+func (m *PendingAction) GetProposeAdmin() *MsgTokenFactoryProposeAdmin {
+	if x, ok := m.GetAction().(*PendingAction_ProposeAdmin); ok {
+		return x.ProposeAdmin
+	}
+	return nil
+}
+
+func (m *PendingAction) GetMint() *MsgTokenFactoryMint {
+	if x, ok := m.GetAction().(*PendingAction_Mint); ok {
+		return x.Mint
+	}
+	return nil
+}
+
+func (m *PendingAction) GetForceTransfer() *MsgTokenFactoryForceTransfer {
+	if x, ok := m.GetAction().(*PendingAction_ForceTransfer); ok {
+		return x.ForceTransfer
+	}
+	return nil
+}
+
+func (m *PendingAction) GetSetDenomMetadata() *MsgTokenFactorySetDenomMetadata {
+	if x, ok := m.GetAction().(*PendingAction_SetDenomMetadata); ok {
+		return x.SetDenomMetadata
+	}
+	return nil
+}
+
+func (m *PendingAction) GetSetTimelock() *MsgTokenFactorySetTimelock {
+	if x, ok := m.GetAction().(*PendingAction_SetTimelock); ok {
+		return x.SetTimelock
+	}
+	return nil
+}
+
+func (m *PendingAction) GetBatchMint() *MsgTokenFactoryBatchMint {
+	if x, ok := m.GetAction().(*PendingAction_BatchMint); ok {
+		return x.BatchMint
+	}
+	return nil
+}
+
+func (m *PendingAction) GetBatchForceTransfer() *MsgTokenFactoryBatchForceTransfer {
+	if x, ok := m.GetAction().(*PendingAction_BatchForceTransfer); ok {
+		return x.BatchForceTransfer
+	}
+	return nil
+}
+
+func (m *PendingAction) GetGrantRole() *MsgTokenFactoryGrantRole {
+	if x, ok := m.GetAction().(*PendingAction_GrantRole); ok {
+		return x.GrantRole
+	}
+	return nil
+}
+
+func (m *PendingAction) GetConfigureMinter() *MsgTokenFactoryConfigureMinter {
+	if x, ok := m.GetAction().(*PendingAction_ConfigureMinter); ok {
+		return x.ConfigureMinter
+	}
+	return nil
+}
+
+func (m *PendingAction) GetIncreaseMinterAllowance() *MsgTokenFactoryIncreaseMinterAllowance {
+	if x, ok := m.GetAction().(*PendingAction_IncreaseMinterAllowance); ok {
+		return x.IncreaseMinterAllowance
+	}
+	return nil
+}
+
+func (m *PendingAction) GetSetBeforeSendHook() *MsgTokenFactorySetBeforeSendHook {
+	if x, ok := m.GetAction().(*PendingAction_SetBeforeSendHook); ok {
+		return x.SetBeforeSendHook
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PendingAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PendingAction_ChangeAdmin)(nil),
+		(*PendingAction_ProposeAdmin)(nil),
+		(*PendingAction_Mint)(nil),
+		(*PendingAction_ForceTransfer)(nil),
+		(*PendingAction_SetDenomMetadata)(nil),
+		(*PendingAction_SetTimelock)(nil),
+		(*PendingAction_BatchMint)(nil),
+		(*PendingAction_BatchForceTransfer)(nil),
+		(*PendingAction_GrantRole)(nil),
+		(*PendingAction_ConfigureMinter)(nil),
+		(*PendingAction_IncreaseMinterAllowance)(nil),
+		(*PendingAction_SetBeforeSendHook)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*DenomTimelock)(nil), "osmosis.tokenfactory.v1beta1.DenomTimelock")
+	proto.RegisterType((*PendingAction)(nil), "osmosis.tokenfactory.v1beta1.PendingAction")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/timelock.proto", fileDescriptor_64756efa26051292)
+}
+
+var fileDescriptor_64756efa26051292 = []byte{
+	// 815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x6b, 0xe3, 0x46,
+	0x18, 0xc7, 0xa5, 0x34, 0x9b, 0xae, 0xc7, 0x76, 0x36, 0x3b, 0x64, 0xa9, 0x12, 0xba, 0xd6, 0x22,
+	0x68, 0x59, 0x28, 0x91, 0x48, 0x0b, 0x7d, 0x09, 0x7d, 0x21, 0xaa, 0xd9, 0xb5, 0x0f, 0x86, 0xad,
+	0x62, 0x68, 0x29, 0x14, 0x75, 0x24, 0x8d, 0xe5, 0xc1, 0xd2, 0x8c, 0x3b, 0x33, 0x6e, 0xd7, 0xc7,
+	0xf6, 0x13, 0xec, 0xb1, 0xc7, 0x7e, 0x88, 0x7e, 0x88, 0xbd, 0x14, 0x96, 0x9e, 0x4a, 0x0f, 0x6e,
+	0x49, 0x2e, 0x3d, 0xe7, 0x13, 0x94, 0x99, 0x91, 0x88, 0xe3, 0x5d, 0x16, 0x94, 0x9b, 0x9e, 0xb7,
+	0xdf, 0x7f, 0x9e, 0xe7, 0x19, 0x49, 0xe0, 0x3d, 0x26, 0x4a, 0x26, 0x88, 0x08, 0x24, 0x9b, 0x61,
+	0x3a, 0x41, 0xa9, 0x64, 0x7c, 0x19, 0xfc, 0x78, 0x9c, 0x60, 0x89, 0x8e, 0x03, 0x49, 0x4a, 0x5c,
+	0xb0, 0x74, 0xe6, 0xcf, 0x39, 0x93, 0x0c, 0xbe, 0x5d, 0x25, 0xfb, 0xeb, 0xc9, 0x7e, 0x95, 0x7c,
+	0xb8, 0x9f, 0xb3, 0x9c, 0xe9, 0xc4, 0x40, 0x3d, 0x99, 0x9a, 0xc3, 0x83, 0x54, 0x17, 0xc5, 0x26,
+	0x60, 0x8c, 0x2a, 0xd4, 0xcb, 0x19, 0xcb, 0x0b, 0x1c, 0x68, 0x2b, 0x59, 0x4c, 0x82, 0x6c, 0xc1,
+	0x91, 0x24, 0x8c, 0x56, 0x71, 0x77, 0x33, 0xae, 0x8e, 0x23, 0x24, 0x2a, 0xe7, 0x55, 0xc2, 0x3b,
+	0xaf, 0x3f, 0xfc, 0x53, 0x93, 0xe6, 0xfd, 0x61, 0x83, 0x6e, 0x1f, 0x53, 0x56, 0x8e, 0xab, 0x76,
+	0xe0, 0x10, 0xdc, 0xca, 0x70, 0x81, 0x96, 0x8e, 0xfd, 0xc0, 0x7e, 0xd8, 0x7e, 0xff, 0xc0, 0x37,
+	0x4a, 0x7e, 0xad, 0xe4, 0xf7, 0xab, 0x93, 0x84, 0xce, 0xf3, 0x95, 0x6b, 0x5d, 0xae, 0xdc, 0xce,
+	0x12, 0x95, 0xc5, 0x89, 0xa7, 0xab, 0xbc, 0x5f, 0xff, 0x71, 0xed, 0xc8, 0x10, 0xe0, 0x0c, 0xec,
+	0x96, 0x84, 0xca, 0x58, 0x4e, 0x39, 0x16, 0x53, 0x56, 0x64, 0xce, 0xd6, 0x03, 0xfb, 0x61, 0x2b,
+	0xec, 0xab, 0xc2, 0xbf, 0x57, 0xee, 0x3d, 0xd3, 0xb2, 0xc8, 0x66, 0x3e, 0x61, 0x41, 0x89, 0xe4,
+	0xd4, 0x1f, 0x52, 0x79, 0xb9, 0x72, 0xef, 0x19, 0xe2, 0xf5, 0x62, 0xef, 0xcf, 0xdf, 0x8f, 0x40,
+	0x35, 0xa4, 0x21, 0x95, 0x51, 0x57, 0x85, 0xc7, 0x75, 0xf4, 0x64, 0xfb, 0xbf, 0xdf, 0x5c, 0xdb,
+	0xfb, 0xb9, 0x0d, 0xba, 0x4f, 0x30, 0xcd, 0x08, 0xcd, 0x4f, 0x53, 0x75, 0x4a, 0x78, 0x1f, 0x6c,
+	0x91, 0x4c, 0x37, 0xb3, 0x1d, 0x76, 0x2f, 0x57, 0x6e, 0xcb, 0xb0, 0x49, 0xe6, 0x45, 0x5b, 0x24,
+	0x83, 0xef, 0xaa, 0x76, 0x29, 0x2b, 0xab, 0xa3, 0xed, 0xad, 0xf7, 0x43, 0x59, 0xe9, 0x45, 0x26,
+	0x0c, 0xbf, 0x01, 0x80, 0x63, 0x94, 0x2d, 0x63, 0x35, 0x68, 0xe7, 0x0d, 0x3d, 0x9b, 0xc3, 0x97,
+	0x66, 0x33, 0xae, 0xb7, 0x10, 0xde, 0xaf, 0x86, 0x73, 0xd7, 0xc0, 0xae, 0x6a, 0xbd, 0x67, 0x6a,
+	0x42, 0x2d, 0xed, 0x50, 0xe9, 0xf0, 0x3b, 0xd0, 0x49, 0xa7, 0x88, 0xe6, 0x38, 0x46, 0x59, 0x49,
+	0xa8, 0xb3, 0xad, 0xd9, 0x1f, 0xfb, 0xaf, 0xbb, 0x50, 0xfe, 0x48, 0xe4, 0x63, 0xe5, 0x7f, 0x64,
+	0xfc, 0x5f, 0x6a, 0xc0, 0xa9, 0xaa, 0x1f, 0x58, 0x51, 0x3b, 0xbd, 0x32, 0xe1, 0xf7, 0xa0, 0x3b,
+	0xe7, 0x6c, 0xce, 0x44, 0xcd, 0xbf, 0xa5, 0xf9, 0x9f, 0x34, 0xe2, 0x3f, 0x31, 0x84, 0x5a, 0xa0,
+	0x33, 0x5f, 0xb3, 0xe1, 0x63, 0xb0, 0xad, 0x56, 0xe1, 0xec, 0x68, 0xf0, 0x71, 0x23, 0xf0, 0x88,
+	0x50, 0x39, 0xb0, 0x22, 0x0d, 0x80, 0x29, 0xd8, 0x9d, 0x30, 0x9e, 0xe2, 0x58, 0x72, 0x44, 0xc5,
+	0x04, 0x73, 0xe7, 0x4d, 0x8d, 0x3c, 0x69, 0x84, 0x7c, 0xa4, 0x10, 0xe3, 0x8a, 0x30, 0xb0, 0xa2,
+	0xee, 0x64, 0xdd, 0x01, 0x4b, 0x00, 0x05, 0x96, 0xb1, 0xde, 0x6a, 0x5c, 0x62, 0x89, 0x32, 0x24,
+	0x91, 0x73, 0x5b, 0x0b, 0x7d, 0xd6, 0x48, 0xe8, 0x0c, 0x4b, 0xfd, 0xea, 0x8c, 0x2a, 0xc8, 0xc0,
+	0x8a, 0xf6, 0xc4, 0x86, 0x4f, 0x6d, 0x57, 0xc9, 0xd5, 0x5f, 0x0b, 0xa7, 0x75, 0x83, 0xed, 0x9e,
+	0x61, 0x59, 0xbf, 0x9e, 0x6a, 0xbb, 0xe2, 0xca, 0x84, 0x5f, 0x03, 0x90, 0x20, 0x99, 0x4e, 0x63,
+	0xbd, 0x01, 0xa0, 0xe1, 0x1f, 0x36, 0x82, 0x87, 0xaa, 0xbc, 0x5a, 0x43, 0x2b, 0xa9, 0x0d, 0x28,
+	0xc0, 0xbe, 0x01, 0x6f, 0x6c, 0xa4, 0xad, 0x25, 0xbe, 0x68, 0x2e, 0xb1, 0xb9, 0x16, 0x98, 0xbc,
+	0xe4, 0x55, 0xdd, 0xe4, 0x1c, 0x51, 0x19, 0x73, 0x56, 0x60, 0xa7, 0x73, 0x83, 0x6e, 0x1e, 0xab,
+	0xf2, 0x88, 0x15, 0x58, 0x75, 0x93, 0xd7, 0x06, 0x24, 0x60, 0x2f, 0x65, 0x74, 0x42, 0xf2, 0x05,
+	0xc7, 0x7a, 0x54, 0x98, 0x3b, 0x5d, 0x8d, 0xff, 0xb4, 0xd9, 0x7b, 0x56, 0x43, 0x46, 0x9a, 0x31,
+	0xb0, 0xa2, 0x3b, 0xe9, 0x75, 0x17, 0xfc, 0xc5, 0x06, 0x07, 0x84, 0xa6, 0x1c, 0x23, 0x51, 0x4b,
+	0xc5, 0xa8, 0x28, 0xd8, 0x4f, 0x88, 0xa6, 0xd8, 0xd9, 0xd5, 0xa2, 0xfd, 0x46, 0xa2, 0xc3, 0x8a,
+	0x66, 0x04, 0x4e, 0x6b, 0xd6, 0xc0, 0x8a, 0xde, 0x22, 0xaf, 0x0e, 0xc1, 0x1f, 0xc0, 0xbe, 0xba,
+	0x75, 0x09, 0x9e, 0x30, 0x8e, 0x63, 0x81, 0x69, 0x16, 0x4f, 0x19, 0x9b, 0x39, 0x77, 0xb4, 0xfc,
+	0xe7, 0x4d, 0x6f, 0x5f, 0xa8, 0x39, 0x67, 0x98, 0x66, 0x03, 0xc6, 0xd4, 0x1d, 0xbc, 0x2b, 0x36,
+	0x9d, 0xe1, 0x6d, 0xb0, 0x83, 0xf4, 0x17, 0x37, 0xfc, 0xea, 0xf9, 0x79, 0xcf, 0x7e, 0x71, 0xde,
+	0xb3, 0xff, 0x3d, 0xef, 0xd9, 0xcf, 0x2e, 0x7a, 0xd6, 0x8b, 0x8b, 0x9e, 0xf5, 0xd7, 0x45, 0xcf,
+	0xfa, 0xf6, 0xa3, 0x9c, 0xc8, 0xe9, 0x22, 0xf1, 0x53, 0x56, 0x06, 0x94, 0x71, 0x82, 0x8e, 0x28,
+	0x96, 0xe6, 0x0f, 0x75, 0x54, 0xff, 0xa2, 0x9e, 0x5e, 0xff, 0x63, 0xc9, 0xe5, 0x1c, 0x8b, 0x64,
+	0x47, 0x7f, 0x61, 0x3f, 0xf8, 0x7f, 0x00, 0xfa, 0xb6, 0x89, 0x0b, 0x93, 0x07, 0x00, 0x00,
+}
+
+func (this *DenomTimelock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTimelock)
+	if !ok {
+		that2, ok := that.(DenomTimelock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Delay != that1.Delay {
+		return false
+	}
+	if !this.MintThreshold.Equal(that1.MintThreshold) {
+		return false
+	}
+	return true
+}
+func (m *DenomTimelock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTimelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTimelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintThreshold.Size()
+		i -= size
+		if _, err := m.MintThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTimelock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTimelock(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		{
+			size := m.Action.Size()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReadyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReadyTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTimelock(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAction_ChangeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_ChangeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChangeAdmin != nil {
+		{
+			size, err := m.ChangeAdmin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_ProposeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_ProposeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProposeAdmin != nil {
+		{
+			size, err := m.ProposeAdmin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_Mint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_Mint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Mint != nil {
+		{
+			size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_ForceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_ForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ForceTransfer != nil {
+		{
+			size, err := m.ForceTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_SetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_SetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetDenomMetadata != nil {
+		{
+			size, err := m.SetDenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_SetTimelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_SetTimelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetTimelock != nil {
+		{
+			size, err := m.SetTimelock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_BatchMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_BatchMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BatchMint != nil {
+		{
+			size, err := m.BatchMint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_BatchForceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_BatchForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BatchForceTransfer != nil {
+		{
+			size, err := m.BatchForceTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_GrantRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_GrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GrantRole != nil {
+		{
+			size, err := m.GrantRole.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_ConfigureMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_ConfigureMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConfigureMinter != nil {
+		{
+			size, err := m.ConfigureMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_IncreaseMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_IncreaseMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IncreaseMinterAllowance != nil {
+		{
+			size, err := m.IncreaseMinterAllowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PendingAction_SetBeforeSendHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction_SetBeforeSendHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetBeforeSendHook != nil {
+		{
+			size, err := m.SetBeforeSendHook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintTimelock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimelock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomTimelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTimelock(uint64(l))
+	l = m.MintThreshold.Size()
+	n += 1 + l + sovTimelock(uint64(l))
+	return n
+}
+
+func (m *PendingAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTimelock(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReadyTime)
+	n += 1 + l + sovTimelock(uint64(l))
+	if m.Action != nil {
+		n += m.Action.Size()
+	}
+	return n
+}
+
+func (m *PendingAction_ChangeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangeAdmin != nil {
+		l = m.ChangeAdmin.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_ProposeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposeAdmin != nil {
+		l = m.ProposeAdmin.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_Mint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mint != nil {
+		l = m.Mint.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_ForceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForceTransfer != nil {
+		l = m.ForceTransfer.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_SetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetDenomMetadata != nil {
+		l = m.SetDenomMetadata.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_SetTimelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetTimelock != nil {
+		l = m.SetTimelock.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_BatchMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchMint != nil {
+		l = m.BatchMint.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_BatchForceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchForceTransfer != nil {
+		l = m.BatchForceTransfer.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_GrantRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GrantRole != nil {
+		l = m.GrantRole.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_ConfigureMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigureMinter != nil {
+		l = m.ConfigureMinter.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_IncreaseMinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncreaseMinterAllowance != nil {
+		l = m.IncreaseMinterAllowance.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *PendingAction_SetBeforeSendHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetBeforeSendHook != nil {
+		l = m.SetBeforeSendHook.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+
+func sovTimelock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimelock(x uint64) (n int) {
+	return sovTimelock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomTimelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTimelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTimelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReadyTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeAdmin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryChangeAdmin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_ChangeAdmin{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeAdmin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryProposeAdmin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_ProposeAdmin{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryMint{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_Mint{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryForceTransfer{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_ForceTransfer{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetDenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactorySetDenomMetadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_SetDenomMetadata{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetTimelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactorySetTimelock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_SetTimelock{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryBatchMint{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_BatchMint{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchForceTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryBatchForceTransfer{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_BatchForceTransfer{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantRole", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryGrantRole{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_GrantRole{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryConfigureMinter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_ConfigureMinter{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseMinterAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactoryIncreaseMinterAllowance{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_IncreaseMinterAllowance{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBeforeSendHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTokenFactorySetBeforeSendHook{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &PendingAction_SetBeforeSendHook{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimelock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimelock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimelock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimelock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimelock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimelock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimelock = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,24 +5,30 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -43,6 +49,18 @@ type MsgTokenFactoryCreateDenom struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	// subdenom can be up to 44 "alphanumeric" characters long.
 	Subdenom string `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty" yaml:"subdenom"`
+	// max_supply optionally caps the total supply of the denom. Zero means no
+	// cap. Once set, the cap can only be lowered.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// allowlist_enabled restricts the holders of the denom to the addresses on
+	// its allowlist. It can only be enabled at creation.
+	AllowlistEnabled bool `protobuf:"varint,4,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty" yaml:"allowlist_enabled"`
+	// renounced_capabilities are never held over the denom. Every other
+	// capability is held until the admin renounces it.
+	RenouncedCapabilities []DenomCapability `protobuf:"varint,5,rep,packed,name=renounced_capabilities,json=renouncedCapabilities,proto3,enum=osmosis.tokenfactory.v1beta1.DenomCapability" json:"renounced_capabilities,omitempty" yaml:"renounced_capabilities"`
+	// fee_denom picks which of the creation fee options is paid. If empty, the
+	// first option is paid.
+	FeeDenom string `protobuf:"bytes,6,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
 }
 
 func (m *MsgTokenFactoryCreateDenom) Reset()         { *m = MsgTokenFactoryCreateDenom{} }
@@ -92,6 +110,27 @@ func (m *MsgTokenFactoryCreateDenom) GetSubdenom() string {
 	return ""
 }
 
+func (m *MsgTokenFactoryCreateDenom) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+func (m *MsgTokenFactoryCreateDenom) GetRenouncedCapabilities() []DenomCapability {
+	if m != nil {
+		return m.RenouncedCapabilities
+	}
+	return nil
+}
+
+func (m *MsgTokenFactoryCreateDenom) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
 // MsgTokenFactoryCreateDenomResponse is the return value of MsgTokenFactoryCreateDenom
 // It returns the full string of the newly created denom
 type MsgTokenFactoryCreateDenomResponse struct {
@@ -144,6 +183,9 @@ type MsgTokenFactoryMint struct {
 	Sender        string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Amount        types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
 	MintToAddress string     `protobuf:"bytes,3,opt,name=mintToAddress,proto3" json:"mintToAddress,omitempty" yaml:"mint_to_address"`
+	// optional client-supplied id, which can't be used again for a mint or burn
+	// of the same denom within the reference id retention
+	ReferenceId string `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty" yaml:"reference_id"`
 }
 
 func (m *MsgTokenFactoryMint) Reset()         { *m = MsgTokenFactoryMint{} }
@@ -200,6 +242,13 @@ func (m *MsgTokenFactoryMint) GetMintToAddress() string {
 	return ""
 }
 
+func (m *MsgTokenFactoryMint) GetReferenceId() string {
+	if m != nil {
+		return m.ReferenceId
+	}
+	return ""
+}
+
 type MsgTokenFactoryMintResponse struct {
 }
 
@@ -242,6 +291,9 @@ type MsgTokenFactoryBurn struct {
 	Sender          string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Amount          types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
 	BurnFromAddress string     `protobuf:"bytes,3,opt,name=burnFromAddress,proto3" json:"burnFromAddress,omitempty" yaml:"burn_from_address"`
+	// optional client-supplied id, which can't be used again for a mint or burn
+	// of the same denom within the reference id retention
+	ReferenceId string `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty" yaml:"reference_id"`
 }
 
 func (m *MsgTokenFactoryBurn) Reset()         { *m = MsgTokenFactoryBurn{} }
@@ -298,6 +350,13 @@ func (m *MsgTokenFactoryBurn) GetBurnFromAddress() string {
 	return ""
 }
 
+func (m *MsgTokenFactoryBurn) GetReferenceId() string {
+	if m != nil {
+		return m.ReferenceId
+	}
+	return ""
+}
+
 type MsgTokenFactoryBurnResponse struct {
 }
 
@@ -334,12 +393,15 @@ func (m *MsgTokenFactoryBurnResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTokenFactoryBurnResponse proto.InternalMessageInfo
 
-// MsgTokenFactoryChangeAdmin is the sdk.Msg type for allowing an admin account to reassign
-// adminship of a denom to a new account
+// MsgTokenFactoryChangeAdmin is the sdk.Msg type for allowing an admin account to renounce
+// adminship of a denom. Handing adminship over to a new account is done in two
+// steps with MsgTokenFactoryProposeAdmin and MsgTokenFactoryAcceptAdmin, so
+// new_admin must be empty and confirm_renounce must be set.
 type MsgTokenFactoryChangeAdmin struct {
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-	NewAdmin string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty" yaml:"new_admin"`
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom           string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	NewAdmin        string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty" yaml:"new_admin"`
+	ConfirmRenounce bool   `protobuf:"varint,4,opt,name=confirm_renounce,json=confirmRenounce,proto3" json:"confirm_renounce,omitempty" yaml:"confirm_renounce"`
 }
 
 func (m *MsgTokenFactoryChangeAdmin) Reset()         { *m = MsgTokenFactoryChangeAdmin{} }
@@ -396,6 +458,13 @@ func (m *MsgTokenFactoryChangeAdmin) GetNewAdmin() string {
 	return ""
 }
 
+func (m *MsgTokenFactoryChangeAdmin) GetConfirmRenounce() bool {
+	if m != nil {
+		return m.ConfirmRenounce
+	}
+	return false
+}
+
 // MsgTokenFactoryChangeAdminResponse defines the response structure for an executed
 // MsgTokenFactoryChangeAdmin message.
 type MsgTokenFactoryChangeAdminResponse struct {
@@ -632,940 +701,12921 @@ func (m *MsgTokenFactoryForceTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTokenFactoryForceTransferResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgTokenFactoryCreateDenom)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCreateDenom")
-	proto.RegisterType((*MsgTokenFactoryCreateDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCreateDenomResponse")
-	proto.RegisterType((*MsgTokenFactoryMint)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryMint")
-	proto.RegisterType((*MsgTokenFactoryMintResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryMintResponse")
-	proto.RegisterType((*MsgTokenFactoryBurn)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBurn")
-	proto.RegisterType((*MsgTokenFactoryBurnResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBurnResponse")
-	proto.RegisterType((*MsgTokenFactoryChangeAdmin)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryChangeAdmin")
-	proto.RegisterType((*MsgTokenFactoryChangeAdminResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryChangeAdminResponse")
-	proto.RegisterType((*MsgTokenFactorySetDenomMetadata)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetDenomMetadata")
-	proto.RegisterType((*MsgTokenFactorySetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetDenomMetadataResponse")
-	proto.RegisterType((*MsgTokenFactoryForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryForceTransfer")
-	proto.RegisterType((*MsgTokenFactoryForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryForceTransferResponse")
+// MsgTokenFactoryGrantRole is the sdk.Msg type for allowing an admin account to grant
+// a role over the denom to another address
+type MsgTokenFactoryGrantRole struct {
+	Sender  string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom   string    `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Role    DenomRole `protobuf:"varint,3,opt,name=role,proto3,enum=osmosis.tokenfactory.v1beta1.DenomRole" json:"role,omitempty" yaml:"role"`
+	Address string    `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/tokenfactory/v1beta1/tx.proto", fileDescriptor_283b6c9a90a846b4)
+func (m *MsgTokenFactoryGrantRole) Reset()         { *m = MsgTokenFactoryGrantRole{} }
+func (m *MsgTokenFactoryGrantRole) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryGrantRole) ProtoMessage()    {}
+func (*MsgTokenFactoryGrantRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{12}
 }
-
-var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x02, 0xbf, 0x06, 0x86, 0x5f, 0x03, 0x2c, 0xa8, 0x75, 0x2d, 0xbb, 0x64, 0x82, 0x28,
-	0x07, 0xb6, 0x29, 0x1e, 0x54, 0x12, 0x13, 0x28, 0xda, 0x78, 0xb0, 0x07, 0x57, 0x4e, 0x5e, 0x9a,
-	0x69, 0x3b, 0x94, 0x0d, 0xee, 0x0c, 0xd9, 0x99, 0x0a, 0x7c, 0x04, 0x4f, 0x7a, 0xf4, 0x68, 0xfc,
-	0x0e, 0xfa, 0x19, 0x38, 0x78, 0x20, 0x9e, 0x3c, 0x6d, 0x0c, 0x7c, 0x83, 0xfd, 0x04, 0x66, 0x67,
-	0xf6, 0x5f, 0x77, 0x01, 0xb3, 0x78, 0xe0, 0x06, 0xfb, 0x3e, 0xcf, 0xf3, 0xbe, 0xcf, 0x3b, 0xef,
-	0xbc, 0x1d, 0x70, 0x9f, 0x32, 0x87, 0x32, 0x9b, 0xd5, 0x39, 0xdd, 0xc7, 0x64, 0x17, 0xf5, 0x38,
-	0x75, 0x8f, 0xeb, 0xef, 0x1b, 0x5d, 0xcc, 0x51, 0xa3, 0xce, 0x8f, 0xcc, 0x03, 0x97, 0x72, 0xaa,
-	0xd6, 0x42, 0x98, 0x99, 0x86, 0x99, 0x21, 0x4c, 0x5b, 0x18, 0xd0, 0x01, 0x15, 0xc0, 0x7a, 0xf0,
-	0x97, 0xe4, 0x68, 0x7a, 0x4f, 0x90, 0xea, 0x5d, 0xc4, 0x70, 0xac, 0xd8, 0xa3, 0x36, 0xc9, 0xc5,
-	0xc9, 0x7e, 0x1c, 0x0f, 0xfe, 0x91, 0x71, 0x78, 0x04, 0xb4, 0x36, 0x1b, 0xec, 0x04, 0x09, 0x5b,
-	0x32, 0xe1, 0xb6, 0x8b, 0x11, 0xc7, 0xcf, 0x31, 0xa1, 0x8e, 0xba, 0x0a, 0xca, 0x0c, 0x93, 0x3e,
-	0x76, 0xab, 0xca, 0x92, 0xf2, 0x70, 0xaa, 0x39, 0xe7, 0x7b, 0x46, 0xe5, 0x18, 0x39, 0xef, 0x36,
-	0xa0, 0xfc, 0x0e, 0xad, 0x10, 0xa0, 0xd6, 0xc1, 0x24, 0x1b, 0x76, 0xfb, 0x01, 0xad, 0x3a, 0x26,
-	0xc0, 0xf3, 0xbe, 0x67, 0xcc, 0x84, 0xe0, 0x30, 0x02, 0xad, 0x18, 0x04, 0xf7, 0x00, 0xbc, 0x3c,
-	0xb3, 0x85, 0xd9, 0x01, 0x25, 0x0c, 0xab, 0x4d, 0x30, 0x43, 0xf0, 0x61, 0x47, 0x74, 0xa4, 0x23,
-	0xd5, 0x65, 0x29, 0x9a, 0xef, 0x19, 0xb7, 0xa5, 0x7a, 0x06, 0x00, 0xad, 0x0a, 0xc1, 0x87, 0x42,
-	0x58, 0x68, 0xc1, 0x1f, 0x0a, 0x98, 0xcf, 0xa4, 0x6a, 0xdb, 0x84, 0x17, 0x71, 0xf7, 0x12, 0x94,
-	0x91, 0x43, 0x87, 0x84, 0x0b, 0x6f, 0xd3, 0xeb, 0x77, 0x4d, 0xd9, 0x57, 0x33, 0xe8, 0x7b, 0x74,
-	0x44, 0xe6, 0x36, 0xb5, 0x49, 0xf3, 0xd6, 0x89, 0x67, 0x94, 0x12, 0x25, 0x49, 0x83, 0x56, 0xc8,
-	0x57, 0x37, 0x41, 0xc5, 0xb1, 0x09, 0xdf, 0xa1, 0x5b, 0xfd, 0xbe, 0x8b, 0x19, 0xab, 0x8e, 0x67,
-	0xed, 0x04, 0xe1, 0x0e, 0xa7, 0x1d, 0x24, 0x01, 0xd0, 0x1a, 0x25, 0xc0, 0x45, 0x70, 0xef, 0x02,
-	0x37, 0x51, 0xc7, 0xe0, 0xcf, 0xbc, 0xdb, 0xe6, 0xd0, 0x25, 0x37, 0xe3, 0xb6, 0x05, 0x66, 0xba,
-	0x43, 0x97, 0xb4, 0x5c, 0xea, 0x8c, 0xfa, 0xad, 0xf9, 0x9e, 0x51, 0x95, 0x9c, 0x00, 0xd0, 0xd9,
-	0x75, 0xa9, 0x93, 0x38, 0xce, 0x92, 0x2e, 0xf0, 0x1c, 0x78, 0x8a, 0x3d, 0x7f, 0x55, 0xf2, 0x63,
-	0xbc, 0x87, 0xc8, 0x00, 0x6f, 0xf5, 0x1d, 0xbb, 0x90, 0xf5, 0x15, 0xf0, 0x5f, 0x7a, 0x86, 0x67,
-	0x7d, 0xcf, 0xf8, 0x5f, 0x22, 0xc3, 0xd9, 0x92, 0x61, 0xb5, 0x01, 0xa6, 0x82, 0xb1, 0x43, 0x81,
-	0x7e, 0x68, 0x69, 0xc1, 0xf7, 0x8c, 0xd9, 0x64, 0x22, 0x45, 0x08, 0x5a, 0x93, 0x04, 0x1f, 0x8a,
-	0x2a, 0xe0, 0x72, 0x7e, 0xe0, 0x93, 0x1a, 0x63, 0x2b, 0x5f, 0x14, 0x60, 0x64, 0x60, 0x6f, 0x30,
-	0x17, 0x83, 0xdc, 0xc6, 0x1c, 0xf5, 0x11, 0x47, 0x45, 0xfc, 0x58, 0x60, 0xd2, 0x09, 0x69, 0xe1,
-	0x61, 0x2e, 0x26, 0x87, 0x49, 0xf6, 0xe3, 0xc3, 0x8c, 0xb4, 0x9b, 0x77, 0xc2, 0x03, 0x0d, 0x6f,
-	0x6e, 0x44, 0x86, 0x56, 0xac, 0x03, 0x57, 0xc1, 0x83, 0xbf, 0x54, 0x18, 0xbb, 0xf9, 0x36, 0x06,
-	0x6a, 0x19, 0x6c, 0x8b, 0xba, 0x3d, 0xbc, 0xe3, 0x22, 0xc2, 0x76, 0xb1, 0x7b, 0x33, 0x53, 0x69,
-	0x81, 0x79, 0x1e, 0x16, 0x90, 0x9f, 0xcc, 0x25, 0xdf, 0x33, 0x6a, 0x92, 0x17, 0x81, 0x32, 0xd3,
-	0x79, 0x11, 0x59, 0x7d, 0x05, 0xe6, 0xa2, 0xcf, 0xc9, 0xdd, 0x9e, 0x10, 0x8a, 0xba, 0xef, 0x19,
-	0x5a, 0x46, 0x31, 0x7d, 0xbf, 0xf3, 0x44, 0xb8, 0x02, 0x96, 0xaf, 0x6a, 0x5b, 0xd4, 0xdf, 0xf5,
-	0xef, 0x65, 0x30, 0xde, 0x66, 0x03, 0xf5, 0x83, 0x02, 0xa6, 0xd3, 0x8b, 0xfb, 0x89, 0x79, 0xd5,
-	0x6f, 0x89, 0x79, 0xf9, 0xe2, 0xd5, 0x36, 0xaf, 0xcb, 0x8c, 0x57, 0x36, 0x07, 0x13, 0x62, 0xbd,
-	0x36, 0x0a, 0x29, 0x05, 0x14, 0xed, 0x69, 0x61, 0x4a, 0x3a, 0xab, 0x58, 0x73, 0xc5, 0xb2, 0x06,
-	0x94, 0x82, 0x59, 0xd3, 0x8b, 0x47, 0xf6, 0x3d, 0xb5, 0x69, 0x0a, 0xf6, 0x3d, 0x61, 0x16, 0xed,
-	0x7b, 0x7e, 0x73, 0xa8, 0x9f, 0x15, 0x30, 0x9b, 0x5b, 0x15, 0xcf, 0x0a, 0xc9, 0x66, 0xe9, 0xda,
-	0x8b, 0x7f, 0xa2, 0xc7, 0xa5, 0x7d, 0x54, 0x40, 0x65, 0xf4, 0xde, 0x6f, 0x14, 0x12, 0x1e, 0xe1,
-	0x6a, 0xcd, 0xeb, 0x73, 0xa3, 0x8a, 0x9a, 0xaf, 0x4f, 0xce, 0x74, 0xe5, 0xf4, 0x4c, 0x57, 0x7e,
-	0x9f, 0xe9, 0xca, 0xa7, 0x73, 0xbd, 0x74, 0x7a, 0xae, 0x97, 0x7e, 0x9d, 0xeb, 0xa5, 0xb7, 0x8f,
-	0x07, 0x36, 0xdf, 0x1b, 0x76, 0xcd, 0x1e, 0x75, 0xea, 0x84, 0xba, 0x36, 0x5a, 0x23, 0x98, 0xcb,
-	0x97, 0xdb, 0x5a, 0xf4, 0x74, 0x3b, 0x1a, 0x7d, 0xc9, 0xf1, 0xe3, 0x03, 0xcc, 0xba, 0x65, 0xf1,
-	0xa2, 0x7a, 0xf4, 0x27, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x65, 0x01, 0x29, 0xee, 0x09, 0x00, 0x00,
+func (m *MsgTokenFactoryGrantRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateDenom(ctx context.Context, in *MsgTokenFactoryCreateDenom, opts ...grpc.CallOption) (*MsgTokenFactoryCreateDenomResponse, error)
-	Mint(ctx context.Context, in *MsgTokenFactoryMint, opts ...grpc.CallOption) (*MsgTokenFactoryMintResponse, error)
-	Burn(ctx context.Context, in *MsgTokenFactoryBurn, opts ...grpc.CallOption) (*MsgTokenFactoryBurnResponse, error)
-	ChangeAdmin(ctx context.Context, in *MsgTokenFactoryChangeAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryChangeAdminResponse, error)
-	SetDenomMetadata(ctx context.Context, in *MsgTokenFactorySetDenomMetadata, opts ...grpc.CallOption) (*MsgTokenFactorySetDenomMetadataResponse, error)
-	ForceTransfer(ctx context.Context, in *MsgTokenFactoryForceTransfer, opts ...grpc.CallOption) (*MsgTokenFactoryForceTransferResponse, error)
+func (m *MsgTokenFactoryGrantRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryGrantRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTokenFactoryGrantRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryGrantRole.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTokenFactoryGrantRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryGrantRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryGrantRole.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateDenom(ctx context.Context, in *MsgTokenFactoryCreateDenom, opts ...grpc.CallOption) (*MsgTokenFactoryCreateDenomResponse, error) {
-	out := new(MsgTokenFactoryCreateDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/CreateDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTokenFactoryGrantRole proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryGrantRole) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Mint(ctx context.Context, in *MsgTokenFactoryMint, opts ...grpc.CallOption) (*MsgTokenFactoryMintResponse, error) {
-	out := new(MsgTokenFactoryMintResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryGrantRole) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgTokenFactoryBurn, opts ...grpc.CallOption) (*MsgTokenFactoryBurnResponse, error) {
-	out := new(MsgTokenFactoryBurnResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryGrantRole) GetRole() DenomRole {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return RoleUnspecified
 }
 
-func (c *msgClient) ChangeAdmin(ctx context.Context, in *MsgTokenFactoryChangeAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryChangeAdminResponse, error) {
-	out := new(MsgTokenFactoryChangeAdminResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ChangeAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryGrantRole) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgTokenFactorySetDenomMetadata, opts ...grpc.CallOption) (*MsgTokenFactorySetDenomMetadataResponse, error) {
-	out := new(MsgTokenFactorySetDenomMetadataResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetDenomMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgTokenFactoryGrantRoleResponse defines the response structure for an executed
+// MsgTokenFactoryGrantRole message.
+type MsgTokenFactoryGrantRoleResponse struct {
 }
 
-func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgTokenFactoryForceTransfer, opts ...grpc.CallOption) (*MsgTokenFactoryForceTransferResponse, error) {
-	out := new(MsgTokenFactoryForceTransferResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ForceTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryGrantRoleResponse) Reset()         { *m = MsgTokenFactoryGrantRoleResponse{} }
+func (m *MsgTokenFactoryGrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryGrantRoleResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryGrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{13}
+}
+func (m *MsgTokenFactoryGrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryGrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryGrantRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateDenom(context.Context, *MsgTokenFactoryCreateDenom) (*MsgTokenFactoryCreateDenomResponse, error)
-	Mint(context.Context, *MsgTokenFactoryMint) (*MsgTokenFactoryMintResponse, error)
-	Burn(context.Context, *MsgTokenFactoryBurn) (*MsgTokenFactoryBurnResponse, error)
-	ChangeAdmin(context.Context, *MsgTokenFactoryChangeAdmin) (*MsgTokenFactoryChangeAdminResponse, error)
-	SetDenomMetadata(context.Context, *MsgTokenFactorySetDenomMetadata) (*MsgTokenFactorySetDenomMetadataResponse, error)
-	ForceTransfer(context.Context, *MsgTokenFactoryForceTransfer) (*MsgTokenFactoryForceTransferResponse, error)
+func (m *MsgTokenFactoryGrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryGrantRoleResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryGrantRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryGrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryGrantRoleResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgTokenFactoryGrantRoleResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryRevokeRole is the sdk.Msg type for allowing an admin account to revoke
+// a role over the denom from an address
+type MsgTokenFactoryRevokeRole struct {
+	Sender  string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom   string    `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Role    DenomRole `protobuf:"varint,3,opt,name=role,proto3,enum=osmosis.tokenfactory.v1beta1.DenomRole" json:"role,omitempty" yaml:"role"`
+	Address string    `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func (*UnimplementedMsgServer) CreateDenom(ctx context.Context, req *MsgTokenFactoryCreateDenom) (*MsgTokenFactoryCreateDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDenom not implemented")
+func (m *MsgTokenFactoryRevokeRole) Reset()         { *m = MsgTokenFactoryRevokeRole{} }
+func (m *MsgTokenFactoryRevokeRole) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRevokeRole) ProtoMessage()    {}
+func (*MsgTokenFactoryRevokeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{14}
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgTokenFactoryMint) (*MsgTokenFactoryMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+func (m *MsgTokenFactoryRevokeRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgTokenFactoryBurn) (*MsgTokenFactoryBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+func (m *MsgTokenFactoryRevokeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRevokeRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ChangeAdmin(ctx context.Context, req *MsgTokenFactoryChangeAdmin) (*MsgTokenFactoryChangeAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeAdmin not implemented")
+func (m *MsgTokenFactoryRevokeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRevokeRole.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgTokenFactorySetDenomMetadata) (*MsgTokenFactorySetDenomMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+func (m *MsgTokenFactoryRevokeRole) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgTokenFactoryForceTransfer) (*MsgTokenFactoryForceTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+func (m *MsgTokenFactoryRevokeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRevokeRole.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgTokenFactoryRevokeRole proto.InternalMessageInfo
 
-func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTokenFactoryCreateDenom)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/CreateDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgTokenFactoryCreateDenom))
+func (m *MsgTokenFactoryRevokeRole) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTokenFactoryMint)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgTokenFactoryMint))
+func (m *MsgTokenFactoryRevokeRole) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTokenFactoryBurn)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgTokenFactoryBurn))
+func (m *MsgTokenFactoryRevokeRole) GetRole() DenomRole {
+	if m != nil {
+		return m.Role
 	}
-	return interceptor(ctx, in, info, handler)
+	return RoleUnspecified
 }
 
-func _Msg_ChangeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTokenFactoryChangeAdmin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ChangeAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeAdmin(ctx, req.(*MsgTokenFactoryChangeAdmin))
+func (m *MsgTokenFactoryRevokeRole) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTokenFactorySetDenomMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDenomMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetDenomMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgTokenFactorySetDenomMetadata))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgTokenFactoryRevokeRoleResponse defines the response structure for an executed
+// MsgTokenFactoryRevokeRole message.
+type MsgTokenFactoryRevokeRoleResponse struct {
 }
 
-func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTokenFactoryForceTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ForceTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ForceTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgTokenFactoryForceTransfer))
+func (m *MsgTokenFactoryRevokeRoleResponse) Reset()         { *m = MsgTokenFactoryRevokeRoleResponse{} }
+func (m *MsgTokenFactoryRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRevokeRoleResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryRevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{15}
+}
+func (m *MsgTokenFactoryRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRevokeRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTokenFactoryRevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRevokeRoleResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryRevokeRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRevokeRoleResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateDenom",
-			Handler:    _Msg_CreateDenom_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "ChangeAdmin",
-			Handler:    _Msg_ChangeAdmin_Handler,
-		},
-		{
-			MethodName: "SetDenomMetadata",
-			Handler:    _Msg_SetDenomMetadata_Handler,
-		},
-		{
-			MethodName: "ForceTransfer",
-			Handler:    _Msg_ForceTransfer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/tokenfactory/v1beta1/tx.proto",
+var xxx_messageInfo_MsgTokenFactoryRevokeRoleResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryProposeAdmin is the sdk.Msg type for allowing an admin account to
+// propose a new admin for the denom. The proposed admin must accept the
+// adminship with MsgTokenFactoryAcceptAdmin before it takes effect.
+type MsgTokenFactoryProposeAdmin struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	NewAdmin string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty" yaml:"new_admin"`
 }
 
-func (m *MsgTokenFactoryCreateDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryProposeAdmin) Reset()         { *m = MsgTokenFactoryProposeAdmin{} }
+func (m *MsgTokenFactoryProposeAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryProposeAdmin) ProtoMessage()    {}
+func (*MsgTokenFactoryProposeAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{16}
+}
+func (m *MsgTokenFactoryProposeAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryProposeAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryProposeAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgTokenFactoryCreateDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryProposeAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryProposeAdmin.Merge(m, src)
+}
+func (m *MsgTokenFactoryProposeAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryProposeAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryProposeAdmin.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryCreateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Subdenom) > 0 {
-		i -= len(m.Subdenom)
-		copy(dAtA[i:], m.Subdenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Subdenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgTokenFactoryProposeAdmin proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryProposeAdmin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgTokenFactoryCreateDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryProposeAdmin) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTokenFactoryCreateDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryProposeAdmin) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
+	}
+	return ""
 }
 
-func (m *MsgTokenFactoryCreateDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewTokenDenom) > 0 {
-		i -= len(m.NewTokenDenom)
-		copy(dAtA[i:], m.NewTokenDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewTokenDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgTokenFactoryProposeAdminResponse defines the response structure for an executed
+// MsgTokenFactoryProposeAdmin message.
+type MsgTokenFactoryProposeAdminResponse struct {
 }
 
-func (m *MsgTokenFactoryMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryProposeAdminResponse) Reset()         { *m = MsgTokenFactoryProposeAdminResponse{} }
+func (m *MsgTokenFactoryProposeAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryProposeAdminResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryProposeAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{17}
+}
+func (m *MsgTokenFactoryProposeAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryProposeAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryProposeAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTokenFactoryProposeAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryProposeAdminResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryProposeAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryProposeAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryProposeAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTokenFactoryProposeAdminResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryAcceptAdmin is the sdk.Msg type for allowing the proposed admin of a
+// denom to accept the adminship
+type MsgTokenFactoryAcceptAdmin struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
-func (m *MsgTokenFactoryMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MintToAddress) > 0 {
-		i -= len(m.MintToAddress)
-		copy(dAtA[i:], m.MintToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MintToAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTokenFactoryAcceptAdmin) Reset()         { *m = MsgTokenFactoryAcceptAdmin{} }
+func (m *MsgTokenFactoryAcceptAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryAcceptAdmin) ProtoMessage()    {}
+func (*MsgTokenFactoryAcceptAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{18}
+}
+func (m *MsgTokenFactoryAcceptAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryAcceptAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryAcceptAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTokenFactoryAcceptAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryAcceptAdmin.Merge(m, src)
+}
+func (m *MsgTokenFactoryAcceptAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryAcceptAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryAcceptAdmin.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTokenFactoryAcceptAdmin proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryAcceptAdmin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTokenFactoryMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryAcceptAdmin) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgTokenFactoryMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgTokenFactoryAcceptAdminResponse defines the response structure for an executed
+// MsgTokenFactoryAcceptAdmin message.
+type MsgTokenFactoryAcceptAdminResponse struct {
 }
 
-func (m *MsgTokenFactoryBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTokenFactoryAcceptAdminResponse) Reset()         { *m = MsgTokenFactoryAcceptAdminResponse{} }
+func (m *MsgTokenFactoryAcceptAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryAcceptAdminResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryAcceptAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{19}
 }
-
-func (m *MsgTokenFactoryBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryAcceptAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgTokenFactoryBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BurnFromAddress) > 0 {
-		i -= len(m.BurnFromAddress)
-		copy(dAtA[i:], m.BurnFromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BurnFromAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTokenFactoryAcceptAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryAcceptAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgTokenFactoryBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTokenFactoryAcceptAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryAcceptAdminResponse.Merge(m, src)
 }
-
-func (m *MsgTokenFactoryBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryAcceptAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryAcceptAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryAcceptAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgTokenFactoryAcceptAdminResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryCancelAdminProposal is the sdk.Msg type for allowing the admin, or the
+// proposed admin, to cancel a pending admin proposal
+type MsgTokenFactoryCancelAdminProposal struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
-func (m *MsgTokenFactoryChangeAdmin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryCancelAdminProposal) Reset()         { *m = MsgTokenFactoryCancelAdminProposal{} }
+func (m *MsgTokenFactoryCancelAdminProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryCancelAdminProposal) ProtoMessage()    {}
+func (*MsgTokenFactoryCancelAdminProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{20}
+}
+func (m *MsgTokenFactoryCancelAdminProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryCancelAdminProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryCancelAdminProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgTokenFactoryChangeAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryCancelAdminProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryCancelAdminProposal.Merge(m, src)
+}
+func (m *MsgTokenFactoryCancelAdminProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryCancelAdminProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryCancelAdminProposal.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryChangeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewAdmin) > 0 {
-		i -= len(m.NewAdmin)
-		copy(dAtA[i:], m.NewAdmin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgTokenFactoryCancelAdminProposal proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryCancelAdminProposal) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgTokenFactoryChangeAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryCancelAdminProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTokenFactoryChangeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTokenFactoryCancelAdminProposalResponse defines the response structure for an
+// executed MsgTokenFactoryCancelAdminProposal message.
+type MsgTokenFactoryCancelAdminProposalResponse struct {
 }
 
-func (m *MsgTokenFactoryChangeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTokenFactoryCancelAdminProposalResponse) Reset() {
+	*m = MsgTokenFactoryCancelAdminProposalResponse{}
 }
-
-func (m *MsgTokenFactorySetDenomMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryCancelAdminProposalResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTokenFactoryCancelAdminProposalResponse) ProtoMessage() {}
+func (*MsgTokenFactoryCancelAdminProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{21}
+}
+func (m *MsgTokenFactoryCancelAdminProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryCancelAdminProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryCancelAdminProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTokenFactoryCancelAdminProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryCancelAdminProposalResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryCancelAdminProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryCancelAdminProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryCancelAdminProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactorySetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTokenFactoryCancelAdminProposalResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryConfigureMinter is the sdk.Msg type for allowing an admin account to
+// let another address mint the denom up to an allowance. The allowance can
+// optionally be replenished by replenish_amount every replenish_period.
+// Configuring an existing minter resets its remaining allowance.
+type MsgTokenFactoryConfigureMinter struct {
+	Sender          string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom           string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Minter          string                `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+	Allowance       cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance" yaml:"allowance"`
+	ReplenishAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=replenish_amount,json=replenishAmount,proto3,customtype=cosmossdk.io/math.Int" json:"replenish_amount" yaml:"replenish_amount"`
+	ReplenishPeriod time.Duration         `protobuf:"bytes,6,opt,name=replenish_period,json=replenishPeriod,proto3,stdduration" json:"replenish_period" yaml:"replenish_period"`
 }
 
-func (m *MsgTokenFactorySetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTokenFactoryConfigureMinter) Reset()         { *m = MsgTokenFactoryConfigureMinter{} }
+func (m *MsgTokenFactoryConfigureMinter) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryConfigureMinter) ProtoMessage()    {}
+func (*MsgTokenFactoryConfigureMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{22}
+}
+func (m *MsgTokenFactoryConfigureMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryConfigureMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryConfigureMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTokenFactoryConfigureMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryConfigureMinter.Merge(m, src)
+}
+func (m *MsgTokenFactoryConfigureMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryConfigureMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryConfigureMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryConfigureMinter proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryConfigureMinter) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgTokenFactorySetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryConfigureMinter) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTokenFactorySetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryConfigureMinter) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
 }
 
-func (m *MsgTokenFactorySetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTokenFactoryConfigureMinter) GetReplenishPeriod() time.Duration {
+	if m != nil {
+		return m.ReplenishPeriod
+	}
+	return 0
 }
 
-func (m *MsgTokenFactoryForceTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgTokenFactoryConfigureMinterResponse defines the response structure for an executed
+// MsgTokenFactoryConfigureMinter message.
+type MsgTokenFactoryConfigureMinterResponse struct {
+}
+
+func (m *MsgTokenFactoryConfigureMinterResponse) Reset() {
+	*m = MsgTokenFactoryConfigureMinterResponse{}
+}
+func (m *MsgTokenFactoryConfigureMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryConfigureMinterResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryConfigureMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{23}
+}
+func (m *MsgTokenFactoryConfigureMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryConfigureMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryConfigureMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTokenFactoryConfigureMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryConfigureMinterResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryConfigureMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryConfigureMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryConfigureMinterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryForceTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTokenFactoryConfigureMinterResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryIncreaseMinterAllowance is the sdk.Msg type for allowing an admin account
+// to raise both the allowance and the remaining allowance of a minter
+type MsgTokenFactoryIncreaseMinterAllowance struct {
+	Sender string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Minter string                `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
 }
 
-func (m *MsgTokenFactoryForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TransferToAddress) > 0 {
-		i -= len(m.TransferToAddress)
-		copy(dAtA[i:], m.TransferToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferToAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TransferFromAddress) > 0 {
-		i -= len(m.TransferFromAddress)
-		copy(dAtA[i:], m.TransferFromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferFromAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTokenFactoryIncreaseMinterAllowance) Reset() {
+	*m = MsgTokenFactoryIncreaseMinterAllowance{}
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryIncreaseMinterAllowance) ProtoMessage()    {}
+func (*MsgTokenFactoryIncreaseMinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{24}
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowance.Merge(m, src)
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowance proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryIncreaseMinterAllowance) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgTokenFactoryForceTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTokenFactoryIncreaseMinterAllowance) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgTokenFactoryForceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenFactoryIncreaseMinterAllowance) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
 }
 
-func (m *MsgTokenFactoryForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgTokenFactoryIncreaseMinterAllowanceResponse defines the response structure for an
+// executed MsgTokenFactoryIncreaseMinterAllowance message.
+type MsgTokenFactoryIncreaseMinterAllowanceResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) Reset() {
+	*m = MsgTokenFactoryIncreaseMinterAllowanceResponse{}
 }
-func (m *MsgTokenFactoryCreateDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Subdenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTokenFactoryIncreaseMinterAllowanceResponse) ProtoMessage() {}
+func (*MsgTokenFactoryIncreaseMinterAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{25}
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowanceResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryCreateDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NewTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgTokenFactoryIncreaseMinterAllowanceResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryDecreaseMinterAllowance is the sdk.Msg type for allowing an admin account
+// to lower both the allowance and the remaining allowance of a minter
+type MsgTokenFactoryDecreaseMinterAllowance struct {
+	Sender string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Minter string                `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
 }
 
-func (m *MsgTokenFactoryMint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.MintToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTokenFactoryDecreaseMinterAllowance) Reset() {
+	*m = MsgTokenFactoryDecreaseMinterAllowance{}
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryDecreaseMinterAllowance) ProtoMessage()    {}
+func (*MsgTokenFactoryDecreaseMinterAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{26}
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowance.Merge(m, src)
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowance.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactoryMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowance proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryDecreaseMinterAllowance) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgTokenFactoryBurn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.BurnFromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTokenFactoryDecreaseMinterAllowance) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *MsgTokenFactoryBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTokenFactoryDecreaseMinterAllowance) GetMinter() string {
+	if m != nil {
+		return m.Minter
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgTokenFactoryChangeAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewAdmin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgTokenFactoryDecreaseMinterAllowanceResponse defines the response structure for an
+// executed MsgTokenFactoryDecreaseMinterAllowance message.
+type MsgTokenFactoryDecreaseMinterAllowanceResponse struct {
 }
 
-func (m *MsgTokenFactoryChangeAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) Reset() {
+	*m = MsgTokenFactoryDecreaseMinterAllowanceResponse{}
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTokenFactoryDecreaseMinterAllowanceResponse) ProtoMessage() {}
+func (*MsgTokenFactoryDecreaseMinterAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{27}
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowanceResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTokenFactorySetDenomMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgTokenFactoryDecreaseMinterAllowanceResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryRemoveMinter is the sdk.Msg type for allowing an admin account to remove
+// the allowance of a minter
+type MsgTokenFactoryRemoveMinter struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Minter string `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+}
+
+func (m *MsgTokenFactoryRemoveMinter) Reset()         { *m = MsgTokenFactoryRemoveMinter{} }
+func (m *MsgTokenFactoryRemoveMinter) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRemoveMinter) ProtoMessage()    {}
+func (*MsgTokenFactoryRemoveMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{28}
+}
+func (m *MsgTokenFactoryRemoveMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRemoveMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRemoveMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
+}
+func (m *MsgTokenFactoryRemoveMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRemoveMinter.Merge(m, src)
+}
+func (m *MsgTokenFactoryRemoveMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRemoveMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRemoveMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRemoveMinter proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryRemoveMinter) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryRemoveMinter) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryRemoveMinter) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+// MsgTokenFactoryRemoveMinterResponse defines the response structure for an executed
+// MsgTokenFactoryRemoveMinter message.
+type MsgTokenFactoryRemoveMinterResponse struct {
+}
+
+func (m *MsgTokenFactoryRemoveMinterResponse) Reset()         { *m = MsgTokenFactoryRemoveMinterResponse{} }
+func (m *MsgTokenFactoryRemoveMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRemoveMinterResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryRemoveMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{29}
+}
+func (m *MsgTokenFactoryRemoveMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRemoveMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRemoveMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryRemoveMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRemoveMinterResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryRemoveMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRemoveMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRemoveMinterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRemoveMinterResponse proto.InternalMessageInfo
+
+// MsgTokenFactorySetMaxSupply is the sdk.Msg type for allowing an admin account to cap
+// the total supply of a denom. An existing cap can only be lowered, and never
+// below the current supply.
+type MsgTokenFactorySetMaxSupply struct {
+	Sender    string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+}
+
+func (m *MsgTokenFactorySetMaxSupply) Reset()         { *m = MsgTokenFactorySetMaxSupply{} }
+func (m *MsgTokenFactorySetMaxSupply) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetMaxSupply) ProtoMessage()    {}
+func (*MsgTokenFactorySetMaxSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{30}
+}
+func (m *MsgTokenFactorySetMaxSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetMaxSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetMaxSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetMaxSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetMaxSupply.Merge(m, src)
+}
+func (m *MsgTokenFactorySetMaxSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetMaxSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetMaxSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetMaxSupply proto.InternalMessageInfo
+
+func (m *MsgTokenFactorySetMaxSupply) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactorySetMaxSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgTokenFactorySetMaxSupplyResponse defines the response structure for an executed
+// MsgTokenFactorySetMaxSupply message.
+type MsgTokenFactorySetMaxSupplyResponse struct {
+}
+
+func (m *MsgTokenFactorySetMaxSupplyResponse) Reset()         { *m = MsgTokenFactorySetMaxSupplyResponse{} }
+func (m *MsgTokenFactorySetMaxSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetMaxSupplyResponse) ProtoMessage()    {}
+func (*MsgTokenFactorySetMaxSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{31}
+}
+func (m *MsgTokenFactorySetMaxSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetMaxSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetMaxSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetMaxSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetMaxSupplyResponse.Merge(m, src)
+}
+func (m *MsgTokenFactorySetMaxSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetMaxSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetMaxSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetMaxSupplyResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryPause is the sdk.Msg type for allowing the admin, or a pauser, to halt
+// all transfers of a denom. Minting and burning are still possible while paused.
+type MsgTokenFactoryPause struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgTokenFactoryPause) Reset()         { *m = MsgTokenFactoryPause{} }
+func (m *MsgTokenFactoryPause) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryPause) ProtoMessage()    {}
+func (*MsgTokenFactoryPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{32}
+}
+func (m *MsgTokenFactoryPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryPause.Merge(m, src)
+}
+func (m *MsgTokenFactoryPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryPause proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryPause) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryPause) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgTokenFactoryPauseResponse defines the response structure for an executed
+// MsgTokenFactoryPause message.
+type MsgTokenFactoryPauseResponse struct {
+}
+
+func (m *MsgTokenFactoryPauseResponse) Reset()         { *m = MsgTokenFactoryPauseResponse{} }
+func (m *MsgTokenFactoryPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryPauseResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{33}
+}
+func (m *MsgTokenFactoryPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryPauseResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryPauseResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryUnpause is the sdk.Msg type for allowing the admin, or a pauser, to
+// resume the transfers of a paused denom.
+type MsgTokenFactoryUnpause struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgTokenFactoryUnpause) Reset()         { *m = MsgTokenFactoryUnpause{} }
+func (m *MsgTokenFactoryUnpause) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryUnpause) ProtoMessage()    {}
+func (*MsgTokenFactoryUnpause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{34}
+}
+func (m *MsgTokenFactoryUnpause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryUnpause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryUnpause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryUnpause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryUnpause.Merge(m, src)
+}
+func (m *MsgTokenFactoryUnpause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryUnpause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryUnpause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryUnpause proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryUnpause) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryUnpause) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgTokenFactoryUnpauseResponse defines the response structure for an executed
+// MsgTokenFactoryUnpause message.
+type MsgTokenFactoryUnpauseResponse struct {
+}
+
+func (m *MsgTokenFactoryUnpauseResponse) Reset()         { *m = MsgTokenFactoryUnpauseResponse{} }
+func (m *MsgTokenFactoryUnpauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryUnpauseResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryUnpauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{35}
+}
+func (m *MsgTokenFactoryUnpauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryUnpauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryUnpauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryUnpauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryUnpauseResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryUnpauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryUnpauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryUnpauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryUnpauseResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryFreeze is the sdk.Msg type for allowing the admin, or a freezer, to
+// prevent addresses from sending or receiving a denom. Their other coins are untouched.
+type MsgTokenFactoryFreeze struct {
+	Sender    string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *MsgTokenFactoryFreeze) Reset()         { *m = MsgTokenFactoryFreeze{} }
+func (m *MsgTokenFactoryFreeze) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryFreeze) ProtoMessage()    {}
+func (*MsgTokenFactoryFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{36}
+}
+func (m *MsgTokenFactoryFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryFreeze.Merge(m, src)
+}
+func (m *MsgTokenFactoryFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryFreeze proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryFreeze) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryFreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryFreeze) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgTokenFactoryFreezeResponse defines the response structure for an executed
+// MsgTokenFactoryFreeze message.
+type MsgTokenFactoryFreezeResponse struct {
+}
+
+func (m *MsgTokenFactoryFreezeResponse) Reset()         { *m = MsgTokenFactoryFreezeResponse{} }
+func (m *MsgTokenFactoryFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryFreezeResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{37}
+}
+func (m *MsgTokenFactoryFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryFreezeResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryFreezeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryFreezeResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryUnfreeze is the sdk.Msg type for allowing the admin, or a freezer, to
+// let frozen addresses send and receive a denom again.
+type MsgTokenFactoryUnfreeze struct {
+	Sender    string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *MsgTokenFactoryUnfreeze) Reset()         { *m = MsgTokenFactoryUnfreeze{} }
+func (m *MsgTokenFactoryUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryUnfreeze) ProtoMessage()    {}
+func (*MsgTokenFactoryUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{38}
+}
+func (m *MsgTokenFactoryUnfreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryUnfreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryUnfreeze.Merge(m, src)
+}
+func (m *MsgTokenFactoryUnfreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryUnfreeze proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryUnfreeze) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryUnfreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryUnfreeze) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgTokenFactoryUnfreezeResponse defines the response structure for an executed
+// MsgTokenFactoryUnfreeze message.
+type MsgTokenFactoryUnfreezeResponse struct {
+}
+
+func (m *MsgTokenFactoryUnfreezeResponse) Reset()         { *m = MsgTokenFactoryUnfreezeResponse{} }
+func (m *MsgTokenFactoryUnfreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryUnfreezeResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryUnfreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{39}
+}
+func (m *MsgTokenFactoryUnfreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryUnfreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryUnfreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryUnfreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryUnfreezeResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryUnfreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryUnfreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryUnfreezeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryUnfreezeResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryAddToAllowlist is the sdk.Msg type for allowing the admin, or a
+// compliance manager, to approve addresses to receive a denom in allowlist mode.
+type MsgTokenFactoryAddToAllowlist struct {
+	Sender    string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *MsgTokenFactoryAddToAllowlist) Reset()         { *m = MsgTokenFactoryAddToAllowlist{} }
+func (m *MsgTokenFactoryAddToAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryAddToAllowlist) ProtoMessage()    {}
+func (*MsgTokenFactoryAddToAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{40}
+}
+func (m *MsgTokenFactoryAddToAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryAddToAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryAddToAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryAddToAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryAddToAllowlist.Merge(m, src)
+}
+func (m *MsgTokenFactoryAddToAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryAddToAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryAddToAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryAddToAllowlist proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryAddToAllowlist) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryAddToAllowlist) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryAddToAllowlist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgTokenFactoryAddToAllowlistResponse defines the response structure for an
+// executed MsgTokenFactoryAddToAllowlist message.
+type MsgTokenFactoryAddToAllowlistResponse struct {
+}
+
+func (m *MsgTokenFactoryAddToAllowlistResponse) Reset()         { *m = MsgTokenFactoryAddToAllowlistResponse{} }
+func (m *MsgTokenFactoryAddToAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryAddToAllowlistResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryAddToAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{41}
+}
+func (m *MsgTokenFactoryAddToAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryAddToAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryAddToAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryAddToAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryAddToAllowlistResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryAddToAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryAddToAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryAddToAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryAddToAllowlistResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryRemoveFromAllowlist is the sdk.Msg type for allowing the admin, or
+// a compliance manager, to revoke the approval of addresses to receive a denom in
+// allowlist mode.
+type MsgTokenFactoryRemoveFromAllowlist struct {
+	Sender    string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) Reset()         { *m = MsgTokenFactoryRemoveFromAllowlist{} }
+func (m *MsgTokenFactoryRemoveFromAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRemoveFromAllowlist) ProtoMessage()    {}
+func (*MsgTokenFactoryRemoveFromAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{42}
+}
+func (m *MsgTokenFactoryRemoveFromAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRemoveFromAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryRemoveFromAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlist.Merge(m, src)
+}
+func (m *MsgTokenFactoryRemoveFromAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRemoveFromAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlist proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// MsgTokenFactoryRemoveFromAllowlistResponse defines the response structure for
+// an executed MsgTokenFactoryRemoveFromAllowlist message.
+type MsgTokenFactoryRemoveFromAllowlistResponse struct {
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) Reset() {
+	*m = MsgTokenFactoryRemoveFromAllowlistResponse{}
+}
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTokenFactoryRemoveFromAllowlistResponse) ProtoMessage() {}
+func (*MsgTokenFactoryRemoveFromAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{43}
+}
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlistResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRemoveFromAllowlistResponse proto.InternalMessageInfo
+
+// MsgTokenFactorySetBeforeSendHook is the sdk.Msg type for allowing the admin to
+// attach a CosmWasm contract that is called before every transfer of a denom. An
+// empty cosmwasm_address removes the hook.
+type MsgTokenFactorySetBeforeSendHook struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom           string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	CosmwasmAddress string `protobuf:"bytes,3,opt,name=cosmwasm_address,json=cosmwasmAddress,proto3" json:"cosmwasm_address,omitempty" yaml:"cosmwasm_address"`
+}
+
+func (m *MsgTokenFactorySetBeforeSendHook) Reset()         { *m = MsgTokenFactorySetBeforeSendHook{} }
+func (m *MsgTokenFactorySetBeforeSendHook) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetBeforeSendHook) ProtoMessage()    {}
+func (*MsgTokenFactorySetBeforeSendHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{44}
+}
+func (m *MsgTokenFactorySetBeforeSendHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetBeforeSendHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetBeforeSendHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetBeforeSendHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetBeforeSendHook.Merge(m, src)
+}
+func (m *MsgTokenFactorySetBeforeSendHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetBeforeSendHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetBeforeSendHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetBeforeSendHook proto.InternalMessageInfo
+
+func (m *MsgTokenFactorySetBeforeSendHook) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactorySetBeforeSendHook) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactorySetBeforeSendHook) GetCosmwasmAddress() string {
+	if m != nil {
+		return m.CosmwasmAddress
+	}
+	return ""
+}
+
+// MsgTokenFactorySetBeforeSendHookResponse defines the response structure for an
+// executed MsgTokenFactorySetBeforeSendHook message.
+type MsgTokenFactorySetBeforeSendHookResponse struct {
+}
+
+func (m *MsgTokenFactorySetBeforeSendHookResponse) Reset() {
+	*m = MsgTokenFactorySetBeforeSendHookResponse{}
+}
+func (m *MsgTokenFactorySetBeforeSendHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetBeforeSendHookResponse) ProtoMessage()    {}
+func (*MsgTokenFactorySetBeforeSendHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{45}
+}
+func (m *MsgTokenFactorySetBeforeSendHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetBeforeSendHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetBeforeSendHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetBeforeSendHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetBeforeSendHookResponse.Merge(m, src)
+}
+func (m *MsgTokenFactorySetBeforeSendHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetBeforeSendHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetBeforeSendHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetBeforeSendHookResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryRenounceCapability is the sdk.Msg type for allowing the admin to
+// permanently give up a capability over a denom.
+type MsgTokenFactoryRenounceCapability struct {
+	Sender     string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom      string          `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Capability DenomCapability `protobuf:"varint,3,opt,name=capability,proto3,enum=osmosis.tokenfactory.v1beta1.DenomCapability" json:"capability,omitempty" yaml:"capability"`
+}
+
+func (m *MsgTokenFactoryRenounceCapability) Reset()         { *m = MsgTokenFactoryRenounceCapability{} }
+func (m *MsgTokenFactoryRenounceCapability) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRenounceCapability) ProtoMessage()    {}
+func (*MsgTokenFactoryRenounceCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{46}
+}
+func (m *MsgTokenFactoryRenounceCapability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRenounceCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRenounceCapability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryRenounceCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRenounceCapability.Merge(m, src)
+}
+func (m *MsgTokenFactoryRenounceCapability) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRenounceCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRenounceCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRenounceCapability proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryRenounceCapability) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryRenounceCapability) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryRenounceCapability) GetCapability() DenomCapability {
+	if m != nil {
+		return m.Capability
+	}
+	return CapabilityUnspecified
+}
+
+// MsgTokenFactoryRenounceCapabilityResponse defines the response structure for an
+// executed MsgTokenFactoryRenounceCapability message.
+type MsgTokenFactoryRenounceCapabilityResponse struct {
+}
+
+func (m *MsgTokenFactoryRenounceCapabilityResponse) Reset() {
+	*m = MsgTokenFactoryRenounceCapabilityResponse{}
+}
+func (m *MsgTokenFactoryRenounceCapabilityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTokenFactoryRenounceCapabilityResponse) ProtoMessage() {}
+func (*MsgTokenFactoryRenounceCapabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{47}
+}
+func (m *MsgTokenFactoryRenounceCapabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRenounceCapabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRenounceCapabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryRenounceCapabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRenounceCapabilityResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryRenounceCapabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRenounceCapabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRenounceCapabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRenounceCapabilityResponse proto.InternalMessageInfo
+
+// MsgTokenFactorySetTimelock is the sdk.Msg type for allowing the admin to delay the
+// sensitive admin actions over a denom. Once a denom has a timelock, changing
+// it is itself timelocked.
+type MsgTokenFactorySetTimelock struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// delay of the timelocked actions, zero to disable the timelock
+	Delay time.Duration `protobuf:"bytes,3,opt,name=delay,proto3,stdduration" json:"delay" yaml:"delay"`
+	// mints of more than mint_threshold are timelocked, none if zero
+	MintThreshold cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=mint_threshold,json=mintThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"mint_threshold" yaml:"mint_threshold"`
+}
+
+func (m *MsgTokenFactorySetTimelock) Reset()         { *m = MsgTokenFactorySetTimelock{} }
+func (m *MsgTokenFactorySetTimelock) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetTimelock) ProtoMessage()    {}
+func (*MsgTokenFactorySetTimelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{48}
+}
+func (m *MsgTokenFactorySetTimelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetTimelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetTimelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetTimelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetTimelock.Merge(m, src)
+}
+func (m *MsgTokenFactorySetTimelock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetTimelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetTimelock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetTimelock proto.InternalMessageInfo
+
+func (m *MsgTokenFactorySetTimelock) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactorySetTimelock) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactorySetTimelock) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+// MsgTokenFactorySetTimelockResponse defines the response structure for an
+// executed MsgTokenFactorySetTimelock message.
+type MsgTokenFactorySetTimelockResponse struct {
+}
+
+func (m *MsgTokenFactorySetTimelockResponse) Reset()         { *m = MsgTokenFactorySetTimelockResponse{} }
+func (m *MsgTokenFactorySetTimelockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetTimelockResponse) ProtoMessage()    {}
+func (*MsgTokenFactorySetTimelockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{49}
+}
+func (m *MsgTokenFactorySetTimelockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetTimelockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetTimelockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetTimelockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetTimelockResponse.Merge(m, src)
+}
+func (m *MsgTokenFactorySetTimelockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetTimelockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetTimelockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetTimelockResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryCancelPendingAction is the sdk.Msg type for allowing the admin to
+// cancel a timelocked action before it is executed.
+type MsgTokenFactoryCancelPendingAction struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Id     uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+}
+
+func (m *MsgTokenFactoryCancelPendingAction) Reset()         { *m = MsgTokenFactoryCancelPendingAction{} }
+func (m *MsgTokenFactoryCancelPendingAction) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryCancelPendingAction) ProtoMessage()    {}
+func (*MsgTokenFactoryCancelPendingAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{50}
+}
+func (m *MsgTokenFactoryCancelPendingAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryCancelPendingAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryCancelPendingAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryCancelPendingAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryCancelPendingAction.Merge(m, src)
+}
+func (m *MsgTokenFactoryCancelPendingAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryCancelPendingAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryCancelPendingAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryCancelPendingAction proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryCancelPendingAction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryCancelPendingAction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryCancelPendingAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgTokenFactoryCancelPendingActionResponse defines the response structure for an
+// executed MsgTokenFactoryCancelPendingAction message.
+type MsgTokenFactoryCancelPendingActionResponse struct {
+}
+
+func (m *MsgTokenFactoryCancelPendingActionResponse) Reset() {
+	*m = MsgTokenFactoryCancelPendingActionResponse{}
+}
+func (m *MsgTokenFactoryCancelPendingActionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTokenFactoryCancelPendingActionResponse) ProtoMessage() {}
+func (*MsgTokenFactoryCancelPendingActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{51}
+}
+func (m *MsgTokenFactoryCancelPendingActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryCancelPendingActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryCancelPendingActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryCancelPendingActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryCancelPendingActionResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryCancelPendingActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryCancelPendingActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryCancelPendingActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryCancelPendingActionResponse proto.InternalMessageInfo
+
+// MsgTokenFactorySetMintRateLimit is the sdk.Msg type for allowing the admin to cap
+// the amount of a denom minted within a rolling window. A stricter limit
+// applies right away, while a looser one only applies after a delay.
+type MsgTokenFactorySetMintRateLimit struct {
+	Sender    string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	RateLimit MintRateLimit `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit" yaml:"rate_limit"`
+}
+
+func (m *MsgTokenFactorySetMintRateLimit) Reset()         { *m = MsgTokenFactorySetMintRateLimit{} }
+func (m *MsgTokenFactorySetMintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetMintRateLimit) ProtoMessage()    {}
+func (*MsgTokenFactorySetMintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{52}
+}
+func (m *MsgTokenFactorySetMintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetMintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetMintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetMintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetMintRateLimit.Merge(m, src)
+}
+func (m *MsgTokenFactorySetMintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetMintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetMintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetMintRateLimit proto.InternalMessageInfo
+
+func (m *MsgTokenFactorySetMintRateLimit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactorySetMintRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactorySetMintRateLimit) GetRateLimit() MintRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return MintRateLimit{}
+}
+
+// MsgTokenFactorySetMintRateLimitResponse defines the response structure for an
+// executed MsgTokenFactorySetMintRateLimit message.
+type MsgTokenFactorySetMintRateLimitResponse struct {
+}
+
+func (m *MsgTokenFactorySetMintRateLimitResponse) Reset() {
+	*m = MsgTokenFactorySetMintRateLimitResponse{}
+}
+func (m *MsgTokenFactorySetMintRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactorySetMintRateLimitResponse) ProtoMessage()    {}
+func (*MsgTokenFactorySetMintRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{53}
+}
+func (m *MsgTokenFactorySetMintRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactorySetMintRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactorySetMintRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactorySetMintRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactorySetMintRateLimitResponse.Merge(m, src)
+}
+func (m *MsgTokenFactorySetMintRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactorySetMintRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactorySetMintRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactorySetMintRateLimitResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryUpdateParams is the sdk.Msg type for allowing the module
+// authority, the gov module account by default, to update the module params.
+// All the params must be supplied.
+type MsgTokenFactoryUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *MsgTokenFactoryUpdateParams) Reset()         { *m = MsgTokenFactoryUpdateParams{} }
+func (m *MsgTokenFactoryUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryUpdateParams) ProtoMessage()    {}
+func (*MsgTokenFactoryUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{54}
+}
+func (m *MsgTokenFactoryUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryUpdateParams.Merge(m, src)
+}
+func (m *MsgTokenFactoryUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryUpdateParams proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgTokenFactoryUpdateParamsResponse defines the response structure for an
+// executed MsgTokenFactoryUpdateParams message.
+type MsgTokenFactoryUpdateParamsResponse struct {
+}
+
+func (m *MsgTokenFactoryUpdateParamsResponse) Reset()         { *m = MsgTokenFactoryUpdateParamsResponse{} }
+func (m *MsgTokenFactoryUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{55}
+}
+func (m *MsgTokenFactoryUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryRetireDenom is the sdk.Msg type for allowing the admin to
+// delete a denom with no supply, along with its state and its bank metadata.
+// The creation deposit of the denom, if any, is refunded to the admin.
+type MsgTokenFactoryRetireDenom struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgTokenFactoryRetireDenom) Reset()         { *m = MsgTokenFactoryRetireDenom{} }
+func (m *MsgTokenFactoryRetireDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRetireDenom) ProtoMessage()    {}
+func (*MsgTokenFactoryRetireDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{56}
+}
+func (m *MsgTokenFactoryRetireDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRetireDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRetireDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryRetireDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRetireDenom.Merge(m, src)
+}
+func (m *MsgTokenFactoryRetireDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRetireDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRetireDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRetireDenom proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryRetireDenom) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryRetireDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgTokenFactoryRetireDenomResponse defines the response structure for an
+// executed MsgTokenFactoryRetireDenom message.
+type MsgTokenFactoryRetireDenomResponse struct {
+}
+
+func (m *MsgTokenFactoryRetireDenomResponse) Reset()         { *m = MsgTokenFactoryRetireDenomResponse{} }
+func (m *MsgTokenFactoryRetireDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryRetireDenomResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryRetireDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{57}
+}
+func (m *MsgTokenFactoryRetireDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryRetireDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryRetireDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryRetireDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryRetireDenomResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryRetireDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryRetireDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryRetireDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryRetireDenomResponse proto.InternalMessageInfo
+
+// BatchEntry is an address along with an amount of the denom of a batch
+// message.
+type BatchEntry struct {
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *BatchEntry) Reset()         { *m = BatchEntry{} }
+func (m *BatchEntry) String() string { return proto.CompactTextString(m) }
+func (*BatchEntry) ProtoMessage()    {}
+func (*BatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{58}
+}
+func (m *BatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEntry.Merge(m, src)
+}
+func (m *BatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchEntry proto.InternalMessageInfo
+
+func (m *BatchEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgTokenFactoryBatchMint is the sdk.Msg type for allowing a minter, or a
+// delegated minter, to mint a denom to many addresses at once. The entries are
+// minted atomically, and the whole batch is subject to the same checks as a
+// single mint of the total amount.
+type MsgTokenFactoryBatchMint struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// addresses to mint to, along with the amounts minted to them
+	Entries []BatchEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+}
+
+func (m *MsgTokenFactoryBatchMint) Reset()         { *m = MsgTokenFactoryBatchMint{} }
+func (m *MsgTokenFactoryBatchMint) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryBatchMint) ProtoMessage()    {}
+func (*MsgTokenFactoryBatchMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{59}
+}
+func (m *MsgTokenFactoryBatchMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryBatchMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryBatchMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryBatchMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryBatchMint.Merge(m, src)
+}
+func (m *MsgTokenFactoryBatchMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryBatchMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryBatchMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryBatchMint proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryBatchMint) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryBatchMint) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryBatchMint) GetEntries() []BatchEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// MsgTokenFactoryBatchMintResponse defines the response structure for an
+// executed MsgTokenFactoryBatchMint message.
+type MsgTokenFactoryBatchMintResponse struct {
+}
+
+func (m *MsgTokenFactoryBatchMintResponse) Reset()         { *m = MsgTokenFactoryBatchMintResponse{} }
+func (m *MsgTokenFactoryBatchMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryBatchMintResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryBatchMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{60}
+}
+func (m *MsgTokenFactoryBatchMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryBatchMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryBatchMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryBatchMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryBatchMintResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryBatchMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryBatchMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryBatchMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryBatchMintResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryBatchBurn is the sdk.Msg type for allowing a burner to burn a
+// denom from many addresses at once. The entries are burned atomically.
+type MsgTokenFactoryBatchBurn struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// addresses to burn from, along with the amounts burned from them
+	Entries []BatchEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+}
+
+func (m *MsgTokenFactoryBatchBurn) Reset()         { *m = MsgTokenFactoryBatchBurn{} }
+func (m *MsgTokenFactoryBatchBurn) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryBatchBurn) ProtoMessage()    {}
+func (*MsgTokenFactoryBatchBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{61}
+}
+func (m *MsgTokenFactoryBatchBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryBatchBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryBatchBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryBatchBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryBatchBurn.Merge(m, src)
+}
+func (m *MsgTokenFactoryBatchBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryBatchBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryBatchBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryBatchBurn proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryBatchBurn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryBatchBurn) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryBatchBurn) GetEntries() []BatchEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// MsgTokenFactoryBatchBurnResponse defines the response structure for an
+// executed MsgTokenFactoryBatchBurn message.
+type MsgTokenFactoryBatchBurnResponse struct {
+}
+
+func (m *MsgTokenFactoryBatchBurnResponse) Reset()         { *m = MsgTokenFactoryBatchBurnResponse{} }
+func (m *MsgTokenFactoryBatchBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryBatchBurnResponse) ProtoMessage()    {}
+func (*MsgTokenFactoryBatchBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{62}
+}
+func (m *MsgTokenFactoryBatchBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryBatchBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryBatchBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryBatchBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryBatchBurnResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryBatchBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryBatchBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryBatchBurnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryBatchBurnResponse proto.InternalMessageInfo
+
+// MsgTokenFactoryBatchForceTransfer is the sdk.Msg type for allowing a force
+// transferrer to move a denom from many addresses to a single one at once. The
+// entries are transferred atomically.
+type MsgTokenFactoryBatchForceTransfer struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// addresses to transfer from, along with the amounts transferred from them
+	Entries           []BatchEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+	TransferToAddress string       `protobuf:"bytes,4,opt,name=transfer_to_address,json=transferToAddress,proto3" json:"transfer_to_address,omitempty" yaml:"transfer_to_address"`
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) Reset()         { *m = MsgTokenFactoryBatchForceTransfer{} }
+func (m *MsgTokenFactoryBatchForceTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenFactoryBatchForceTransfer) ProtoMessage()    {}
+func (*MsgTokenFactoryBatchForceTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{63}
+}
+func (m *MsgTokenFactoryBatchForceTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryBatchForceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryBatchForceTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryBatchForceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryBatchForceTransfer.Merge(m, src)
+}
+func (m *MsgTokenFactoryBatchForceTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryBatchForceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryBatchForceTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryBatchForceTransfer proto.InternalMessageInfo
+
+func (m *MsgTokenFactoryBatchForceTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) GetEntries() []BatchEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) GetTransferToAddress() string {
+	if m != nil {
+		return m.TransferToAddress
+	}
+	return ""
+}
+
+// MsgTokenFactoryBatchForceTransferResponse defines the response structure for
+// an executed MsgTokenFactoryBatchForceTransfer message.
+type MsgTokenFactoryBatchForceTransferResponse struct {
+}
+
+func (m *MsgTokenFactoryBatchForceTransferResponse) Reset() {
+	*m = MsgTokenFactoryBatchForceTransferResponse{}
+}
+func (m *MsgTokenFactoryBatchForceTransferResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTokenFactoryBatchForceTransferResponse) ProtoMessage() {}
+func (*MsgTokenFactoryBatchForceTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{64}
+}
+func (m *MsgTokenFactoryBatchForceTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenFactoryBatchForceTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenFactoryBatchForceTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenFactoryBatchForceTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenFactoryBatchForceTransferResponse.Merge(m, src)
+}
+func (m *MsgTokenFactoryBatchForceTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenFactoryBatchForceTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenFactoryBatchForceTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenFactoryBatchForceTransferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgTokenFactoryCreateDenom)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCreateDenom")
+	proto.RegisterType((*MsgTokenFactoryCreateDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCreateDenomResponse")
+	proto.RegisterType((*MsgTokenFactoryMint)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryMint")
+	proto.RegisterType((*MsgTokenFactoryMintResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryMintResponse")
+	proto.RegisterType((*MsgTokenFactoryBurn)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBurn")
+	proto.RegisterType((*MsgTokenFactoryBurnResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBurnResponse")
+	proto.RegisterType((*MsgTokenFactoryChangeAdmin)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryChangeAdmin")
+	proto.RegisterType((*MsgTokenFactoryChangeAdminResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryChangeAdminResponse")
+	proto.RegisterType((*MsgTokenFactorySetDenomMetadata)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetDenomMetadata")
+	proto.RegisterType((*MsgTokenFactorySetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetDenomMetadataResponse")
+	proto.RegisterType((*MsgTokenFactoryForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryForceTransfer")
+	proto.RegisterType((*MsgTokenFactoryForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryForceTransferResponse")
+	proto.RegisterType((*MsgTokenFactoryGrantRole)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryGrantRole")
+	proto.RegisterType((*MsgTokenFactoryGrantRoleResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryGrantRoleResponse")
+	proto.RegisterType((*MsgTokenFactoryRevokeRole)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRevokeRole")
+	proto.RegisterType((*MsgTokenFactoryRevokeRoleResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRevokeRoleResponse")
+	proto.RegisterType((*MsgTokenFactoryProposeAdmin)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryProposeAdmin")
+	proto.RegisterType((*MsgTokenFactoryProposeAdminResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryProposeAdminResponse")
+	proto.RegisterType((*MsgTokenFactoryAcceptAdmin)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryAcceptAdmin")
+	proto.RegisterType((*MsgTokenFactoryAcceptAdminResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryAcceptAdminResponse")
+	proto.RegisterType((*MsgTokenFactoryCancelAdminProposal)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCancelAdminProposal")
+	proto.RegisterType((*MsgTokenFactoryCancelAdminProposalResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCancelAdminProposalResponse")
+	proto.RegisterType((*MsgTokenFactoryConfigureMinter)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryConfigureMinter")
+	proto.RegisterType((*MsgTokenFactoryConfigureMinterResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryConfigureMinterResponse")
+	proto.RegisterType((*MsgTokenFactoryIncreaseMinterAllowance)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryIncreaseMinterAllowance")
+	proto.RegisterType((*MsgTokenFactoryIncreaseMinterAllowanceResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryIncreaseMinterAllowanceResponse")
+	proto.RegisterType((*MsgTokenFactoryDecreaseMinterAllowance)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryDecreaseMinterAllowance")
+	proto.RegisterType((*MsgTokenFactoryDecreaseMinterAllowanceResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryDecreaseMinterAllowanceResponse")
+	proto.RegisterType((*MsgTokenFactoryRemoveMinter)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRemoveMinter")
+	proto.RegisterType((*MsgTokenFactoryRemoveMinterResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRemoveMinterResponse")
+	proto.RegisterType((*MsgTokenFactorySetMaxSupply)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetMaxSupply")
+	proto.RegisterType((*MsgTokenFactorySetMaxSupplyResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetMaxSupplyResponse")
+	proto.RegisterType((*MsgTokenFactoryPause)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryPause")
+	proto.RegisterType((*MsgTokenFactoryPauseResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryPauseResponse")
+	proto.RegisterType((*MsgTokenFactoryUnpause)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryUnpause")
+	proto.RegisterType((*MsgTokenFactoryUnpauseResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryUnpauseResponse")
+	proto.RegisterType((*MsgTokenFactoryFreeze)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryFreeze")
+	proto.RegisterType((*MsgTokenFactoryFreezeResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryFreezeResponse")
+	proto.RegisterType((*MsgTokenFactoryUnfreeze)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryUnfreeze")
+	proto.RegisterType((*MsgTokenFactoryUnfreezeResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryUnfreezeResponse")
+	proto.RegisterType((*MsgTokenFactoryAddToAllowlist)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryAddToAllowlist")
+	proto.RegisterType((*MsgTokenFactoryAddToAllowlistResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryAddToAllowlistResponse")
+	proto.RegisterType((*MsgTokenFactoryRemoveFromAllowlist)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRemoveFromAllowlist")
+	proto.RegisterType((*MsgTokenFactoryRemoveFromAllowlistResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRemoveFromAllowlistResponse")
+	proto.RegisterType((*MsgTokenFactorySetBeforeSendHook)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetBeforeSendHook")
+	proto.RegisterType((*MsgTokenFactorySetBeforeSendHookResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetBeforeSendHookResponse")
+	proto.RegisterType((*MsgTokenFactoryRenounceCapability)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRenounceCapability")
+	proto.RegisterType((*MsgTokenFactoryRenounceCapabilityResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRenounceCapabilityResponse")
+	proto.RegisterType((*MsgTokenFactorySetTimelock)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetTimelock")
+	proto.RegisterType((*MsgTokenFactorySetTimelockResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetTimelockResponse")
+	proto.RegisterType((*MsgTokenFactoryCancelPendingAction)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCancelPendingAction")
+	proto.RegisterType((*MsgTokenFactoryCancelPendingActionResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryCancelPendingActionResponse")
+	proto.RegisterType((*MsgTokenFactorySetMintRateLimit)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetMintRateLimit")
+	proto.RegisterType((*MsgTokenFactorySetMintRateLimitResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactorySetMintRateLimitResponse")
+	proto.RegisterType((*MsgTokenFactoryUpdateParams)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryUpdateParams")
+	proto.RegisterType((*MsgTokenFactoryUpdateParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryUpdateParamsResponse")
+	proto.RegisterType((*MsgTokenFactoryRetireDenom)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRetireDenom")
+	proto.RegisterType((*MsgTokenFactoryRetireDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryRetireDenomResponse")
+	proto.RegisterType((*BatchEntry)(nil), "osmosis.tokenfactory.v1beta1.BatchEntry")
+	proto.RegisterType((*MsgTokenFactoryBatchMint)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBatchMint")
+	proto.RegisterType((*MsgTokenFactoryBatchMintResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBatchMintResponse")
+	proto.RegisterType((*MsgTokenFactoryBatchBurn)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBatchBurn")
+	proto.RegisterType((*MsgTokenFactoryBatchBurnResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBatchBurnResponse")
+	proto.RegisterType((*MsgTokenFactoryBatchForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBatchForceTransfer")
+	proto.RegisterType((*MsgTokenFactoryBatchForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgTokenFactoryBatchForceTransferResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/tx.proto", fileDescriptor_283b6c9a90a846b4)
+}
+
+var fileDescriptor_283b6c9a90a846b4 = []byte{
+	// 2457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x4d, 0x6c, 0x1c, 0x49,
+	0xf5, 0x4f, 0xdb, 0x89, 0xe3, 0x79, 0xf9, 0xb0, 0xd3, 0x8e, 0xe3, 0x49, 0x6f, 0x32, 0xed, 0x74,
+	0xbe, 0x9c, 0xdd, 0x64, 0xbc, 0xf1, 0xee, 0xff, 0xbf, 0x49, 0xc8, 0x26, 0x9e, 0x89, 0xe3, 0xc4,
+	0x28, 0x46, 0xa1, 0xed, 0xbd, 0xac, 0x84, 0x46, 0xed, 0xe9, 0x9a, 0x71, 0xcb, 0xd3, 0x5d, 0x43,
+	0x77, 0x4d, 0x1c, 0xaf, 0x84, 0x84, 0x84, 0xc4, 0x87, 0x84, 0x00, 0x21, 0x81, 0x56, 0x5a, 0xb4,
+	0x5a, 0x40, 0x82, 0x03, 0xe2, 0x06, 0x47, 0x38, 0x2f, 0xb7, 0x85, 0x13, 0x02, 0x34, 0x40, 0x72,
+	0x01, 0x8e, 0x73, 0xe6, 0x80, 0xba, 0xab, 0xba, 0xa6, 0xbf, 0x66, 0x26, 0xdd, 0x93, 0x51, 0x2c,
+	0xb8, 0x65, 0xba, 0xde, 0xef, 0x57, 0xef, 0xbd, 0xaa, 0x57, 0x55, 0xef, 0xbd, 0x18, 0x2e, 0x62,
+	0xc7, 0xc4, 0x8e, 0xe1, 0x2c, 0x12, 0xbc, 0x83, 0xac, 0x9a, 0x56, 0x25, 0xd8, 0xde, 0x5b, 0x7c,
+	0x72, 0x7d, 0x0b, 0x11, 0xed, 0xfa, 0x22, 0x79, 0x5a, 0x6c, 0xda, 0x98, 0x60, 0xf1, 0x0c, 0x13,
+	0x2b, 0x06, 0xc5, 0x8a, 0x4c, 0x4c, 0x3a, 0x59, 0xc7, 0x75, 0xec, 0x09, 0x2e, 0xba, 0xff, 0xa2,
+	0x18, 0xa9, 0x50, 0xf5, 0x40, 0x8b, 0x5b, 0x9a, 0x83, 0x38, 0x63, 0x15, 0x1b, 0x56, 0x6c, 0xdc,
+	0xda, 0xe1, 0xe3, 0xee, 0x0f, 0x36, 0xfe, 0x76, 0x5f, 0xd5, 0xb4, 0x16, 0xd9, 0xc6, 0xb6, 0x41,
+	0xf6, 0xd6, 0x11, 0xd1, 0x74, 0x8d, 0x68, 0x0c, 0xf5, 0x66, 0x5f, 0x94, 0x69, 0x58, 0x44, 0xd5,
+	0x08, 0x7a, 0x64, 0x98, 0x06, 0x61, 0x88, 0x2b, 0x7d, 0x11, 0x4d, 0xcd, 0xd6, 0x4c, 0x87, 0x89,
+	0x9e, 0xa6, 0x2a, 0x57, 0xa8, 0xad, 0xf4, 0x87, 0x6f, 0x4d, 0x1d, 0xe3, 0x7a, 0x03, 0x2d, 0x7a,
+	0xbf, 0xb6, 0x5a, 0xb5, 0x45, 0xbd, 0x65, 0x6b, 0xc4, 0xc0, 0xcc, 0x5a, 0xe5, 0xef, 0xe3, 0x20,
+	0xad, 0x3b, 0xf5, 0x4d, 0x77, 0x8e, 0x55, 0x3a, 0xc7, 0x3d, 0x1b, 0x69, 0x04, 0xad, 0x20, 0x0b,
+	0x9b, 0xe2, 0x15, 0x98, 0x70, 0x90, 0xa5, 0x23, 0x3b, 0x2f, 0xcc, 0x0b, 0x0b, 0xb9, 0xf2, 0x89,
+	0x4e, 0x5b, 0x3e, 0xb6, 0xa7, 0x99, 0x8d, 0x5b, 0x0a, 0xfd, 0xae, 0xa8, 0x4c, 0x40, 0x5c, 0x84,
+	0x49, 0xa7, 0xb5, 0xa5, 0xbb, 0xb0, 0xfc, 0x98, 0x27, 0x3c, 0xd3, 0x69, 0xcb, 0x53, 0x4c, 0x98,
+	0x8d, 0x28, 0x2a, 0x17, 0x12, 0x2b, 0x00, 0xa6, 0xf6, 0xb4, 0xe2, 0xb4, 0x9a, 0xcd, 0xc6, 0x5e,
+	0x7e, 0xdc, 0x83, 0x2c, 0x7f, 0xda, 0x96, 0x0f, 0xfc, 0xa9, 0x2d, 0xcf, 0x52, 0x23, 0x1c, 0x7d,
+	0xa7, 0x68, 0xe0, 0x45, 0x53, 0x23, 0xdb, 0xc5, 0x35, 0x8b, 0x74, 0xda, 0xf2, 0x09, 0xca, 0xd7,
+	0x05, 0x2a, 0x7f, 0xf8, 0xd5, 0x35, 0x60, 0x26, 0xaf, 0x59, 0x44, 0xcd, 0x99, 0xda, 0xd3, 0x0d,
+	0x6f, 0x44, 0x5c, 0x83, 0x13, 0x5a, 0xa3, 0x81, 0x77, 0x1b, 0x86, 0x43, 0x2a, 0xc8, 0xd2, 0xb6,
+	0x1a, 0x48, 0xcf, 0x1f, 0x9c, 0x17, 0x16, 0x26, 0xcb, 0x67, 0x3a, 0x6d, 0x39, 0x4f, 0xa9, 0x62,
+	0x22, 0x8a, 0x3a, 0xcd, 0xbf, 0xdd, 0xa7, 0x9f, 0xc4, 0x6f, 0x08, 0x70, 0xca, 0x46, 0x16, 0x6e,
+	0x59, 0x55, 0xa4, 0x57, 0xaa, 0x5a, 0x53, 0xdb, 0x32, 0x1a, 0x06, 0x31, 0x90, 0x93, 0x3f, 0x34,
+	0x3f, 0xbe, 0x70, 0x7c, 0xe9, 0x5a, 0xb1, 0xdf, 0x56, 0x2c, 0x7a, 0xde, 0xbc, 0xe7, 0xc3, 0xf6,
+	0xca, 0xe7, 0x3a, 0x6d, 0xf9, 0x2c, 0x9d, 0x3f, 0x99, 0x56, 0x51, 0x67, 0xf9, 0xc0, 0xbd, 0xc0,
+	0x77, 0xf1, 0x3a, 0xe4, 0x6a, 0x08, 0x55, 0xa8, 0x9f, 0x27, 0x3c, 0xa7, 0x9d, 0xec, 0xb4, 0xe5,
+	0x69, 0x4a, 0xc6, 0x87, 0x14, 0x75, 0xb2, 0x86, 0xe8, 0x22, 0x2a, 0xdb, 0xa0, 0xf4, 0x5e, 0x62,
+	0x15, 0x39, 0x4d, 0x6c, 0x39, 0x48, 0x2c, 0xc3, 0x94, 0x85, 0x76, 0x2b, 0x9e, 0xfa, 0x8c, 0x9e,
+	0xae, 0xb9, 0xd4, 0x69, 0xcb, 0xa7, 0x28, 0x7d, 0x44, 0x40, 0x51, 0x8f, 0x59, 0x68, 0xd7, 0x23,
+	0xa6, 0x33, 0x7d, 0x73, 0x0c, 0x66, 0x22, 0x53, 0xad, 0x1b, 0x16, 0x49, 0xb3, 0x8d, 0x1e, 0xc2,
+	0x84, 0x66, 0xe2, 0x96, 0x45, 0xbc, 0x4d, 0x74, 0x64, 0xe9, 0x74, 0x91, 0x2d, 0xae, 0x1b, 0xaf,
+	0xdc, 0x9f, 0xf7, 0xb0, 0x61, 0x95, 0x67, 0xdd, 0xcd, 0xd2, 0x65, 0xa2, 0x30, 0x45, 0x65, 0x78,
+	0x71, 0x19, 0x8e, 0xb9, 0x71, 0xb5, 0x89, 0x4b, 0xba, 0x6e, 0x23, 0xc7, 0xc9, 0x8f, 0x47, 0xcd,
+	0x71, 0x87, 0x2b, 0x04, 0x57, 0x34, 0x2a, 0xa0, 0xa8, 0x61, 0x80, 0x78, 0x0b, 0x8e, 0xda, 0xa8,
+	0x86, 0x6c, 0x64, 0x55, 0x51, 0xc5, 0xa0, 0x7b, 0x27, 0x57, 0x9e, 0xeb, 0xb4, 0xe5, 0x19, 0x7f,
+	0xed, 0xba, 0xa3, 0x8a, 0x7a, 0x84, 0xff, 0x5c, 0xd3, 0x95, 0xb3, 0xf0, 0x5a, 0x82, 0x27, 0x7c,
+	0x6f, 0x2b, 0xdf, 0x89, 0x7b, 0xaa, 0xdc, 0xb2, 0xad, 0x57, 0xe3, 0xa9, 0x55, 0x98, 0xda, 0x6a,
+	0xd9, 0xd6, 0xaa, 0x8d, 0xcd, 0xb0, 0xaf, 0x02, 0x61, 0xe2, 0x0a, 0x54, 0x6a, 0x36, 0x36, 0xbb,
+	0xde, 0x8a, 0x82, 0x5e, 0xb2, 0xbf, 0x5c, 0x7f, 0x70, 0x7f, 0xfd, 0x4b, 0x88, 0x9f, 0x53, 0xdb,
+	0x9a, 0x55, 0x47, 0x25, 0xdd, 0x34, 0x52, 0xb9, 0xed, 0x12, 0x1c, 0x0a, 0x1e, 0x52, 0xd3, 0x9d,
+	0xb6, 0x7c, 0x94, 0x4a, 0xb2, 0x3d, 0x4d, 0x87, 0xdd, 0x40, 0x73, 0xb7, 0xbb, 0xe6, 0xf2, 0xe7,
+	0xc7, 0xa3, 0x81, 0xc6, 0x87, 0x14, 0x75, 0xd2, 0x42, 0xbb, 0x54, 0x8b, 0x55, 0x98, 0xae, 0x62,
+	0xab, 0x66, 0xd8, 0x66, 0xc5, 0x0f, 0x5e, 0x76, 0xde, 0xbc, 0xd6, 0x69, 0xcb, 0x73, 0x14, 0x19,
+	0x95, 0x50, 0xd4, 0x29, 0xf6, 0x49, 0xf5, 0xbf, 0x5c, 0x00, 0xa5, 0xb7, 0xad, 0xdc, 0x25, 0x9f,
+	0x08, 0x20, 0x47, 0xc4, 0x36, 0x10, 0xf1, 0x02, 0xd1, 0xbf, 0x7c, 0xd2, 0xf8, 0x45, 0x85, 0x49,
+	0x93, 0xc1, 0xd8, 0x86, 0x3a, 0xdb, 0xdd, 0x50, 0xd6, 0x0e, 0xdf, 0x50, 0x3e, 0x77, 0x79, 0x8e,
+	0x6d, 0x2a, 0x76, 0xc4, 0xfb, 0x60, 0x45, 0xe5, 0x3c, 0xca, 0x15, 0xb8, 0x3c, 0x40, 0x43, 0x6e,
+	0xcd, 0xaf, 0xc7, 0xe0, 0x4c, 0x44, 0x76, 0x15, 0xdb, 0x55, 0xb4, 0x69, 0x6b, 0x96, 0x53, 0x43,
+	0xf6, 0xab, 0x89, 0x0c, 0x15, 0x66, 0x08, 0x53, 0x20, 0x1e, 0x1d, 0xf3, 0x9d, 0xb6, 0x7c, 0x86,
+	0xe2, 0x7c, 0xa1, 0x48, 0x84, 0x24, 0x81, 0xc5, 0x47, 0x70, 0xc2, 0xff, 0xdc, 0x3d, 0x9b, 0x68,
+	0xa8, 0x14, 0x3a, 0x6d, 0x59, 0x8a, 0x30, 0x06, 0xcf, 0xa7, 0x38, 0x50, 0xb9, 0x04, 0x17, 0xfa,
+	0xb9, 0x8d, 0xfb, 0xf7, 0x9f, 0x02, 0xe4, 0x23, 0x82, 0x0f, 0x6c, 0xcd, 0x22, 0x2a, 0x6e, 0xa0,
+	0x51, 0x84, 0xcf, 0x23, 0x38, 0x68, 0xe3, 0x06, 0xf2, 0x5c, 0x75, 0x7c, 0xe9, 0xf2, 0x0b, 0x5c,
+	0x8f, 0xae, 0x26, 0xe5, 0xa9, 0x4e, 0x5b, 0x3e, 0xc2, 0x0e, 0x0b, 0xdc, 0x40, 0x8a, 0xea, 0xb1,
+	0x88, 0x57, 0xe1, 0xb0, 0x16, 0xf2, 0x94, 0xd8, 0x69, 0xcb, 0xc7, 0xd9, 0x9a, 0xf9, 0xde, 0xf1,
+	0x45, 0x14, 0x05, 0xe6, 0x7b, 0x99, 0x1a, 0x3c, 0x50, 0x4e, 0x47, 0x84, 0x54, 0xf4, 0x04, 0xef,
+	0xa0, 0xff, 0x46, 0x87, 0x9c, 0x87, 0x73, 0x3d, 0x6d, 0xe5, 0x1e, 0xf9, 0xa9, 0x10, 0x3b, 0x82,
+	0x1f, 0xdb, 0xb8, 0x89, 0x9d, 0xfd, 0x74, 0xc6, 0x2a, 0x17, 0xe1, 0x7c, 0x1f, 0x25, 0xb9, 0x31,
+	0x38, 0x76, 0x5d, 0x94, 0xaa, 0x55, 0xd4, 0x24, 0xa3, 0x32, 0x25, 0xe1, 0xcc, 0x0e, 0x4c, 0xc8,
+	0xd5, 0xda, 0x8d, 0x9f, 0xec, 0x9a, 0x55, 0x45, 0x0d, 0x4f, 0x8a, 0x1a, 0xa2, 0x35, 0x46, 0xa1,
+	0xde, 0x55, 0x78, 0x7d, 0xf0, 0xc4, 0x5c, 0xcd, 0x3f, 0x8f, 0x43, 0x21, 0x2a, 0xee, 0xde, 0x51,
+	0xf5, 0x96, 0x8d, 0xdc, 0x67, 0x0c, 0xb2, 0x47, 0xa0, 0xa3, 0x4b, 0x69, 0x7a, 0xe4, 0xf9, 0xf1,
+	0x28, 0x25, 0xfd, 0xae, 0xa8, 0x4c, 0x40, 0xfc, 0x12, 0xe4, 0xbc, 0x37, 0xba, 0xe6, 0x5f, 0xb1,
+	0xb9, 0xf2, 0xdd, 0x41, 0xa9, 0xc3, 0x74, 0xe0, 0xbd, 0xef, 0xe2, 0x62, 0x99, 0x03, 0x1f, 0x11,
+	0xbf, 0x0c, 0xd3, 0x36, 0x6a, 0x36, 0x90, 0x65, 0x38, 0xdb, 0x15, 0x76, 0x95, 0x1c, 0xf2, 0x66,
+	0x59, 0x1d, 0x34, 0xcb, 0x9c, 0xff, 0xd2, 0x09, 0xc3, 0xa3, 0x93, 0x4d, 0x71, 0x81, 0x12, 0xbd,
+	0x69, 0x8c, 0xe0, 0x94, 0x4d, 0x64, 0x1b, 0x58, 0xcf, 0x4f, 0xb0, 0xdb, 0x8b, 0xe6, 0x70, 0x45,
+	0x3f, 0x87, 0x2b, 0xae, 0xb0, 0x1c, 0xae, 0x7c, 0x9e, 0xdd, 0x5e, 0xb1, 0x49, 0x29, 0x81, 0xf2,
+	0xe1, 0x5f, 0x65, 0x21, 0x30, 0xd5, 0x63, 0xfa, 0x75, 0x01, 0x2e, 0xf5, 0x5f, 0x5c, 0xbe, 0x0f,
+	0xfe, 0x2d, 0xc4, 0x44, 0xd7, 0xac, 0xaa, 0x8d, 0x34, 0x87, 0x49, 0x96, 0xb8, 0xcb, 0x5e, 0xed,
+	0x7e, 0xd8, 0xe4, 0x37, 0x3e, 0xdd, 0x0c, 0xb7, 0x07, 0x2d, 0x53, 0xf8, 0xbe, 0x8f, 0x2c, 0x0e,
+	0xe3, 0x52, 0xde, 0x84, 0xe2, 0x8b, 0x59, 0xdf, 0xcf, 0x61, 0x2b, 0xe8, 0x7f, 0xd9, 0x61, 0x2b,
+	0xa8, 0xbf, 0xc3, 0x3e, 0x8a, 0x5f, 0x3a, 0x2a, 0x32, 0xf1, 0x93, 0x7d, 0x71, 0xcc, 0x24, 0x5c,
+	0x36, 0x41, 0xe5, 0xb8, 0x11, 0xbf, 0x8f, 0x1b, 0xb1, 0x81, 0xc8, 0x3a, 0x2f, 0x44, 0x8c, 0xc0,
+	0x88, 0x51, 0x17, 0x4f, 0x12, 0x4c, 0x0f, 0x9a, 0xc4, 0x4d, 0x37, 0xe0, 0x64, 0xf4, 0x3a, 0xd6,
+	0x5a, 0xce, 0x28, 0x76, 0xb7, 0x52, 0x80, 0x33, 0x49, 0x53, 0x71, 0x55, 0x76, 0xe0, 0x54, 0x64,
+	0xfc, 0x3d, 0xab, 0x39, 0x2a, 0x65, 0xe6, 0xa1, 0x90, 0x3c, 0x19, 0x57, 0xe7, 0x63, 0x01, 0x66,
+	0x23, 0x22, 0xab, 0x36, 0x42, 0x1f, 0x8c, 0x24, 0xf2, 0x97, 0x20, 0xc7, 0xde, 0x7a, 0xc8, 0xcd,
+	0x4e, 0xc6, 0xc3, 0x0f, 0x29, 0x3e, 0xa4, 0xa8, 0x5d, 0x31, 0x45, 0x86, 0xb3, 0x89, 0xfa, 0x05,
+	0x13, 0xcc, 0xb9, 0x98, 0x91, 0xb5, 0x7d, 0x65, 0xc3, 0x39, 0x90, 0x7b, 0x68, 0xc8, 0xad, 0xf8,
+	0x99, 0x10, 0xb3, 0xb3, 0xa4, 0xeb, 0x9b, 0xb8, 0xe4, 0xd7, 0xf8, 0xf6, 0x8b, 0x2d, 0x97, 0xe1,
+	0x62, 0x5f, 0x3d, 0xb9, 0x45, 0xbf, 0x10, 0x40, 0x49, 0x3c, 0x96, 0xbc, 0x2c, 0x73, 0xbf, 0x99,
+	0x15, 0x7f, 0x79, 0x26, 0x28, 0xcb, 0x6d, 0xfb, 0x8d, 0x10, 0xcb, 0xdd, 0x36, 0x10, 0x29, 0xa3,
+	0x1a, 0xb6, 0xd1, 0x06, 0xb2, 0xf4, 0x87, 0x18, 0xef, 0x8c, 0xc2, 0x32, 0xaf, 0x74, 0xe3, 0x98,
+	0xbb, 0x9a, 0xc3, 0xd3, 0x77, 0x76, 0xaa, 0x86, 0x4a, 0x37, 0x61, 0x09, 0xaf, 0x74, 0x43, 0x3f,
+	0xf9, 0xe9, 0xf8, 0xeb, 0xb0, 0x30, 0x48, 0x7d, 0x6e, 0xeb, 0x5f, 0x84, 0x84, 0xb4, 0x8c, 0x96,
+	0x80, 0xba, 0xa5, 0xe2, 0x51, 0x18, 0xab, 0x03, 0xf0, 0x5a, 0xf3, 0x1e, 0x4b, 0x48, 0x53, 0x16,
+	0xb0, 0x67, 0xbb, 0xd7, 0x49, 0x97, 0x4a, 0x51, 0x03, 0xbc, 0xca, 0x1b, 0x70, 0x65, 0xa0, 0x75,
+	0xdc, 0x17, 0x3f, 0x1f, 0x8b, 0x25, 0x6c, 0x1b, 0x88, 0x6c, 0x1a, 0x26, 0x6a, 0xe0, 0xea, 0x48,
+	0x56, 0x7c, 0xcd, 0x95, 0x6b, 0x68, 0xd4, 0xfe, 0xbe, 0xaf, 0xec, 0x3c, 0x7b, 0x65, 0x73, 0x9a,
+	0x86, 0xb6, 0x47, 0x9f, 0xd6, 0x94, 0x41, 0xdc, 0x81, 0xe3, 0xb4, 0x94, 0xbc, 0x6d, 0x23, 0x67,
+	0x1b, 0x37, 0xfc, 0xca, 0xe7, 0xca, 0xa0, 0x0b, 0x79, 0x36, 0x58, 0x87, 0xf6, 0xc1, 0xd1, 0x4b,
+	0x99, 0x16, 0xa5, 0xf9, 0x68, 0x3c, 0xd1, 0x0c, 0x38, 0x8a, 0xfb, 0xf3, 0x87, 0x42, 0x8f, 0x4c,
+	0xf3, 0x31, 0xb2, 0x74, 0xc3, 0xaa, 0x97, 0xaa, 0xae, 0x69, 0xa3, 0xf0, 0xeb, 0x59, 0x18, 0x33,
+	0x74, 0xcf, 0xa9, 0x07, 0xcb, 0xc7, 0x3a, 0x6d, 0x39, 0x47, 0x85, 0xdc, 0x82, 0xef, 0x98, 0xa1,
+	0xf7, 0x4c, 0x44, 0x43, 0x7a, 0x75, 0x13, 0xd1, 0xc4, 0x1a, 0xe7, 0x7a, 0xb0, 0x5d, 0x36, 0x0a,
+	0x1b, 0x10, 0x80, 0xad, 0x11, 0x54, 0x69, 0xb8, 0x13, 0xb0, 0x0d, 0xf2, 0x46, 0xff, 0x00, 0x09,
+	0xe9, 0x54, 0x3e, 0xcd, 0xb6, 0x0c, 0x0b, 0x91, 0x2e, 0x99, 0xa2, 0xe6, 0x6c, 0x5f, 0x2a, 0xb9,
+	0x3c, 0x1a, 0x22, 0xe2, 0x8e, 0xf8, 0x6d, 0xfc, 0x89, 0xf9, 0x5e, 0x53, 0xd7, 0x08, 0x7a, 0xec,
+	0x35, 0x02, 0xc5, 0xcf, 0x43, 0x8e, 0xb7, 0x1e, 0x99, 0x1f, 0xae, 0x06, 0x4e, 0x66, 0x7f, 0xc8,
+	0xdd, 0x5b, 0x27, 0xd9, 0xde, 0x62, 0x47, 0xd6, 0x06, 0xb1, 0x0d, 0xab, 0xae, 0x76, 0xe1, 0xe2,
+	0x06, 0x4c, 0xd0, 0xf6, 0x22, 0x2b, 0x9f, 0x5e, 0xe8, 0x6f, 0x39, 0xd5, 0x20, 0x5a, 0x49, 0xa5,
+	0x0c, 0x8a, 0xca, 0xa8, 0x12, 0xde, 0x93, 0x41, 0xfd, 0xfb, 0xd4, 0x6d, 0x54, 0x44, 0x0c, 0x3b,
+	0x7d, 0x3b, 0x32, 0x7b, 0xdd, 0x26, 0x30, 0x61, 0x30, 0x9c, 0xa0, 0xac, 0x91, 0xea, 0xf6, 0x7d,
+	0x8b, 0xd8, 0x7b, 0xc1, 0xea, 0x9b, 0x30, 0xb0, 0xfa, 0x16, 0xc8, 0xb5, 0xc6, 0x5e, 0x5e, 0xae,
+	0x75, 0xeb, 0xe0, 0x3f, 0x3e, 0x91, 0x05, 0xe5, 0x77, 0xf1, 0xb2, 0xae, 0xa7, 0x67, 0xda, 0xb6,
+	0xdb, 0x8b, 0x46, 0xc6, 0xfb, 0x70, 0x18, 0x59, 0xc4, 0x36, 0xd8, 0xfd, 0x7f, 0x64, 0x69, 0xa1,
+	0xff, 0xe6, 0xe8, 0x3a, 0xad, 0x7c, 0x8a, 0x6d, 0x10, 0xe6, 0x27, 0x46, 0xa3, 0xa8, 0x3e, 0x61,
+	0x42, 0xd9, 0x96, 0x9b, 0xc2, 0x17, 0xa2, 0x97, 0xbd, 0x69, 0x9b, 0x67, 0xfb, 0xd8, 0xde, 0x50,
+	0xdf, 0xeb, 0x27, 0x63, 0x70, 0x2e, 0x49, 0x28, 0x73, 0x6f, 0x64, 0x1f, 0x18, 0x2e, 0x7e, 0x01,
+	0x66, 0x12, 0xda, 0x1b, 0xd9, 0x7b, 0x20, 0xf1, 0x97, 0x46, 0xdc, 0x47, 0xbe, 0x47, 0x97, 0x7e,
+	0x74, 0x01, 0xc6, 0xd7, 0x9d, 0xba, 0xf8, 0x2d, 0x01, 0x8e, 0x04, 0xff, 0xab, 0xc3, 0x8d, 0x01,
+	0xe7, 0x7b, 0xcf, 0x0e, 0xba, 0xb4, 0x9c, 0x15, 0xc9, 0x7b, 0xef, 0x04, 0x0e, 0x7a, 0x01, 0x7b,
+	0x3d, 0x15, 0x93, 0x0b, 0x91, 0x6e, 0xa6, 0x86, 0x04, 0x67, 0xf5, 0xc2, 0x26, 0xdd, 0xac, 0x2e,
+	0x44, 0xba, 0x99, 0x1a, 0xc2, 0x67, 0xf5, 0xfc, 0x1e, 0x68, 0xdd, 0xa6, 0xf4, 0x7b, 0x17, 0x29,
+	0x2d, 0x67, 0x45, 0x72, 0x5d, 0x3e, 0x14, 0x60, 0x3a, 0xd6, 0x33, 0x7d, 0x37, 0x15, 0x6d, 0x14,
+	0x2e, 0xdd, 0x1f, 0x0a, 0xce, 0x55, 0xfb, 0xae, 0x00, 0xc7, 0xc2, 0x41, 0x7e, 0x2b, 0x15, 0x71,
+	0x08, 0x2b, 0x95, 0xb3, 0x63, 0xb9, 0x46, 0x5f, 0x13, 0x20, 0xd7, 0x6d, 0x19, 0xfe, 0x7f, 0x2a,
+	0x46, 0x8e, 0x93, 0xee, 0x64, 0xc3, 0x71, 0x2d, 0xbe, 0x2e, 0x00, 0x04, 0x1a, 0x75, 0xef, 0xa4,
+	0xa2, 0xeb, 0x02, 0xa5, 0xbb, 0x19, 0x81, 0x5c, 0x91, 0x6f, 0x0b, 0x70, 0x34, 0xd4, 0x1f, 0x4b,
+	0x17, 0x13, 0x41, 0xa8, 0x54, 0xca, 0x0c, 0x0d, 0x85, 0x55, 0xb0, 0xc5, 0x95, 0x2e, 0xac, 0x02,
+	0x48, 0x69, 0x39, 0x2b, 0x92, 0xeb, 0xf2, 0x63, 0x01, 0x66, 0x92, 0xfa, 0x5a, 0x29, 0x03, 0x36,
+	0xce, 0x20, 0x3d, 0x1c, 0x96, 0x81, 0xeb, 0xf8, 0x03, 0x01, 0xa6, 0xa2, 0x3d, 0xad, 0xdb, 0xe9,
+	0xd8, 0xc3, 0x68, 0x69, 0x65, 0x18, 0x34, 0xd7, 0xeb, 0x97, 0x02, 0xcc, 0xf5, 0xea, 0xb1, 0xa4,
+	0x9b, 0xa1, 0x07, 0x8b, 0xf4, 0xe8, 0x65, 0xb0, 0x84, 0xf4, 0x5d, 0x41, 0x2f, 0x43, 0xdf, 0x15,
+	0xf4, 0x32, 0xf4, 0x1d, 0xd0, 0x70, 0xf0, 0xc2, 0x36, 0xd4, 0x61, 0xb8, 0x99, 0xf2, 0x20, 0xe8,
+	0x42, 0xa5, 0x52, 0x66, 0x68, 0x48, 0x9d, 0x50, 0xaf, 0xe0, 0x66, 0xda, 0xeb, 0x83, 0x43, 0xa5,
+	0x52, 0x66, 0x28, 0x57, 0x67, 0x17, 0x0e, 0xd1, 0xfa, 0xfd, 0x52, 0xba, 0x13, 0xc9, 0xc5, 0x48,
+	0xb7, 0xd2, 0x63, 0xf8, 0xc4, 0x5f, 0x81, 0xc3, 0x7e, 0xb5, 0xfe, 0xed, 0x54, 0x34, 0x0c, 0x25,
+	0xdd, 0xce, 0x82, 0xe2, 0xd3, 0x7f, 0x00, 0x13, 0xac, 0x38, 0xff, 0x56, 0xba, 0x9b, 0xd2, 0x03,
+	0x49, 0x9f, 0xcb, 0x00, 0xe2, 0x73, 0x7f, 0x55, 0x80, 0x49, 0x5e, 0x57, 0xff, 0xbf, 0x94, 0x66,
+	0x50, 0x98, 0xf4, 0x6e, 0x26, 0x18, 0x57, 0xe1, 0xfb, 0x02, 0x1c, 0x8f, 0x14, 0xc5, 0xd3, 0x99,
+	0x14, 0x06, 0x4b, 0xf7, 0x86, 0x00, 0x87, 0x6e, 0x91, 0xa4, 0xba, 0xf6, 0x72, 0x86, 0xa8, 0x0b,
+	0x31, 0x48, 0x0f, 0x87, 0x65, 0xe0, 0x3a, 0x7e, 0x24, 0xc0, 0x89, 0x78, 0x7d, 0xfa, 0x4e, 0xda,
+	0x40, 0x0c, 0xe3, 0xa5, 0xd5, 0xe1, 0xf0, 0x5c, 0xbb, 0x8f, 0x05, 0x10, 0x13, 0x2a, 0xca, 0x69,
+	0x9f, 0x3e, 0x51, 0x02, 0xe9, 0xc1, 0x90, 0x04, 0xa1, 0x47, 0x4b, 0xb0, 0xcc, 0x7b, 0x23, 0xad,
+	0xe1, 0x3e, 0x52, 0x5a, 0xce, 0x8a, 0x4c, 0x78, 0xb4, 0x84, 0x4b, 0xa4, 0x59, 0x1e, 0x2d, 0x21,
+	0x06, 0xe9, 0xe1, 0xb0, 0x0c, 0xd1, 0x7c, 0x25, 0x5c, 0xff, 0x4c, 0x9d, 0xaf, 0x84, 0xe0, 0xd2,
+	0xfd, 0xa1, 0xe0, 0xa1, 0x8b, 0x2c, 0x54, 0x91, 0x4c, 0x77, 0x91, 0x05, 0xa1, 0x52, 0x29, 0x33,
+	0x34, 0xb4, 0xb3, 0x82, 0x95, 0xc3, 0x1b, 0x29, 0xb7, 0x2c, 0x47, 0x4a, 0xcb, 0x59, 0x91, 0xa1,
+	0xc4, 0xa9, 0x5b, 0x94, 0x4b, 0x97, 0x38, 0x71, 0x9c, 0x74, 0x27, 0x1b, 0x2e, 0xae, 0x85, 0x97,
+	0xf3, 0x67, 0xd0, 0xc2, 0x4b, 0xfc, 0xef, 0x64, 0xc3, 0x85, 0x8e, 0xa4, 0x84, 0x02, 0xd6, 0xdd,
+	0xf4, 0xb4, 0xe1, 0x04, 0xf7, 0xc1, 0x90, 0x04, 0xbe, 0x82, 0xe5, 0x2f, 0x7e, 0xfa, 0xac, 0x20,
+	0x7c, 0xf6, 0xac, 0x20, 0xfc, 0xed, 0x59, 0x41, 0xf8, 0xde, 0xf3, 0xc2, 0x81, 0xcf, 0x9e, 0x17,
+	0x0e, 0xfc, 0xf1, 0x79, 0xe1, 0xc0, 0xfb, 0xef, 0xd4, 0x0d, 0xb2, 0xdd, 0xda, 0x2a, 0x56, 0xb1,
+	0xb9, 0x68, 0x61, 0xdb, 0xd0, 0xae, 0x59, 0x88, 0xd0, 0xbf, 0xce, 0xb9, 0xe6, 0xff, 0x79, 0xce,
+	0xd3, 0xf0, 0x5f, 0xeb, 0x90, 0xbd, 0x26, 0x72, 0xb6, 0x26, 0xbc, 0x96, 0xd2, 0x5b, 0xff, 0x19,
+	0x00, 0x25, 0x29, 0x27, 0x85, 0xd5, 0x34, 0x00, 0x00,
+}
+
+func (this *BatchEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BatchEntry)
+	if !ok {
+		that2, ok := that.(BatchEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateDenom(ctx context.Context, in *MsgTokenFactoryCreateDenom, opts ...grpc.CallOption) (*MsgTokenFactoryCreateDenomResponse, error)
+	Mint(ctx context.Context, in *MsgTokenFactoryMint, opts ...grpc.CallOption) (*MsgTokenFactoryMintResponse, error)
+	Burn(ctx context.Context, in *MsgTokenFactoryBurn, opts ...grpc.CallOption) (*MsgTokenFactoryBurnResponse, error)
+	ChangeAdmin(ctx context.Context, in *MsgTokenFactoryChangeAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryChangeAdminResponse, error)
+	SetDenomMetadata(ctx context.Context, in *MsgTokenFactorySetDenomMetadata, opts ...grpc.CallOption) (*MsgTokenFactorySetDenomMetadataResponse, error)
+	ForceTransfer(ctx context.Context, in *MsgTokenFactoryForceTransfer, opts ...grpc.CallOption) (*MsgTokenFactoryForceTransferResponse, error)
+	GrantRole(ctx context.Context, in *MsgTokenFactoryGrantRole, opts ...grpc.CallOption) (*MsgTokenFactoryGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgTokenFactoryRevokeRole, opts ...grpc.CallOption) (*MsgTokenFactoryRevokeRoleResponse, error)
+	ProposeAdmin(ctx context.Context, in *MsgTokenFactoryProposeAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryProposeAdminResponse, error)
+	AcceptAdmin(ctx context.Context, in *MsgTokenFactoryAcceptAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryAcceptAdminResponse, error)
+	CancelAdminProposal(ctx context.Context, in *MsgTokenFactoryCancelAdminProposal, opts ...grpc.CallOption) (*MsgTokenFactoryCancelAdminProposalResponse, error)
+	ConfigureMinter(ctx context.Context, in *MsgTokenFactoryConfigureMinter, opts ...grpc.CallOption) (*MsgTokenFactoryConfigureMinterResponse, error)
+	IncreaseMinterAllowance(ctx context.Context, in *MsgTokenFactoryIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgTokenFactoryIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(ctx context.Context, in *MsgTokenFactoryDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgTokenFactoryDecreaseMinterAllowanceResponse, error)
+	RemoveMinter(ctx context.Context, in *MsgTokenFactoryRemoveMinter, opts ...grpc.CallOption) (*MsgTokenFactoryRemoveMinterResponse, error)
+	SetMaxSupply(ctx context.Context, in *MsgTokenFactorySetMaxSupply, opts ...grpc.CallOption) (*MsgTokenFactorySetMaxSupplyResponse, error)
+	Pause(ctx context.Context, in *MsgTokenFactoryPause, opts ...grpc.CallOption) (*MsgTokenFactoryPauseResponse, error)
+	Unpause(ctx context.Context, in *MsgTokenFactoryUnpause, opts ...grpc.CallOption) (*MsgTokenFactoryUnpauseResponse, error)
+	Freeze(ctx context.Context, in *MsgTokenFactoryFreeze, opts ...grpc.CallOption) (*MsgTokenFactoryFreezeResponse, error)
+	Unfreeze(ctx context.Context, in *MsgTokenFactoryUnfreeze, opts ...grpc.CallOption) (*MsgTokenFactoryUnfreezeResponse, error)
+	AddToAllowlist(ctx context.Context, in *MsgTokenFactoryAddToAllowlist, opts ...grpc.CallOption) (*MsgTokenFactoryAddToAllowlistResponse, error)
+	RemoveFromAllowlist(ctx context.Context, in *MsgTokenFactoryRemoveFromAllowlist, opts ...grpc.CallOption) (*MsgTokenFactoryRemoveFromAllowlistResponse, error)
+	SetBeforeSendHook(ctx context.Context, in *MsgTokenFactorySetBeforeSendHook, opts ...grpc.CallOption) (*MsgTokenFactorySetBeforeSendHookResponse, error)
+	RenounceCapability(ctx context.Context, in *MsgTokenFactoryRenounceCapability, opts ...grpc.CallOption) (*MsgTokenFactoryRenounceCapabilityResponse, error)
+	SetTimelock(ctx context.Context, in *MsgTokenFactorySetTimelock, opts ...grpc.CallOption) (*MsgTokenFactorySetTimelockResponse, error)
+	CancelPendingAction(ctx context.Context, in *MsgTokenFactoryCancelPendingAction, opts ...grpc.CallOption) (*MsgTokenFactoryCancelPendingActionResponse, error)
+	SetMintRateLimit(ctx context.Context, in *MsgTokenFactorySetMintRateLimit, opts ...grpc.CallOption) (*MsgTokenFactorySetMintRateLimitResponse, error)
+	UpdateParams(ctx context.Context, in *MsgTokenFactoryUpdateParams, opts ...grpc.CallOption) (*MsgTokenFactoryUpdateParamsResponse, error)
+	RetireDenom(ctx context.Context, in *MsgTokenFactoryRetireDenom, opts ...grpc.CallOption) (*MsgTokenFactoryRetireDenomResponse, error)
+	BatchMint(ctx context.Context, in *MsgTokenFactoryBatchMint, opts ...grpc.CallOption) (*MsgTokenFactoryBatchMintResponse, error)
+	BatchBurn(ctx context.Context, in *MsgTokenFactoryBatchBurn, opts ...grpc.CallOption) (*MsgTokenFactoryBatchBurnResponse, error)
+	BatchForceTransfer(ctx context.Context, in *MsgTokenFactoryBatchForceTransfer, opts ...grpc.CallOption) (*MsgTokenFactoryBatchForceTransferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateDenom(ctx context.Context, in *MsgTokenFactoryCreateDenom, opts ...grpc.CallOption) (*MsgTokenFactoryCreateDenomResponse, error) {
+	out := new(MsgTokenFactoryCreateDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/CreateDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgTokenFactoryMint, opts ...grpc.CallOption) (*MsgTokenFactoryMintResponse, error) {
+	out := new(MsgTokenFactoryMintResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgTokenFactoryBurn, opts ...grpc.CallOption) (*MsgTokenFactoryBurnResponse, error) {
+	out := new(MsgTokenFactoryBurnResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeAdmin(ctx context.Context, in *MsgTokenFactoryChangeAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryChangeAdminResponse, error) {
+	out := new(MsgTokenFactoryChangeAdminResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ChangeAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgTokenFactorySetDenomMetadata, opts ...grpc.CallOption) (*MsgTokenFactorySetDenomMetadataResponse, error) {
+	out := new(MsgTokenFactorySetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgTokenFactoryForceTransfer, opts ...grpc.CallOption) (*MsgTokenFactoryForceTransferResponse, error) {
+	out := new(MsgTokenFactoryForceTransferResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ForceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgTokenFactoryGrantRole, opts ...grpc.CallOption) (*MsgTokenFactoryGrantRoleResponse, error) {
+	out := new(MsgTokenFactoryGrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgTokenFactoryRevokeRole, opts ...grpc.CallOption) (*MsgTokenFactoryRevokeRoleResponse, error) {
+	out := new(MsgTokenFactoryRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeAdmin(ctx context.Context, in *MsgTokenFactoryProposeAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryProposeAdminResponse, error) {
+	out := new(MsgTokenFactoryProposeAdminResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ProposeAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAdmin(ctx context.Context, in *MsgTokenFactoryAcceptAdmin, opts ...grpc.CallOption) (*MsgTokenFactoryAcceptAdminResponse, error) {
+	out := new(MsgTokenFactoryAcceptAdminResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/AcceptAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAdminProposal(ctx context.Context, in *MsgTokenFactoryCancelAdminProposal, opts ...grpc.CallOption) (*MsgTokenFactoryCancelAdminProposalResponse, error) {
+	out := new(MsgTokenFactoryCancelAdminProposalResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/CancelAdminProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfigureMinter(ctx context.Context, in *MsgTokenFactoryConfigureMinter, opts ...grpc.CallOption) (*MsgTokenFactoryConfigureMinterResponse, error) {
+	out := new(MsgTokenFactoryConfigureMinterResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ConfigureMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IncreaseMinterAllowance(ctx context.Context, in *MsgTokenFactoryIncreaseMinterAllowance, opts ...grpc.CallOption) (*MsgTokenFactoryIncreaseMinterAllowanceResponse, error) {
+	out := new(MsgTokenFactoryIncreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/IncreaseMinterAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecreaseMinterAllowance(ctx context.Context, in *MsgTokenFactoryDecreaseMinterAllowance, opts ...grpc.CallOption) (*MsgTokenFactoryDecreaseMinterAllowanceResponse, error) {
+	out := new(MsgTokenFactoryDecreaseMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/DecreaseMinterAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinter(ctx context.Context, in *MsgTokenFactoryRemoveMinter, opts ...grpc.CallOption) (*MsgTokenFactoryRemoveMinterResponse, error) {
+	out := new(MsgTokenFactoryRemoveMinterResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/RemoveMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxSupply(ctx context.Context, in *MsgTokenFactorySetMaxSupply, opts ...grpc.CallOption) (*MsgTokenFactorySetMaxSupplyResponse, error) {
+	out := new(MsgTokenFactorySetMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetMaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Pause(ctx context.Context, in *MsgTokenFactoryPause, opts ...grpc.CallOption) (*MsgTokenFactoryPauseResponse, error) {
+	out := new(MsgTokenFactoryPauseResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpause(ctx context.Context, in *MsgTokenFactoryUnpause, opts ...grpc.CallOption) (*MsgTokenFactoryUnpauseResponse, error) {
+	out := new(MsgTokenFactoryUnpauseResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Unpause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Freeze(ctx context.Context, in *MsgTokenFactoryFreeze, opts ...grpc.CallOption) (*MsgTokenFactoryFreezeResponse, error) {
+	out := new(MsgTokenFactoryFreezeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Freeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unfreeze(ctx context.Context, in *MsgTokenFactoryUnfreeze, opts ...grpc.CallOption) (*MsgTokenFactoryUnfreezeResponse, error) {
+	out := new(MsgTokenFactoryUnfreezeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/Unfreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToAllowlist(ctx context.Context, in *MsgTokenFactoryAddToAllowlist, opts ...grpc.CallOption) (*MsgTokenFactoryAddToAllowlistResponse, error) {
+	out := new(MsgTokenFactoryAddToAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/AddToAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromAllowlist(ctx context.Context, in *MsgTokenFactoryRemoveFromAllowlist, opts ...grpc.CallOption) (*MsgTokenFactoryRemoveFromAllowlistResponse, error) {
+	out := new(MsgTokenFactoryRemoveFromAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/RemoveFromAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBeforeSendHook(ctx context.Context, in *MsgTokenFactorySetBeforeSendHook, opts ...grpc.CallOption) (*MsgTokenFactorySetBeforeSendHookResponse, error) {
+	out := new(MsgTokenFactorySetBeforeSendHookResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetBeforeSendHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenounceCapability(ctx context.Context, in *MsgTokenFactoryRenounceCapability, opts ...grpc.CallOption) (*MsgTokenFactoryRenounceCapabilityResponse, error) {
+	out := new(MsgTokenFactoryRenounceCapabilityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/RenounceCapability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTimelock(ctx context.Context, in *MsgTokenFactorySetTimelock, opts ...grpc.CallOption) (*MsgTokenFactorySetTimelockResponse, error) {
+	out := new(MsgTokenFactorySetTimelockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetTimelock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPendingAction(ctx context.Context, in *MsgTokenFactoryCancelPendingAction, opts ...grpc.CallOption) (*MsgTokenFactoryCancelPendingActionResponse, error) {
+	out := new(MsgTokenFactoryCancelPendingActionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/CancelPendingAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintRateLimit(ctx context.Context, in *MsgTokenFactorySetMintRateLimit, opts ...grpc.CallOption) (*MsgTokenFactorySetMintRateLimitResponse, error) {
+	out := new(MsgTokenFactorySetMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetMintRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgTokenFactoryUpdateParams, opts ...grpc.CallOption) (*MsgTokenFactoryUpdateParamsResponse, error) {
+	out := new(MsgTokenFactoryUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetireDenom(ctx context.Context, in *MsgTokenFactoryRetireDenom, opts ...grpc.CallOption) (*MsgTokenFactoryRetireDenomResponse, error) {
+	out := new(MsgTokenFactoryRetireDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/RetireDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchMint(ctx context.Context, in *MsgTokenFactoryBatchMint, opts ...grpc.CallOption) (*MsgTokenFactoryBatchMintResponse, error) {
+	out := new(MsgTokenFactoryBatchMintResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/BatchMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchBurn(ctx context.Context, in *MsgTokenFactoryBatchBurn, opts ...grpc.CallOption) (*MsgTokenFactoryBatchBurnResponse, error) {
+	out := new(MsgTokenFactoryBatchBurnResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/BatchBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchForceTransfer(ctx context.Context, in *MsgTokenFactoryBatchForceTransfer, opts ...grpc.CallOption) (*MsgTokenFactoryBatchForceTransferResponse, error) {
+	out := new(MsgTokenFactoryBatchForceTransferResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/BatchForceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateDenom(context.Context, *MsgTokenFactoryCreateDenom) (*MsgTokenFactoryCreateDenomResponse, error)
+	Mint(context.Context, *MsgTokenFactoryMint) (*MsgTokenFactoryMintResponse, error)
+	Burn(context.Context, *MsgTokenFactoryBurn) (*MsgTokenFactoryBurnResponse, error)
+	ChangeAdmin(context.Context, *MsgTokenFactoryChangeAdmin) (*MsgTokenFactoryChangeAdminResponse, error)
+	SetDenomMetadata(context.Context, *MsgTokenFactorySetDenomMetadata) (*MsgTokenFactorySetDenomMetadataResponse, error)
+	ForceTransfer(context.Context, *MsgTokenFactoryForceTransfer) (*MsgTokenFactoryForceTransferResponse, error)
+	GrantRole(context.Context, *MsgTokenFactoryGrantRole) (*MsgTokenFactoryGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgTokenFactoryRevokeRole) (*MsgTokenFactoryRevokeRoleResponse, error)
+	ProposeAdmin(context.Context, *MsgTokenFactoryProposeAdmin) (*MsgTokenFactoryProposeAdminResponse, error)
+	AcceptAdmin(context.Context, *MsgTokenFactoryAcceptAdmin) (*MsgTokenFactoryAcceptAdminResponse, error)
+	CancelAdminProposal(context.Context, *MsgTokenFactoryCancelAdminProposal) (*MsgTokenFactoryCancelAdminProposalResponse, error)
+	ConfigureMinter(context.Context, *MsgTokenFactoryConfigureMinter) (*MsgTokenFactoryConfigureMinterResponse, error)
+	IncreaseMinterAllowance(context.Context, *MsgTokenFactoryIncreaseMinterAllowance) (*MsgTokenFactoryIncreaseMinterAllowanceResponse, error)
+	DecreaseMinterAllowance(context.Context, *MsgTokenFactoryDecreaseMinterAllowance) (*MsgTokenFactoryDecreaseMinterAllowanceResponse, error)
+	RemoveMinter(context.Context, *MsgTokenFactoryRemoveMinter) (*MsgTokenFactoryRemoveMinterResponse, error)
+	SetMaxSupply(context.Context, *MsgTokenFactorySetMaxSupply) (*MsgTokenFactorySetMaxSupplyResponse, error)
+	Pause(context.Context, *MsgTokenFactoryPause) (*MsgTokenFactoryPauseResponse, error)
+	Unpause(context.Context, *MsgTokenFactoryUnpause) (*MsgTokenFactoryUnpauseResponse, error)
+	Freeze(context.Context, *MsgTokenFactoryFreeze) (*MsgTokenFactoryFreezeResponse, error)
+	Unfreeze(context.Context, *MsgTokenFactoryUnfreeze) (*MsgTokenFactoryUnfreezeResponse, error)
+	AddToAllowlist(context.Context, *MsgTokenFactoryAddToAllowlist) (*MsgTokenFactoryAddToAllowlistResponse, error)
+	RemoveFromAllowlist(context.Context, *MsgTokenFactoryRemoveFromAllowlist) (*MsgTokenFactoryRemoveFromAllowlistResponse, error)
+	SetBeforeSendHook(context.Context, *MsgTokenFactorySetBeforeSendHook) (*MsgTokenFactorySetBeforeSendHookResponse, error)
+	RenounceCapability(context.Context, *MsgTokenFactoryRenounceCapability) (*MsgTokenFactoryRenounceCapabilityResponse, error)
+	SetTimelock(context.Context, *MsgTokenFactorySetTimelock) (*MsgTokenFactorySetTimelockResponse, error)
+	CancelPendingAction(context.Context, *MsgTokenFactoryCancelPendingAction) (*MsgTokenFactoryCancelPendingActionResponse, error)
+	SetMintRateLimit(context.Context, *MsgTokenFactorySetMintRateLimit) (*MsgTokenFactorySetMintRateLimitResponse, error)
+	UpdateParams(context.Context, *MsgTokenFactoryUpdateParams) (*MsgTokenFactoryUpdateParamsResponse, error)
+	RetireDenom(context.Context, *MsgTokenFactoryRetireDenom) (*MsgTokenFactoryRetireDenomResponse, error)
+	BatchMint(context.Context, *MsgTokenFactoryBatchMint) (*MsgTokenFactoryBatchMintResponse, error)
+	BatchBurn(context.Context, *MsgTokenFactoryBatchBurn) (*MsgTokenFactoryBatchBurnResponse, error)
+	BatchForceTransfer(context.Context, *MsgTokenFactoryBatchForceTransfer) (*MsgTokenFactoryBatchForceTransferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateDenom(ctx context.Context, req *MsgTokenFactoryCreateDenom) (*MsgTokenFactoryCreateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDenom not implemented")
+}
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgTokenFactoryMint) (*MsgTokenFactoryMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgTokenFactoryBurn) (*MsgTokenFactoryBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) ChangeAdmin(ctx context.Context, req *MsgTokenFactoryChangeAdmin) (*MsgTokenFactoryChangeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAdmin not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgTokenFactorySetDenomMetadata) (*MsgTokenFactorySetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgTokenFactoryForceTransfer) (*MsgTokenFactoryForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+}
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgTokenFactoryGrantRole) (*MsgTokenFactoryGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgTokenFactoryRevokeRole) (*MsgTokenFactoryRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMsgServer) ProposeAdmin(ctx context.Context, req *MsgTokenFactoryProposeAdmin) (*MsgTokenFactoryProposeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdmin not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAdmin(ctx context.Context, req *MsgTokenFactoryAcceptAdmin) (*MsgTokenFactoryAcceptAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdmin not implemented")
+}
+func (*UnimplementedMsgServer) CancelAdminProposal(ctx context.Context, req *MsgTokenFactoryCancelAdminProposal) (*MsgTokenFactoryCancelAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAdminProposal not implemented")
+}
+func (*UnimplementedMsgServer) ConfigureMinter(ctx context.Context, req *MsgTokenFactoryConfigureMinter) (*MsgTokenFactoryConfigureMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureMinter not implemented")
+}
+func (*UnimplementedMsgServer) IncreaseMinterAllowance(ctx context.Context, req *MsgTokenFactoryIncreaseMinterAllowance) (*MsgTokenFactoryIncreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseMinterAllowance not implemented")
+}
+func (*UnimplementedMsgServer) DecreaseMinterAllowance(ctx context.Context, req *MsgTokenFactoryDecreaseMinterAllowance) (*MsgTokenFactoryDecreaseMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseMinterAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMinter(ctx context.Context, req *MsgTokenFactoryRemoveMinter) (*MsgTokenFactoryRemoveMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinter not implemented")
+}
+func (*UnimplementedMsgServer) SetMaxSupply(ctx context.Context, req *MsgTokenFactorySetMaxSupply) (*MsgTokenFactorySetMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxSupply not implemented")
+}
+func (*UnimplementedMsgServer) Pause(ctx context.Context, req *MsgTokenFactoryPause) (*MsgTokenFactoryPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedMsgServer) Unpause(ctx context.Context, req *MsgTokenFactoryUnpause) (*MsgTokenFactoryUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (*UnimplementedMsgServer) Freeze(ctx context.Context, req *MsgTokenFactoryFreeze) (*MsgTokenFactoryFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Freeze not implemented")
+}
+func (*UnimplementedMsgServer) Unfreeze(ctx context.Context, req *MsgTokenFactoryUnfreeze) (*MsgTokenFactoryUnfreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfreeze not implemented")
+}
+func (*UnimplementedMsgServer) AddToAllowlist(ctx context.Context, req *MsgTokenFactoryAddToAllowlist) (*MsgTokenFactoryAddToAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFromAllowlist(ctx context.Context, req *MsgTokenFactoryRemoveFromAllowlist) (*MsgTokenFactoryRemoveFromAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) SetBeforeSendHook(ctx context.Context, req *MsgTokenFactorySetBeforeSendHook) (*MsgTokenFactorySetBeforeSendHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBeforeSendHook not implemented")
+}
+func (*UnimplementedMsgServer) RenounceCapability(ctx context.Context, req *MsgTokenFactoryRenounceCapability) (*MsgTokenFactoryRenounceCapabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenounceCapability not implemented")
+}
+func (*UnimplementedMsgServer) SetTimelock(ctx context.Context, req *MsgTokenFactorySetTimelock) (*MsgTokenFactorySetTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimelock not implemented")
+}
+func (*UnimplementedMsgServer) CancelPendingAction(ctx context.Context, req *MsgTokenFactoryCancelPendingAction) (*MsgTokenFactoryCancelPendingActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingAction not implemented")
+}
+func (*UnimplementedMsgServer) SetMintRateLimit(ctx context.Context, req *MsgTokenFactorySetMintRateLimit) (*MsgTokenFactorySetMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgTokenFactoryUpdateParams) (*MsgTokenFactoryUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RetireDenom(ctx context.Context, req *MsgTokenFactoryRetireDenom) (*MsgTokenFactoryRetireDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireDenom not implemented")
+}
+func (*UnimplementedMsgServer) BatchMint(ctx context.Context, req *MsgTokenFactoryBatchMint) (*MsgTokenFactoryBatchMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMint not implemented")
+}
+func (*UnimplementedMsgServer) BatchBurn(ctx context.Context, req *MsgTokenFactoryBatchBurn) (*MsgTokenFactoryBatchBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchBurn not implemented")
+}
+func (*UnimplementedMsgServer) BatchForceTransfer(ctx context.Context, req *MsgTokenFactoryBatchForceTransfer) (*MsgTokenFactoryBatchForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchForceTransfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryCreateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/CreateDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgTokenFactoryCreateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgTokenFactoryMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgTokenFactoryBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryChangeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ChangeAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeAdmin(ctx, req.(*MsgTokenFactoryChangeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactorySetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgTokenFactorySetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ForceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgTokenFactoryForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgTokenFactoryGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgTokenFactoryRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryProposeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ProposeAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdmin(ctx, req.(*MsgTokenFactoryProposeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryAcceptAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/AcceptAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdmin(ctx, req.(*MsgTokenFactoryAcceptAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryCancelAdminProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/CancelAdminProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAdminProposal(ctx, req.(*MsgTokenFactoryCancelAdminProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfigureMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryConfigureMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfigureMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ConfigureMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfigureMinter(ctx, req.(*MsgTokenFactoryConfigureMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryIncreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/IncreaseMinterAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseMinterAllowance(ctx, req.(*MsgTokenFactoryIncreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecreaseMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryDecreaseMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/DecreaseMinterAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseMinterAllowance(ctx, req.(*MsgTokenFactoryDecreaseMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryRemoveMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/RemoveMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinter(ctx, req.(*MsgTokenFactoryRemoveMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactorySetMaxSupply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetMaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxSupply(ctx, req.(*MsgTokenFactorySetMaxSupply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgTokenFactoryPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Unpause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgTokenFactoryUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Freeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Freeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Freeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Freeze(ctx, req.(*MsgTokenFactoryFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unfreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryUnfreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unfreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/Unfreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unfreeze(ctx, req.(*MsgTokenFactoryUnfreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryAddToAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/AddToAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToAllowlist(ctx, req.(*MsgTokenFactoryAddToAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryRemoveFromAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/RemoveFromAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromAllowlist(ctx, req.(*MsgTokenFactoryRemoveFromAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBeforeSendHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactorySetBeforeSendHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBeforeSendHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetBeforeSendHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBeforeSendHook(ctx, req.(*MsgTokenFactorySetBeforeSendHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenounceCapability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryRenounceCapability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenounceCapability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/RenounceCapability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenounceCapability(ctx, req.(*MsgTokenFactoryRenounceCapability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactorySetTimelock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetTimelock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTimelock(ctx, req.(*MsgTokenFactorySetTimelock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPendingAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryCancelPendingAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/CancelPendingAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingAction(ctx, req.(*MsgTokenFactoryCancelPendingAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactorySetMintRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetMintRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintRateLimit(ctx, req.(*MsgTokenFactorySetMintRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgTokenFactoryUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryRetireDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/RetireDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireDenom(ctx, req.(*MsgTokenFactoryRetireDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryBatchMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/BatchMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchMint(ctx, req.(*MsgTokenFactoryBatchMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryBatchBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/BatchBurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchBurn(ctx, req.(*MsgTokenFactoryBatchBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenFactoryBatchForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/BatchForceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchForceTransfer(ctx, req.(*MsgTokenFactoryBatchForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDenom",
+			Handler:    _Msg_CreateDenom_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "ChangeAdmin",
+			Handler:    _Msg_ChangeAdmin_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ProposeAdmin",
+			Handler:    _Msg_ProposeAdmin_Handler,
+		},
+		{
+			MethodName: "AcceptAdmin",
+			Handler:    _Msg_AcceptAdmin_Handler,
+		},
+		{
+			MethodName: "CancelAdminProposal",
+			Handler:    _Msg_CancelAdminProposal_Handler,
+		},
+		{
+			MethodName: "ConfigureMinter",
+			Handler:    _Msg_ConfigureMinter_Handler,
+		},
+		{
+			MethodName: "IncreaseMinterAllowance",
+			Handler:    _Msg_IncreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "DecreaseMinterAllowance",
+			Handler:    _Msg_DecreaseMinterAllowance_Handler,
+		},
+		{
+			MethodName: "RemoveMinter",
+			Handler:    _Msg_RemoveMinter_Handler,
+		},
+		{
+			MethodName: "SetMaxSupply",
+			Handler:    _Msg_SetMaxSupply_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "Freeze",
+			Handler:    _Msg_Freeze_Handler,
+		},
+		{
+			MethodName: "Unfreeze",
+			Handler:    _Msg_Unfreeze_Handler,
+		},
+		{
+			MethodName: "AddToAllowlist",
+			Handler:    _Msg_AddToAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromAllowlist",
+			Handler:    _Msg_RemoveFromAllowlist_Handler,
+		},
+		{
+			MethodName: "SetBeforeSendHook",
+			Handler:    _Msg_SetBeforeSendHook_Handler,
+		},
+		{
+			MethodName: "RenounceCapability",
+			Handler:    _Msg_RenounceCapability_Handler,
+		},
+		{
+			MethodName: "SetTimelock",
+			Handler:    _Msg_SetTimelock_Handler,
+		},
+		{
+			MethodName: "CancelPendingAction",
+			Handler:    _Msg_CancelPendingAction_Handler,
+		},
+		{
+			MethodName: "SetMintRateLimit",
+			Handler:    _Msg_SetMintRateLimit_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RetireDenom",
+			Handler:    _Msg_RetireDenom_Handler,
+		},
+		{
+			MethodName: "BatchMint",
+			Handler:    _Msg_BatchMint_Handler,
+		},
+		{
+			MethodName: "BatchBurn",
+			Handler:    _Msg_BatchBurn_Handler,
+		},
+		{
+			MethodName: "BatchForceTransfer",
+			Handler:    _Msg_BatchForceTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/tokenfactory/v1beta1/tx.proto",
+}
+
+func (m *MsgTokenFactoryCreateDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryCreateDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryCreateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RenouncedCapabilities) > 0 {
+		dAtA2 := make([]byte, len(m.RenouncedCapabilities)*10)
+		var j1 int
+		for _, num := range m.RenouncedCapabilities {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryCreateDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryCreateDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryCreateDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewTokenDenom) > 0 {
+		i -= len(m.NewTokenDenom)
+		copy(dAtA[i:], m.NewTokenDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewTokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintToAddress) > 0 {
+		i -= len(m.MintToAddress)
+		copy(dAtA[i:], m.MintToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MintToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BurnFromAddress) > 0 {
+		i -= len(m.BurnFromAddress)
+		copy(dAtA[i:], m.BurnFromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BurnFromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryChangeAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryChangeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryChangeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmRenounce {
+		i--
+		if m.ConfirmRenounce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryChangeAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryChangeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryChangeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryForceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryForceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferToAddress) > 0 {
+		i -= len(m.TransferToAddress)
+		copy(dAtA[i:], m.TransferToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TransferFromAddress) > 0 {
+		i -= len(m.TransferFromAddress)
+		copy(dAtA[i:], m.TransferFromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferFromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryForceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryForceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryGrantRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryGrantRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryGrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryGrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryGrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRevokeRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRevokeRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRevokeRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRevokeRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRevokeRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryProposeAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryProposeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryProposeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryProposeAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryProposeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryProposeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryAcceptAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryAcceptAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryAcceptAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryAcceptAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryAcceptAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryAcceptAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryCancelAdminProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryCancelAdminProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryCancelAdminProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryCancelAdminProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryCancelAdminProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryCancelAdminProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryConfigureMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryConfigureMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryConfigureMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReplenishPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ReplenishAmount.Size()
+		i -= size
+		if _, err := m.ReplenishAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryConfigureMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryConfigureMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryConfigureMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRemoveMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRemoveMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRemoveMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRemoveMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRemoveMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRemoveMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetMaxSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetMaxSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetMaxSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetMaxSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetMaxSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetMaxSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryUnpause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryUnpause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryUnpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryUnpauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryUnpauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryUnpauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryUnfreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryUnfreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryUnfreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryUnfreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryUnfreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryUnfreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryAddToAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryAddToAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryAddToAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryAddToAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryAddToAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryAddToAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetBeforeSendHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetBeforeSendHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetBeforeSendHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmwasmAddress) > 0 {
+		i -= len(m.CosmwasmAddress)
+		copy(dAtA[i:], m.CosmwasmAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmwasmAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetBeforeSendHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetBeforeSendHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetBeforeSendHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRenounceCapability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRenounceCapability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRenounceCapability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Capability != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Capability))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRenounceCapabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRenounceCapabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRenounceCapabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetTimelock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetTimelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetTimelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintThreshold.Size()
+		i -= size
+		if _, err := m.MintThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetTimelockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetTimelockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetTimelockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryCancelPendingAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryCancelPendingAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryCancelPendingAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryCancelPendingActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryCancelPendingActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryCancelPendingActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetMintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetMintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetMintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactorySetMintRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactorySetMintRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactorySetMintRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRetireDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRetireDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRetireDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryRetireDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryRetireDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryRetireDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBatchMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBatchMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBatchMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBatchMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBatchMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBatchMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBatchBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBatchBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBatchBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBatchBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBatchBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBatchBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferToAddress) > 0 {
+		i -= len(m.TransferToAddress)
+		copy(dAtA[i:], m.TransferToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenFactoryBatchForceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenFactoryBatchForceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenFactoryBatchForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTokenFactoryCreateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	if len(m.RenouncedCapabilities) > 0 {
+		l = 0
+		for _, e := range m.RenouncedCapabilities {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryCreateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.MintToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BurnFromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryChangeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConfirmRenounce {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryChangeAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactorySetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactorySetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryForceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TransferFromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryForceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryGrantRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryGrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryRevokeRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryRevokeRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryProposeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryProposeAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryAcceptAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryAcceptAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryCancelAdminProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryCancelAdminProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryConfigureMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ReplenishAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReplenishPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactoryConfigureMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryRemoveMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryRemoveMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactorySetMaxSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactorySetMaxSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryUnpause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryUnpauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryUnfreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryAddToAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryAddToAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactorySetBeforeSendHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmwasmAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactorySetBeforeSendHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryRenounceCapability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Capability != 0 {
+		n += 1 + sovTx(uint64(m.Capability))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryRenounceCapabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactorySetTimelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MintThreshold.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactorySetTimelockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryCancelPendingAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryCancelPendingActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactorySetMintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactorySetMintRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactoryUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryRetireDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryRetireDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTokenFactoryBatchMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryBatchMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryBatchBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryBatchBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTokenFactoryBatchForceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TransferToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenFactoryBatchForceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTokenFactoryCreateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryCreateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryCreateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		case 5:
+			if wireType == 0 {
+				var v DenomCapability
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= DenomCapability(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RenouncedCapabilities = append(m.RenouncedCapabilities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RenouncedCapabilities) == 0 {
+					m.RenouncedCapabilities = make([]DenomCapability, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v DenomCapability
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= DenomCapability(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RenouncedCapabilities = append(m.RenouncedCapabilities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenouncedCapabilities", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryCreateDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryCreateDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryCreateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryChangeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryChangeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmRenounce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConfirmRenounce = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryChangeAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryChangeAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetDenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryForceTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryForceTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryForceTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryForceTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryGrantRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryGrantRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryGrantRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= DenomRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryGrantRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryGrantRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryGrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRevokeRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRevokeRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRevokeRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= DenomRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRevokeRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRevokeRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRevokeRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryProposeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryProposeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryProposeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryProposeAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryProposeAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryProposeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryAcceptAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryAcceptAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryAcceptAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryAcceptAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryAcceptAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryAcceptAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryCancelAdminProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelAdminProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryCancelAdminProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelAdminProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelAdminProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryConfigureMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryConfigureMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryConfigureMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplenishAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReplenishAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryConfigureMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryConfigureMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryConfigureMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryIncreaseMinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryIncreaseMinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryIncreaseMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryIncreaseMinterAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryIncreaseMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryDecreaseMinterAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryDecreaseMinterAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryDecreaseMinterAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryDecreaseMinterAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryDecreaseMinterAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRemoveMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRemoveMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetMaxSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetMaxSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetMaxSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetMaxSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetMaxSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetMaxSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryUnpause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnpause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnpause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryUnpauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnpauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnpauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryFreezeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryFreezeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryUnfreezeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnfreezeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryUnfreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryAddToAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryAddToAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryAddToAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryAddToAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryAddToAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryAddToAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRemoveFromAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveFromAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveFromAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRemoveFromAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveFromAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRemoveFromAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetBeforeSendHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetBeforeSendHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetBeforeSendHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRenounceCapability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRenounceCapability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRenounceCapability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+			}
+			m.Capability = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capability |= DenomCapability(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryRenounceCapabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryRenounceCapabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryRenounceCapabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetTimelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetTimelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetTimelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactorySetTimelockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactorySetTimelockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactorySetTimelockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryCancelPendingAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelPendingAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelPendingAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgTokenFactorySetDenomMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgTokenFactoryForceTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TransferFromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TransferToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTokenFactoryCancelPendingActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelPendingActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryCancelPendingActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgTokenFactoryForceTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgTokenFactoryCreateDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactorySetMintRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,10 +13638,10 @@ func (m *MsgTokenFactoryCreateDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryCreateDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactorySetMintRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryCreateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactorySetMintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1628,7 +13678,7 @@ func (m *MsgTokenFactoryCreateDenom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1656,7 +13706,40 @@ func (m *MsgTokenFactoryCreateDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1679,7 +13762,7 @@ func (m *MsgTokenFactoryCreateDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryCreateDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactorySetMintRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1702,15 +13785,65 @@ func (m *MsgTokenFactoryCreateDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryCreateDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactorySetMintRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryCreateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactorySetMintRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1735,11 +13868,94 @@ func (m *MsgTokenFactoryCreateDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenFactoryUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewTokenDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenFactoryUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenFactoryUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1761,7 +13977,7 @@ func (m *MsgTokenFactoryCreateDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryMint) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryRetireDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1784,10 +14000,10 @@ func (m *MsgTokenFactoryMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryRetireDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryRetireDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1824,40 +14040,7 @@ func (m *MsgTokenFactoryMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1885,7 +14068,7 @@ func (m *MsgTokenFactoryMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1908,7 +14091,7 @@ func (m *MsgTokenFactoryMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryRetireDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1931,10 +14114,10 @@ func (m *MsgTokenFactoryMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryRetireDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryRetireDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1958,7 +14141,7 @@ func (m *MsgTokenFactoryMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryBurn) Unmarshal(dAtA []byte) error {
+func (m *BatchEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1981,15 +14164,15 @@ func (m *MsgTokenFactoryBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2017,45 +14200,12 @@ func (m *MsgTokenFactoryBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
-			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -2082,58 +14232,10 @@ func (m *MsgTokenFactoryBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTokenFactoryBurnResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryBurnResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2155,7 +14257,7 @@ func (m *MsgTokenFactoryBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryChangeAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryBatchMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2178,10 +14280,10 @@ func (m *MsgTokenFactoryChangeAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryChangeAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryBatchMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryBatchMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2250,9 +14352,9 @@ func (m *MsgTokenFactoryChangeAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2262,23 +14364,25 @@ func (m *MsgTokenFactoryChangeAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, BatchEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2301,7 +14405,7 @@ func (m *MsgTokenFactoryChangeAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryChangeAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryBatchMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2324,10 +14428,10 @@ func (m *MsgTokenFactoryChangeAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryChangeAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryBatchMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryBatchMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2351,7 +14455,7 @@ func (m *MsgTokenFactoryChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactorySetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryBatchBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2374,10 +14478,10 @@ func (m *MsgTokenFactorySetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryBatchBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryBatchBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2414,7 +14518,39 @@ func (m *MsgTokenFactorySetDenomMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2441,7 +14577,8 @@ func (m *MsgTokenFactorySetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, BatchEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2466,7 +14603,7 @@ func (m *MsgTokenFactorySetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactorySetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryBatchBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2489,10 +14626,10 @@ func (m *MsgTokenFactorySetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryBatchBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactorySetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryBatchBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2516,7 +14653,7 @@ func (m *MsgTokenFactorySetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryForceTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryBatchForceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2539,10 +14676,10 @@ func (m *MsgTokenFactoryForceTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryForceTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryBatchForceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryBatchForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2579,9 +14716,9 @@ func (m *MsgTokenFactoryForceTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2591,30 +14728,29 @@ func (m *MsgTokenFactoryForceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2624,23 +14760,25 @@ func (m *MsgTokenFactoryForceTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, BatchEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2695,7 +14833,7 @@ func (m *MsgTokenFactoryForceTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTokenFactoryForceTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenFactoryBatchForceTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2718,10 +14856,10 @@ func (m *MsgTokenFactoryForceTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTokenFactoryForceTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenFactoryBatchForceTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTokenFactoryForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenFactoryBatchForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: